@@ -0,0 +1,28 @@
+package api
+
+import (
+	"log"
+	"match-me/api/middleware"
+	"match-me/config"
+	"match-me/internal/scheduler"
+	userUsecase "match-me/internal/usecases/user"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// registerAdminRoutes registers the operational endpoints, which are for admins only
+func registerAdminRoutes(r *gin.Engine, cfg *config.Config, users userUsecase.UserUsecase, jobs *scheduler.Scheduler) {
+	adminGroup := r.Group("/admin", middleware.VerifyUser(users, cfg.JWTSecret), middleware.RequireAdmin())
+	{
+		// Background job runs on this instance
+		adminGroup.GET("/jobs", func(c *gin.Context) {
+			metrics := jobs.Metrics()
+			c.JSON(http.StatusOK, gin.H{
+				"jobs":  metrics,
+				"count": len(metrics),
+			})
+		})
+	}
+	log.Println("💫 All admin routes registered")
+}
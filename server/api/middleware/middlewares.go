@@ -12,6 +12,7 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // Ping returns a middleware that responds with "pong" to GET requests on "/ping".
@@ -57,6 +58,19 @@ func GetUserFromGinContext(c *gin.Context) (*models.User, bool) {
 	return userModel, ok
 }
 
+// SessionContextKey is the key used to store the session ID of the access token in context
+const SessionContextKey = "session"
+
+// GetSessionIDFromGinContext extracts the current session ID from Gin context
+func GetSessionIDFromGinContext(c *gin.Context) (uuid.UUID, bool) {
+	sessionID, exists := c.Get(SessionContextKey)
+	if !exists {
+		return uuid.Nil, false
+	}
+	id, ok := sessionID.(uuid.UUID)
+	return id, ok
+}
+
 // VerifyUser is middleware that extracts a user from a valid access token
 func VerifyUser(userUC user.UserUsecase, jwtSecret string) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -83,7 +97,7 @@ func VerifyUser(userUC user.UserUsecase, jwtSecret string) gin.HandlerFunc {
 		}
 
 		// Verify access token
-		userID, err := jwt.VerifyJwtToken(c.Request.Context(), tokenStr, jwt.PurposeLogin, jwtSecret)
+		userID, claims, err := jwt.ParseJwtToken(c.Request.Context(), tokenStr, jwt.PurposeLogin, jwtSecret)
 		if err != nil {
 			log.Printf("[middleware]: VerifyUser Invalid token: %v", err)
 			c.JSON(http.StatusUnauthorized, gin.H{
@@ -94,6 +108,21 @@ func VerifyUser(userUC user.UserUsecase, jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
+		// Check the session behind the token has not been revoked
+		sessionID, err := jwt.ClaimUUID(claims, jwt.ClaimSessionID)
+		if err == nil {
+			err = userUC.ValidateSession(c.Request.Context(), userID, sessionID)
+		}
+		if err != nil {
+			log.Printf("[middleware]: VerifyUser Invalid session: %v", err)
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":   "Unauthorized",
+				"details": "Session expired or revoked. Please login.",
+			})
+			c.Abort()
+			return
+		}
+
 		// Load user from DB
 		user, err := userUC.GetUserByID(c.Request.Context(), userID, models.AccessLevelFull)
 		if err != nil {
@@ -107,8 +136,35 @@ func VerifyUser(userUC user.UserUsecase, jwtSecret string) gin.HandlerFunc {
 
 		// Store user in context
 		c.Set(UserContextKey, user)
+		c.Set(SessionContextKey, sessionID)
 
 		// Proceed to next middleware/handler
 		c.Next()
 	}
 }
+
+// RequireAdmin is middleware that only lets admins through. It must run after VerifyUser.
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, exists := GetUserFromGinContext(c)
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":   "Unauthorized",
+				"details": "User not authenticated",
+			})
+			c.Abort()
+			return
+		}
+
+		if user.Role != models.RoleAdmin {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Forbidden",
+				"details": "Admin access required",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -1,24 +1,35 @@
 package api
 
 import (
+	"context"
 	"match-me/api/websocket"
 	"match-me/config"
 	"match-me/ent"
 	"match-me/internal/adapters/connection"
+	"match-me/internal/adapters/event"
+	mediaAdapter "match-me/internal/adapters/media"
 	"match-me/internal/adapters/user"
-	"match-me/internal/pkg/cloudinary"
+	"match-me/internal/models"
+	"match-me/internal/pkg/broker"
+	"match-me/internal/pkg/mailer"
+	"match-me/internal/pkg/matching"
+	"match-me/internal/pkg/storage"
+	"match-me/internal/repositories"
 	"match-me/internal/repositories/connections"
 	"match-me/internal/repositories/interactions"
 	"match-me/internal/requests"
+	"match-me/internal/scheduler"
 	inUc "match-me/internal/usecases/interactions"
 	wscore "match-me/internal/websocket"
 
 	"log"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
-func registerRoutes(client *ent.Client, r *gin.Engine, cfg *config.Config, cld cloudinary.Cloudinary) {
+func registerRoutes(client *ent.Client, r *gin.Engine, cfg *config.Config, media storage.MediaStore, fanout broker.Broker, jobs *scheduler.Scheduler) {
 
 	connectionRepo := connections.NewConnectionRepository(client)
 	connectionReqRepo := connections.NewConnectionRequestRepository(client)
@@ -32,9 +43,14 @@ func registerRoutes(client *ent.Client, r *gin.Engine, cfg *config.Config, cld c
 	go typingHub.Run()
 	go statusHub.Run()
 
-	webSocketService := wscore.NewWebSocketService(chatHub, typingHub, statusHub)
+	webSocketService := wscore.NewWebSocketService(chatHub, typingHub, statusHub, fanout, cfg.PresenceHeartbeatInterval, cfg.PresenceLeaseTTL)
+	go webSocketService.Run()
+
 	interactionService := inUc.NewUserInteractionUsecase(interactionRepo)
 	validationService := requests.NewValidationService()
+	mail := mailer.NewMailer(cfg)
+	feedCache := matching.NewFeedCache(cfg.RecommendationCacheTTL)
+	repositories.UseFeedCache(client, feedCache)
 
 	log.Println("🚀 Registering API routes...")
 	userHandler := user.NewUserHandler(
@@ -44,29 +60,107 @@ func registerRoutes(client *ent.Client, r *gin.Engine, cfg *config.Config, cld c
 		connectionReqRepo,
 		interactionService,
 		validationService,
-		cld,
+		media,
+		mail,
+		feedCache,
+		webSocketService,
 	)
 	userHandler.RegisterRoutes(r)
 
+	connectionHandler := connection.NewConnectionHandler(
+		client,
+		cfg,
+		validationService,
+		media,
+		webSocketService,
+		userHandler.UserUsecase,
+		interactionService,
+	)
+	connectionHandler.RegisterRoutes(r)
+
 	webSocketHandler := websocket.NewWebSocketHandler(
 		chatHub,
 		typingHub,
 		statusHub,
+		webSocketService,
 		connectionRepo,
+		connectionHandler.MessageUsecase,
 		userHandler.UserUsecase,
 		cfg,
 	)
 	webSocketHandler.RegisterRoutes(r)
 
-	connectionHandler := connection.NewConnectionHandler(
+	eventHandler := event.NewEventHandler(
 		client,
 		cfg,
-		validationService,
-		cld,
 		webSocketService,
 		userHandler.UserUsecase,
-		interactionService,
 	)
-	connectionHandler.RegisterRoutes(r)
+	eventHandler.RegisterRoutes(r)
+
+	// Messages that arrived while the user was offline count as delivered once they connect
+	webSocketService.OnClientConnected(func(userID uuid.UUID) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, err := connectionHandler.MessageUsecase.DeliverPendingMessages(ctx, userID); err != nil {
+			log.Printf("Failed to deliver pending messages for user %s: %v", userID, err)
+		}
+	})
+
+	// Users only see the presence of the people they are connected with
+	webSocketService.UseContacts(func(userID uuid.UUID) ([]uuid.UUID, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return connectionRepo.GetConnectedUserIDs(ctx, userID)
+	})
+
+	// Last seen is stored when a user goes offline and refreshed while they stay online,
+	// except while they are invisible
+	webSocketService.OnClientDisconnected(func(userID uuid.UUID) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := userHandler.UserUsecase.RecordDisconnect(ctx, userID); err != nil {
+			log.Printf("Failed to record last seen for user %s: %v", userID, err)
+		}
+	})
+	webSocketService.OnUserActivity(func(userIDs []uuid.UUID) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := userHandler.UserUsecase.RecordActivity(ctx, userIDs); err != nil {
+			log.Printf("Failed to record activity of %d users: %v", len(userIDs), err)
+		}
+	})
+	webSocketService.UseStatusLookup(func(userIDs []uuid.UUID) map[uuid.UUID]*models.UserStatus {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		statuses, err := userHandler.UserUsecase.GetStatuses(ctx, userIDs)
+		if err != nil {
+			log.Printf("Failed to look up statuses: %v", err)
+			return map[uuid.UUID]*models.UserStatus{}
+		}
+		return statuses
+	})
+	webSocketService.SetAwayAfter(cfg.PresenceAwayAfter)
+
+	// Messages sent over a chat socket go through the same validation as HTTP sends
+	webSocketService.OnChatMessage(func(ctx context.Context, senderID, connectionID uuid.UUID, send wscore.SendMessageEvent) (*models.Message, error) {
+		return connectionHandler.MessageUsecase.SendTextMessage(ctx, senderID, connectionID, send.Content, send.ReplyToID, send.ClientMessageID)
+	})
+
+	// Expiring requests notifies both users, so the job runs through the usecase
+	jobs.Register(scheduler.Job{
+		Name:     scheduler.JobExpireRequests,
+		Interval: cfg.ExpireRequestsInterval,
+		Timeout:  scheduler.DefaultJobTimeout,
+		Run:      connectionHandler.ConnectionRequestUsecase.ExpireRequests,
+	})
+
+	registerAdminRoutes(r, cfg, userHandler.UserUsecase, jobs)
+
+	// Local media is served by the API itself through signed URLs
+	if localStore, ok := media.(*storage.LocalStore); ok {
+		mediaHandler := mediaAdapter.NewMediaHandler(localStore)
+		mediaHandler.RegisterRoutes(r)
+	}
 
 }
@@ -4,14 +4,16 @@ import (
 	"match-me/api/middleware"
 	"match-me/config"
 	"match-me/ent"
-	"match-me/internal/pkg/cloudinary"
+	"match-me/internal/pkg/broker"
+	"match-me/internal/pkg/storage"
+	"match-me/internal/scheduler"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
-func NewHTTPServer(client *ent.Client, cfg *config.Config, cld cloudinary.Cloudinary) *http.Server {
+func NewHTTPServer(client *ent.Client, cfg *config.Config, media storage.MediaStore, fanout broker.Broker, jobs *scheduler.Scheduler) *http.Server {
 
 	if cfg.AppEnv != "development" {
 		gin.SetMode(gin.ReleaseMode)
@@ -26,7 +28,7 @@ func NewHTTPServer(client *ent.Client, cfg *config.Config, cld cloudinary.Cloudi
 	router.Use(middleware.Ping())
 
 	// Register routes
-	registerRoutes(client, router, cfg, cld)
+	registerRoutes(client, router, cfg, media, fanout, jobs)
 
 	// HTTP server setup
 	srv := &http.Server{
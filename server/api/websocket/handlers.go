@@ -3,10 +3,12 @@ package websocket
 import (
 	"log"
 	"net/http"
+	"strings"
 
 	"match-me/api/middleware"
 	"match-me/config"
 	"match-me/internal/repositories/connections"
+	connectionUsecases "match-me/internal/usecases/connections"
 	userUsecase "match-me/internal/usecases/user"
 	wscore "match-me/internal/websocket"
 
@@ -19,14 +21,18 @@ type WebSocketHandler struct {
 	chatHub        *wscore.ChatHub
 	typingHub      *wscore.TypingHub
 	statusHub      *wscore.StatusHub
+	wsService      *wscore.WebSocketService
 	connectionRepo connections.ConnectionRepository
+	messageUsecase connectionUsecases.MessageUsecase
 	UserUsecase    userUsecase.UserUsecase
 	cfg            *config.Config
 }
 
 // NewWebSocketHandler creates a new WebSocket handler
 func NewWebSocketHandler(chatHub *wscore.ChatHub, typingHub *wscore.TypingHub, statusHub *wscore.StatusHub,
+	wsService *wscore.WebSocketService,
 	connectionRepo connections.ConnectionRepository,
+	messageUsecase connectionUsecases.MessageUsecase,
 	userUsecase userUsecase.UserUsecase,
 	cfg *config.Config,
 ) *WebSocketHandler {
@@ -34,8 +40,10 @@ func NewWebSocketHandler(chatHub *wscore.ChatHub, typingHub *wscore.TypingHub, s
 		chatHub:        chatHub,
 		typingHub:      typingHub,
 		statusHub:      statusHub,
+		wsService:      wsService,
 		UserUsecase:    userUsecase,
 		connectionRepo: connectionRepo,
+		messageUsecase: messageUsecase,
 		cfg:            cfg,
 	}
 }
@@ -101,30 +109,42 @@ func (h *WebSocketHandler) HandleTypingConnection(c *gin.Context) {
 	wscore.ServeTypingWS(h.typingHub, c, user.ID, connectionID)
 }
 
+// HandleMultiplexConnection handles a single WebSocket connection that subscribes to
+// status, chat and typing channels on demand
+func (h *WebSocketHandler) HandleMultiplexConnection(c *gin.Context) {
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	log.Printf("🔀 Starting multiplexed WebSocket upgrade for user %s", user.ID)
+	wscore.ServeMultiplexWS(h.chatHub, h.typingHub, h.statusHub, c, user.ID, h.messageUsecase.CheckConnectionAccess)
+}
+
 // validateConnectionAccess verifies that a user has access to a connection
 func (h *WebSocketHandler) validateConnectionAccess(c *gin.Context, userID, connectionID uuid.UUID) bool {
-	connection, err := h.connectionRepo.GetConnection(c.Request.Context(), connectionID)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Connection not found"})
-		return false
+	err := h.messageUsecase.CheckConnectionAccess(c.Request.Context(), userID, connectionID)
+	if err == nil {
+		return true
 	}
 
-	if connection.Status != "connected" {
+	switch {
+	case err.Error() == "connection not found":
+		c.JSON(http.StatusNotFound, gin.H{"error": "Connection not found"})
+	case err.Error() == "connection is not active":
 		c.JSON(http.StatusForbidden, gin.H{"error": "Connection is not active"})
-		return false
-	}
-
-	if connection.UserAID != userID && connection.UserBID != userID {
+	case strings.HasPrefix(err.Error(), "unauthorized:"):
 		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied to this connection"})
-		return false
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check connection access"})
 	}
-
-	return true
+	return false
 }
 
-// GetOnlineUsers returns list of online users (for debugging/admin)
+// GetOnlineUsers returns list of online users across all nodes (admin only)
 func (h *WebSocketHandler) GetOnlineUsers(c *gin.Context) {
-	onlineUsers := h.statusHub.GetOnlineUsers()
+	onlineUsers := h.wsService.GetOnlineUsers()
 	c.JSON(http.StatusOK, gin.H{
 		"online_users": onlineUsers,
 		"count":        len(onlineUsers),
@@ -156,12 +176,18 @@ func (h *WebSocketHandler) GetConnectionStatus(c *gin.Context) {
 		return
 	}
 
+	// Invisible users appear offline to the other side
 	userStatuses := make(map[string]bool)
-	userStatuses[connection.UserAID.String()] = h.statusHub.IsUserOnline(connection.UserAID)
-	userStatuses[connection.UserBID.String()] = h.statusHub.IsUserOnline(connection.UserBID)
+	statuses := make(map[string]string)
+	for _, userID := range []uuid.UUID{connection.UserAID, connection.UserBID} {
+		status := h.wsService.GetUserStatus(userID)
+		statuses[userID.String()] = status
+		userStatuses[userID.String()] = status != "offline"
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"connection_id": connectionID,
 		"users":         userStatuses,
+		"statuses":      statuses,
 	})
 }
@@ -14,6 +14,11 @@ func (ws *WebSocketHandler) RegisterRoutes(r *gin.Engine) *gin.Engine {
 	wsGroup := r.Group("/ws")
 	wsGroup.Use(middleware.VerifyUser(ws.UserUsecase, ws.cfg.JWTSecret))
 	{
+		// Multiplexed WebSocket - one socket subscribing to status, chat and typing channels
+		wsGroup.GET("", ws.HandleMultiplexConnection)
+
+		// The per-purpose sockets below remain available for older clients
+
 		// Chat WebSocket - for real-time messaging in a specific connection
 		wsGroup.GET("/chat/:connectionId", ws.HandleChatConnection)
 
@@ -23,8 +28,8 @@ func (ws *WebSocketHandler) RegisterRoutes(r *gin.Engine) *gin.Engine {
 		// Typing WebSocket - for typing indicators in a specific connection
 		wsGroup.GET("/typing/:connectionId", ws.HandleTypingConnection)
 
-		// Status endpoints (HTTP endpoints for debugging/admin)
-		wsGroup.GET("/online-users", ws.GetOnlineUsers)
+		// Status endpoints; the global online list is for admins only
+		wsGroup.GET("/online-users", middleware.RequireAdmin(), ws.GetOnlineUsers)
 		wsGroup.GET("/connection/:connectionId/status", ws.GetConnectionStatus)
 	}
 	log.Println("💫 All websocket routes registered")
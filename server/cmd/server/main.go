@@ -7,13 +7,16 @@ import (
 	"log"
 	"match-me/api"
 	"match-me/config"
-	"match-me/internal/pkg/cloudinary"
+	"match-me/internal/pkg/broker"
 	"match-me/internal/pkg/seed"
+	"match-me/internal/pkg/storage"
 	"match-me/internal/repositories"
+	"match-me/internal/scheduler"
 	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 )
@@ -28,6 +31,7 @@ func main() {
 	var populateFlag = flag.String("p", "", "Populate database with n users (e.g., -p 500")
 	var resetFlag = flag.Bool("r", false, "Reset database (delete all data)")
 	var resetPopulateFlag = flag.String("rp", "", "Reset database and populate with n users (e.g., -rp 500")
+	var runJobFlag = flag.String("run-job", "", "Run a background job once and exit (e.g., -run-job expire-requests)")
 	var helpFlag = flag.Bool("h", false, "Show help and usage information")
 	flag.Parse()
 
@@ -91,16 +95,48 @@ func main() {
 		return
 	}
 
+	// Set up background jobs
+	jobs := scheduler.New(client, cfg.SchedulerJitter)
+	scheduler.RegisterJobs(jobs, client, cfg)
+
 	// set up media storage
-	cld := cloudinary.NewCloudinary()
+	media, err := storage.NewMediaStore(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize media store: %v", err)
+	}
+	repositories.UseMediaStore(client, media)
+	log.Printf("Media store initialized (%s)", cfg.MediaBackend)
 
-	// Initialize and start HTTP server
-	srv := api.NewHTTPServer(client, cfg, cld)
-	handleServerLifecycle(srv)
+	// set up WebSocket fan-out between server nodes
+	fanout, err := broker.NewBroker(cfg, client)
+	if err != nil {
+		log.Fatalf("Failed to initialize broker: %v", err)
+	}
+	defer fanout.Close()
+	log.Printf("Broker initialized (%s)", cfg.BrokerBackend)
+
+	// Initialize HTTP server, which also registers the jobs that need its services
+	srv := api.NewHTTPServer(client, cfg, media, fanout, jobs)
+
+	// Handle run job flag
+	if *runJobFlag != "" {
+		// The job's own timeout applies, not what is left of the init timeout
+		affected, err := jobs.RunNow(context.Background(), *runJobFlag)
+		if err != nil {
+			log.Fatalf("Failed to run job %s: %v (available jobs: %s)", *runJobFlag, err, strings.Join(jobs.JobNames(), ", "))
+		}
+		log.Printf("Job %s completed: %d rows affected", *runJobFlag, affected)
+		return
+	}
+
+	if cfg.SchedulerEnabled {
+		jobs.Start(context.Background())
+	}
+	handleServerLifecycle(srv, jobs)
 }
 
-// handleServerLifecycle manages the lifecycle of the HTTP server
-func handleServerLifecycle(srv *http.Server) {
+// handleServerLifecycle manages the lifecycle of the HTTP server and the background jobs
+func handleServerLifecycle(srv *http.Server, jobs *scheduler.Scheduler) {
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 
@@ -119,6 +155,9 @@ func handleServerLifecycle(srv *http.Server) {
 	case <-stop:
 		log.Println("Shutdown signal received. Shutting down server...")
 
+		// Stop scheduling new job runs and let running ones finish
+		jobs.Stop()
+
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
 		defer cancel()
 
@@ -144,7 +183,8 @@ func printUsage() {
 	fmt.Println("  -r          Reset database (delete all data)")
 	fmt.Println("  -p n        Populate database with n users (1-500")
 	fmt.Println("  -rp n       Reset database and populate with n users (1-500")
+	fmt.Println("  -run-job j  Run background job j once (expire-requests, cleanup-interactions, cleanup-sessions)")
 	fmt.Println()
-	fmt.Println("NOTE: Database operations (-r, -p, -rp) will exit after completion.")
+	fmt.Println("NOTE: Database operations (-r, -p, -rp) and -run-job will exit after completion.")
 	fmt.Println("      Only use -p or -rp when you want to add test data.")
 }
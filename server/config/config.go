@@ -2,6 +2,7 @@ package config
 
 import (
 	"sync"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -24,8 +25,70 @@ func LoadConfig() *Config {
 			JWTSecret:     mustGetEnv("JWT_SECRET"),
 			ClientAddr:    getEnvStrArray("CLIENT_ADDR", []string{"*"}),
 			CloudinaryUrl: getEnvStr("CLOUDINARY_URL", ""),
+
+			AccessTokenTTL:  getEnvDuration("ACCESS_TOKEN_TTL", 15*time.Minute),
+			RefreshTokenTTL: getEnvDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour),
+			ResetTokenTTL:   getEnvDuration("RESET_TOKEN_TTL", time.Hour),
+			VerifyTokenTTL:  getEnvDuration("VERIFY_TOKEN_TTL", 48*time.Hour),
+			VerifyResendGap: getEnvDuration("VERIFY_RESEND_INTERVAL", 2*time.Minute),
+			FrontendURL:     getEnvStr("FRONTEND_URL", "http://localhost:5173"),
+
+			MailDriver:   getEnvStr("MAIL_DRIVER", "log"),
+			MailFrom:     getEnvStr("MAIL_FROM", "Match-Me <no-reply@matchme.local>"),
+			MailLogDir:   getEnvStr("MAIL_LOG_DIR", ""),
+			SMTPHost:     getEnvStr("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnvStr("SMTP_PORT", "587"),
+			SMTPUsername: getEnvStr("SMTP_USERNAME", ""),
+			SMTPPassword: getEnvStr("SMTP_PASSWORD", ""),
 		}
+
+		cfg.MediaBackend = getEnvStr("MEDIA_BACKEND", defaultMediaBackend(cfg.CloudinaryUrl))
+		cfg.MediaLocalDir = getEnvStr("MEDIA_LOCAL_DIR", "./uploads")
+		cfg.MediaBaseURL = getEnvStr("MEDIA_BASE_URL", cfg.Host+":"+cfg.Port)
+		cfg.MediaURLSecret = getEnvStr("MEDIA_URL_SECRET", "")
+		cfg.MediaURLTTL = getEnvDuration("MEDIA_URL_TTL", time.Hour)
+		cfg.S3Endpoint = getEnvStr("S3_ENDPOINT", "")
+		cfg.S3Region = getEnvStr("S3_REGION", "us-east-1")
+		cfg.S3Bucket = getEnvStr("S3_BUCKET", "")
+		cfg.S3AccessKey = getEnvStr("S3_ACCESS_KEY", "")
+		cfg.S3SecretKey = getEnvStr("S3_SECRET_KEY", "")
+		cfg.S3PublicURL = getEnvStr("S3_PUBLIC_URL", "")
+		cfg.S3PathStyle = getEnvBool("S3_PATH_STYLE", true)
+
+		cfg.PhotoMaxBytes = int64(getEnvInt("PHOTO_MAX_BYTES", 10<<20))
+		cfg.PhotoMaxDimension = getEnvInt("PHOTO_MAX_DIMENSION", 6000)
+		cfg.PhotoMaxPixels = getEnvInt("PHOTO_MAX_PIXELS", 16_000_000)
+		cfg.PhotoMinDimension = getEnvInt("PHOTO_MIN_DIMENSION", 200)
+		cfg.MaxPhotosPerUser = getEnvInt("MAX_PHOTOS_PER_USER", 6)
+
+		cfg.RecommendationPageSize = getEnvInt("RECOMMENDATION_PAGE_SIZE", 20)
+		cfg.RecommendationCacheTTL = getEnvDuration("RECOMMENDATION_CACHE_TTL", 10*time.Minute)
+
+		cfg.ConnectionRequestTTL = getEnvDuration("CONNECTION_REQUEST_TTL", 30*24*time.Hour)
+		cfg.ConnectionRequestMaxTTL = getEnvDuration("CONNECTION_REQUEST_MAX_TTL", 90*24*time.Hour)
+
+		cfg.MessageEditWindow = getEnvDuration("MESSAGE_EDIT_WINDOW", 15*time.Minute)
+
+		cfg.BrokerBackend = getEnvStr("WS_BROKER", "memory")
+		cfg.PresenceHeartbeatInterval = getEnvDuration("PRESENCE_HEARTBEAT_INTERVAL", 15*time.Second)
+		cfg.PresenceLeaseTTL = getEnvDuration("PRESENCE_LEASE_TTL", 45*time.Second)
+		cfg.LastSeenInterval = getEnvDuration("LAST_SEEN_INTERVAL", time.Minute)
+		cfg.PresenceAwayAfter = getEnvDuration("PRESENCE_AWAY_AFTER", 5*time.Minute)
+
+		cfg.SchedulerEnabled = getEnvBool("SCHEDULER_ENABLED", true)
+		cfg.SchedulerJitter = getEnvDuration("SCHEDULER_JITTER", time.Minute)
+		cfg.ExpireRequestsInterval = getEnvDuration("JOB_EXPIRE_REQUESTS_INTERVAL", time.Hour)
+		cfg.CleanupInteractionsInterval = getEnvDuration("JOB_CLEANUP_INTERACTIONS_INTERVAL", 6*time.Hour)
+		cfg.CleanupSessionsInterval = getEnvDuration("JOB_CLEANUP_SESSIONS_INTERVAL", 6*time.Hour)
 	})
 
 	return cfg
 }
+
+// defaultMediaBackend keeps Cloudinary for existing setups and falls back to local storage
+func defaultMediaBackend(cloudinaryURL string) string {
+	if cloudinaryURL != "" {
+		return "cloudinary"
+	}
+	return "local"
+}
@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"log"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
@@ -16,6 +18,69 @@ type Config struct {
 	JWTSecret     string
 	ClientAddr    []string
 	CloudinaryUrl string
+
+	// Auth
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	ResetTokenTTL   time.Duration
+	VerifyTokenTTL  time.Duration
+	VerifyResendGap time.Duration
+	FrontendURL     string
+
+	// Mail
+	MailDriver   string
+	MailFrom     string
+	MailLogDir   string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+
+	// Media storage
+	MediaBackend   string
+	MediaLocalDir  string
+	MediaBaseURL   string
+	MediaURLSecret string
+	MediaURLTTL    time.Duration
+	S3Endpoint     string
+	S3Region       string
+	S3Bucket       string
+	S3AccessKey    string
+	S3SecretKey    string
+	S3PublicURL    string
+	S3PathStyle    bool
+
+	// Photo processing
+	PhotoMaxBytes     int64
+	PhotoMaxDimension int
+	PhotoMaxPixels    int
+	PhotoMinDimension int
+	MaxPhotosPerUser  int
+
+	// Recommendations
+	RecommendationPageSize int
+	RecommendationCacheTTL time.Duration
+
+	// Connection requests
+	ConnectionRequestTTL    time.Duration
+	ConnectionRequestMaxTTL time.Duration
+
+	// Messages
+	MessageEditWindow time.Duration
+
+	// WebSocket fan-out and presence
+	BrokerBackend             string
+	PresenceHeartbeatInterval time.Duration
+	PresenceLeaseTTL          time.Duration
+	LastSeenInterval          time.Duration
+	PresenceAwayAfter         time.Duration
+
+	// Background jobs
+	SchedulerEnabled            bool
+	SchedulerJitter             time.Duration
+	ExpireRequestsInterval      time.Duration
+	CleanupInteractionsInterval time.Duration
+	CleanupSessionsInterval     time.Duration
 }
 
 // Helper function to get required environment variable as string
@@ -58,3 +123,51 @@ func getEnvStrArray(key string, defaultVal []string) []string {
 	}
 	return parts
 }
+
+// Helper function to get environment variable as time.Duration (e.g. "15m", "720h") with default
+func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val, exists := os.LookupEnv(key)
+	if !exists || val == "" {
+		log.Printf("Warning: No value found for '%s', using default value", key)
+		return defaultVal
+	}
+
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		log.Printf("Warning: Invalid duration '%s' for '%s', using default value", val, key)
+		return defaultVal
+	}
+	return d
+}
+
+// Helper function to get environment variable as bool with default
+func getEnvBool(key string, defaultVal bool) bool {
+	val, exists := os.LookupEnv(key)
+	if !exists || val == "" {
+		log.Printf("Warning: No value found for '%s', using default value", key)
+		return defaultVal
+	}
+
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		log.Printf("Warning: Invalid bool '%s' for '%s', using default value", val, key)
+		return defaultVal
+	}
+	return b
+}
+
+// Helper function to get environment variable as int with default
+func getEnvInt(key string, defaultVal int) int {
+	val, exists := os.LookupEnv(key)
+	if !exists || val == "" {
+		log.Printf("Warning: No value found for '%s', using default value", key)
+		return defaultVal
+	}
+
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		log.Printf("Warning: Invalid int '%s' for '%s', using default value", val, key)
+		return defaultVal
+	}
+	return n
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"match-me/ent/brokerpayload"
+	"match-me/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BrokerPayloadDelete is the builder for deleting a BrokerPayload entity.
+type BrokerPayloadDelete struct {
+	config
+	hooks    []Hook
+	mutation *BrokerPayloadMutation
+}
+
+// Where appends a list predicates to the BrokerPayloadDelete builder.
+func (_d *BrokerPayloadDelete) Where(ps ...predicate.BrokerPayload) *BrokerPayloadDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BrokerPayloadDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BrokerPayloadDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BrokerPayloadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(brokerpayload.Table, sqlgraph.NewFieldSpec(brokerpayload.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BrokerPayloadDeleteOne is the builder for deleting a single BrokerPayload entity.
+type BrokerPayloadDeleteOne struct {
+	_d *BrokerPayloadDelete
+}
+
+// Where appends a list predicates to the BrokerPayloadDelete builder.
+func (_d *BrokerPayloadDeleteOne) Where(ps ...predicate.BrokerPayload) *BrokerPayloadDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BrokerPayloadDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{brokerpayload.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BrokerPayloadDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"match-me/ent/brokerpayload"
+	"match-me/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BrokerPayloadUpdate is the builder for updating BrokerPayload entities.
+type BrokerPayloadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BrokerPayloadMutation
+}
+
+// Where appends a list predicates to the BrokerPayloadUpdate builder.
+func (_u *BrokerPayloadUpdate) Where(ps ...predicate.BrokerPayload) *BrokerPayloadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the BrokerPayloadMutation object of the builder.
+func (_u *BrokerPayloadUpdate) Mutation() *BrokerPayloadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BrokerPayloadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BrokerPayloadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BrokerPayloadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BrokerPayloadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BrokerPayloadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(brokerpayload.Table, brokerpayload.Columns, sqlgraph.NewFieldSpec(brokerpayload.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{brokerpayload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BrokerPayloadUpdateOne is the builder for updating a single BrokerPayload entity.
+type BrokerPayloadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BrokerPayloadMutation
+}
+
+// Mutation returns the BrokerPayloadMutation object of the builder.
+func (_u *BrokerPayloadUpdateOne) Mutation() *BrokerPayloadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BrokerPayloadUpdate builder.
+func (_u *BrokerPayloadUpdateOne) Where(ps ...predicate.BrokerPayload) *BrokerPayloadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BrokerPayloadUpdateOne) Select(field string, fields ...string) *BrokerPayloadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BrokerPayload entity.
+func (_u *BrokerPayloadUpdateOne) Save(ctx context.Context) (*BrokerPayload, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BrokerPayloadUpdateOne) SaveX(ctx context.Context) *BrokerPayload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BrokerPayloadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BrokerPayloadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BrokerPayloadUpdateOne) sqlSave(ctx context.Context) (_node *BrokerPayload, err error) {
+	_spec := sqlgraph.NewUpdateSpec(brokerpayload.Table, brokerpayload.Columns, sqlgraph.NewFieldSpec(brokerpayload.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BrokerPayload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, brokerpayload.FieldID)
+		for _, f := range fields {
+			if !brokerpayload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != brokerpayload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &BrokerPayload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{brokerpayload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -43,9 +43,11 @@ type ConnectionEdges struct {
 	UserA *User `json:"user_a,omitempty"`
 	// Reference to the second user in the connection
 	UserB *User `json:"user_b,omitempty"`
+	// Read watermarks of the users in the connection
+	ReadMarkers []*ReadMarker `json:"read_markers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // UserAOrErr returns the UserA value or an error if the edge
@@ -70,6 +72,15 @@ func (e ConnectionEdges) UserBOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user_b"}
 }
 
+// ReadMarkersOrErr returns the ReadMarkers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConnectionEdges) ReadMarkersOrErr() ([]*ReadMarker, error) {
+	if e.loadedTypes[2] {
+		return e.ReadMarkers, nil
+	}
+	return nil, &NotLoadedError{edge: "read_markers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Connection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -161,6 +172,11 @@ func (_m *Connection) QueryUserB() *UserQuery {
 	return NewConnectionClient(_m.config).QueryUserB(_m)
 }
 
+// QueryReadMarkers queries the "read_markers" edge of the Connection entity.
+func (_m *Connection) QueryReadMarkers() *ReadMarkerQuery {
+	return NewConnectionClient(_m.config).QueryReadMarkers(_m)
+}
+
 // Update returns a builder for updating this Connection.
 // Note that you need to call Connection.Unwrap() before calling this method if this Connection
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -32,6 +32,8 @@ const (
 	EdgeUserA = "user_a"
 	// EdgeUserB holds the string denoting the user_b edge name in mutations.
 	EdgeUserB = "user_b"
+	// EdgeReadMarkers holds the string denoting the read_markers edge name in mutations.
+	EdgeReadMarkers = "read_markers"
 	// Table holds the table name of the connection in the database.
 	Table = "connections"
 	// UserATable is the table that holds the user_a relation/edge.
@@ -48,6 +50,13 @@ const (
 	UserBInverseTable = "users"
 	// UserBColumn is the table column denoting the user_b relation/edge.
 	UserBColumn = "user_b_id"
+	// ReadMarkersTable is the table that holds the read_markers relation/edge.
+	ReadMarkersTable = "read_markers"
+	// ReadMarkersInverseTable is the table name for the ReadMarker entity.
+	// It exists in this package in order to avoid circular dependency with the "readmarker" package.
+	ReadMarkersInverseTable = "read_markers"
+	// ReadMarkersColumn is the table column denoting the read_markers relation/edge.
+	ReadMarkersColumn = "connection_id"
 )
 
 // Columns holds all SQL columns for connection fields.
@@ -156,6 +165,20 @@ func ByUserBField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserBStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByReadMarkersCount orders the results by read_markers count.
+func ByReadMarkersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReadMarkersStep(), opts...)
+	}
+}
+
+// ByReadMarkers orders the results by read_markers terms.
+func ByReadMarkers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReadMarkersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserAStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -170,3 +193,10 @@ func newUserBStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, UserBTable, UserBColumn),
 	)
 }
+func newReadMarkersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReadMarkersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReadMarkersTable, ReadMarkersColumn),
+	)
+}
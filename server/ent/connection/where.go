@@ -317,6 +317,29 @@ func HasUserBWith(preds ...predicate.User) predicate.Connection {
 	})
 }
 
+// HasReadMarkers applies the HasEdge predicate on the "read_markers" edge.
+func HasReadMarkers() predicate.Connection {
+	return predicate.Connection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReadMarkersTable, ReadMarkersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReadMarkersWith applies the HasEdge predicate on the "read_markers" edge with a given conditions (other predicates).
+func HasReadMarkersWith(preds ...predicate.ReadMarker) predicate.Connection {
+	return predicate.Connection(func(s *sql.Selector) {
+		step := newReadMarkersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Connection) predicate.Connection {
 	return predicate.Connection(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"match-me/ent/connection"
+	"match-me/ent/readmarker"
 	"match-me/ent/user"
 	"time"
 
@@ -106,6 +107,21 @@ func (_c *ConnectionCreate) SetUserB(v *User) *ConnectionCreate {
 	return _c.SetUserBID(v.ID)
 }
 
+// AddReadMarkerIDs adds the "read_markers" edge to the ReadMarker entity by IDs.
+func (_c *ConnectionCreate) AddReadMarkerIDs(ids ...uuid.UUID) *ConnectionCreate {
+	_c.mutation.AddReadMarkerIDs(ids...)
+	return _c
+}
+
+// AddReadMarkers adds the "read_markers" edges to the ReadMarker entity.
+func (_c *ConnectionCreate) AddReadMarkers(v ...*ReadMarker) *ConnectionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReadMarkerIDs(ids...)
+}
+
 // Mutation returns the ConnectionMutation object of the builder.
 func (_c *ConnectionCreate) Mutation() *ConnectionMutation {
 	return _c.mutation
@@ -268,6 +284,22 @@ func (_c *ConnectionCreate) createSpec() (*Connection, *sqlgraph.CreateSpec) {
 		_node.UserBID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReadMarkersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ReadMarkersTable,
+			Columns: []string{connection.ReadMarkersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
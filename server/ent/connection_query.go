@@ -4,13 +4,16 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"match-me/ent/connection"
 	"match-me/ent/predicate"
+	"match-me/ent/readmarker"
 	"match-me/ent/user"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -20,12 +23,14 @@ import (
 // ConnectionQuery is the builder for querying Connection entities.
 type ConnectionQuery struct {
 	config
-	ctx        *QueryContext
-	order      []connection.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Connection
-	withUserA  *UserQuery
-	withUserB  *UserQuery
+	ctx             *QueryContext
+	order           []connection.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Connection
+	withUserA       *UserQuery
+	withUserB       *UserQuery
+	withReadMarkers *ReadMarkerQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +111,28 @@ func (_q *ConnectionQuery) QueryUserB() *UserQuery {
 	return query
 }
 
+// QueryReadMarkers chains the current query on the "read_markers" edge.
+func (_q *ConnectionQuery) QueryReadMarkers() *ReadMarkerQuery {
+	query := (&ReadMarkerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, selector),
+			sqlgraph.To(readmarker.Table, readmarker.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connection.ReadMarkersTable, connection.ReadMarkersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Connection entity from the query.
 // Returns a *NotFoundError when no Connection was found.
 func (_q *ConnectionQuery) First(ctx context.Context) (*Connection, error) {
@@ -293,13 +320,14 @@ func (_q *ConnectionQuery) Clone() *ConnectionQuery {
 		return nil
 	}
 	return &ConnectionQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]connection.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Connection{}, _q.predicates...),
-		withUserA:  _q.withUserA.Clone(),
-		withUserB:  _q.withUserB.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]connection.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.Connection{}, _q.predicates...),
+		withUserA:       _q.withUserA.Clone(),
+		withUserB:       _q.withUserB.Clone(),
+		withReadMarkers: _q.withReadMarkers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -328,6 +356,17 @@ func (_q *ConnectionQuery) WithUserB(opts ...func(*UserQuery)) *ConnectionQuery
 	return _q
 }
 
+// WithReadMarkers tells the query-builder to eager-load the nodes that are connected to
+// the "read_markers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ConnectionQuery) WithReadMarkers(opts ...func(*ReadMarkerQuery)) *ConnectionQuery {
+	query := (&ReadMarkerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReadMarkers = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +445,10 @@ func (_q *ConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 	var (
 		nodes       = []*Connection{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withUserA != nil,
 			_q.withUserB != nil,
+			_q.withReadMarkers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -420,6 +460,9 @@ func (_q *ConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -441,6 +484,13 @@ func (_q *ConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 			return nil, err
 		}
 	}
+	if query := _q.withReadMarkers; query != nil {
+		if err := _q.loadReadMarkers(ctx, query, nodes,
+			func(n *Connection) { n.Edges.ReadMarkers = []*ReadMarker{} },
+			func(n *Connection, e *ReadMarker) { n.Edges.ReadMarkers = append(n.Edges.ReadMarkers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,9 +552,42 @@ func (_q *ConnectionQuery) loadUserB(ctx context.Context, query *UserQuery, node
 	}
 	return nil
 }
+func (_q *ConnectionQuery) loadReadMarkers(ctx context.Context, query *ReadMarkerQuery, nodes []*Connection, init func(*Connection), assign func(*Connection, *ReadMarker)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Connection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(readmarker.FieldConnectionID)
+	}
+	query.Where(predicate.ReadMarker(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(connection.ReadMarkersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConnectionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "connection_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ConnectionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -573,6 +656,9 @@ func (_q *ConnectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -590,6 +676,32 @@ func (_q *ConnectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *ConnectionQuery) ForUpdate(opts ...sql.LockOption) *ConnectionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *ConnectionQuery) ForShare(opts ...sql.LockOption) *ConnectionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // ConnectionGroupBy is the group-by builder for Connection entities.
 type ConnectionGroupBy struct {
 	selector
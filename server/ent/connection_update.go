@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"match-me/ent/connection"
 	"match-me/ent/predicate"
+	"match-me/ent/readmarker"
 	"match-me/ent/user"
 	"time"
 
@@ -122,6 +123,21 @@ func (_u *ConnectionUpdate) SetUserB(v *User) *ConnectionUpdate {
 	return _u.SetUserBID(v.ID)
 }
 
+// AddReadMarkerIDs adds the "read_markers" edge to the ReadMarker entity by IDs.
+func (_u *ConnectionUpdate) AddReadMarkerIDs(ids ...uuid.UUID) *ConnectionUpdate {
+	_u.mutation.AddReadMarkerIDs(ids...)
+	return _u
+}
+
+// AddReadMarkers adds the "read_markers" edges to the ReadMarker entity.
+func (_u *ConnectionUpdate) AddReadMarkers(v ...*ReadMarker) *ConnectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadMarkerIDs(ids...)
+}
+
 // Mutation returns the ConnectionMutation object of the builder.
 func (_u *ConnectionUpdate) Mutation() *ConnectionMutation {
 	return _u.mutation
@@ -139,6 +155,27 @@ func (_u *ConnectionUpdate) ClearUserB() *ConnectionUpdate {
 	return _u
 }
 
+// ClearReadMarkers clears all "read_markers" edges to the ReadMarker entity.
+func (_u *ConnectionUpdate) ClearReadMarkers() *ConnectionUpdate {
+	_u.mutation.ClearReadMarkers()
+	return _u
+}
+
+// RemoveReadMarkerIDs removes the "read_markers" edge to ReadMarker entities by IDs.
+func (_u *ConnectionUpdate) RemoveReadMarkerIDs(ids ...uuid.UUID) *ConnectionUpdate {
+	_u.mutation.RemoveReadMarkerIDs(ids...)
+	return _u
+}
+
+// RemoveReadMarkers removes "read_markers" edges to ReadMarker entities.
+func (_u *ConnectionUpdate) RemoveReadMarkers(v ...*ReadMarker) *ConnectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReadMarkerIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ConnectionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -276,6 +313,51 @@ func (_u *ConnectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReadMarkersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ReadMarkersTable,
+			Columns: []string{connection.ReadMarkersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReadMarkersIDs(); len(nodes) > 0 && !_u.mutation.ReadMarkersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ReadMarkersTable,
+			Columns: []string{connection.ReadMarkersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReadMarkersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ReadMarkersTable,
+			Columns: []string{connection.ReadMarkersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{connection.Label}
@@ -388,6 +470,21 @@ func (_u *ConnectionUpdateOne) SetUserB(v *User) *ConnectionUpdateOne {
 	return _u.SetUserBID(v.ID)
 }
 
+// AddReadMarkerIDs adds the "read_markers" edge to the ReadMarker entity by IDs.
+func (_u *ConnectionUpdateOne) AddReadMarkerIDs(ids ...uuid.UUID) *ConnectionUpdateOne {
+	_u.mutation.AddReadMarkerIDs(ids...)
+	return _u
+}
+
+// AddReadMarkers adds the "read_markers" edges to the ReadMarker entity.
+func (_u *ConnectionUpdateOne) AddReadMarkers(v ...*ReadMarker) *ConnectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadMarkerIDs(ids...)
+}
+
 // Mutation returns the ConnectionMutation object of the builder.
 func (_u *ConnectionUpdateOne) Mutation() *ConnectionMutation {
 	return _u.mutation
@@ -405,6 +502,27 @@ func (_u *ConnectionUpdateOne) ClearUserB() *ConnectionUpdateOne {
 	return _u
 }
 
+// ClearReadMarkers clears all "read_markers" edges to the ReadMarker entity.
+func (_u *ConnectionUpdateOne) ClearReadMarkers() *ConnectionUpdateOne {
+	_u.mutation.ClearReadMarkers()
+	return _u
+}
+
+// RemoveReadMarkerIDs removes the "read_markers" edge to ReadMarker entities by IDs.
+func (_u *ConnectionUpdateOne) RemoveReadMarkerIDs(ids ...uuid.UUID) *ConnectionUpdateOne {
+	_u.mutation.RemoveReadMarkerIDs(ids...)
+	return _u
+}
+
+// RemoveReadMarkers removes "read_markers" edges to ReadMarker entities.
+func (_u *ConnectionUpdateOne) RemoveReadMarkers(v ...*ReadMarker) *ConnectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReadMarkerIDs(ids...)
+}
+
 // Where appends a list predicates to the ConnectionUpdate builder.
 func (_u *ConnectionUpdateOne) Where(ps ...predicate.Connection) *ConnectionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -572,6 +690,51 @@ func (_u *ConnectionUpdateOne) sqlSave(ctx context.Context) (_node *Connection,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReadMarkersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ReadMarkersTable,
+			Columns: []string{connection.ReadMarkersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReadMarkersIDs(); len(nodes) > 0 && !_u.mutation.ReadMarkersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ReadMarkersTable,
+			Columns: []string{connection.ReadMarkersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReadMarkersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ReadMarkersTable,
+			Columns: []string{connection.ReadMarkersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Connection{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,9 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"match-me/ent/brokerpayload"
 	"match-me/ent/connection"
 	"match-me/ent/connectionrequest"
+	"match-me/ent/event"
+	"match-me/ent/eventparticipant"
 	"match-me/ent/message"
+	"match-me/ent/messageedit"
+	"match-me/ent/messagereaction"
+	"match-me/ent/presencelease"
+	"match-me/ent/readmarker"
+	"match-me/ent/session"
 	"match-me/ent/user"
 	"match-me/ent/userinteraction"
 	"match-me/ent/userphoto"
@@ -78,9 +86,17 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			brokerpayload.Table:     brokerpayload.ValidColumn,
 			connection.Table:        connection.ValidColumn,
 			connectionrequest.Table: connectionrequest.ValidColumn,
+			event.Table:             event.ValidColumn,
+			eventparticipant.Table:  eventparticipant.ValidColumn,
 			message.Table:           message.ValidColumn,
+			messageedit.Table:       messageedit.ValidColumn,
+			messagereaction.Table:   messagereaction.ValidColumn,
+			presencelease.Table:     presencelease.ValidColumn,
+			readmarker.Table:        readmarker.ValidColumn,
+			session.Table:           session.ValidColumn,
 			user.Table:              user.ValidColumn,
 			userinteraction.Table:   userinteraction.ValidColumn,
 			userphoto.Table:         userphoto.ValidColumn,
@@ -0,0 +1,913 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"match-me/ent/event"
+	"match-me/ent/eventparticipant"
+	"match-me/ent/predicate"
+	"match-me/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EventUpdate is the builder for updating Event entities.
+type EventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// Where appends a list predicates to the EventUpdate builder.
+func (_u *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *EventUpdate) SetTitle(v string) *EventUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableTitle(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventUpdate) SetDescription(v string) *EventUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableDescription(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *EventUpdate) ClearDescription() *EventUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *EventUpdate) SetEventType(v string) *EventUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableEventType(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *EventUpdate) ClearEventType() *EventUpdate {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EventUpdate) SetLocation(v *schema.Point) *EventUpdate {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetLocationName sets the "location_name" field.
+func (_u *EventUpdate) SetLocationName(v string) *EventUpdate {
+	_u.mutation.SetLocationName(v)
+	return _u
+}
+
+// SetNillableLocationName sets the "location_name" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableLocationName(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetLocationName(*v)
+	}
+	return _u
+}
+
+// ClearLocationName clears the value of the "location_name" field.
+func (_u *EventUpdate) ClearLocationName() *EventUpdate {
+	_u.mutation.ClearLocationName()
+	return _u
+}
+
+// SetMaxParticipants sets the "max_participants" field.
+func (_u *EventUpdate) SetMaxParticipants(v int) *EventUpdate {
+	_u.mutation.ResetMaxParticipants()
+	_u.mutation.SetMaxParticipants(v)
+	return _u
+}
+
+// SetNillableMaxParticipants sets the "max_participants" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableMaxParticipants(v *int) *EventUpdate {
+	if v != nil {
+		_u.SetMaxParticipants(*v)
+	}
+	return _u
+}
+
+// AddMaxParticipants adds value to the "max_participants" field.
+func (_u *EventUpdate) AddMaxParticipants(v int) *EventUpdate {
+	_u.mutation.AddMaxParticipants(v)
+	return _u
+}
+
+// ClearMaxParticipants clears the value of the "max_participants" field.
+func (_u *EventUpdate) ClearMaxParticipants() *EventUpdate {
+	_u.mutation.ClearMaxParticipants()
+	return _u
+}
+
+// SetCurrentParticipants sets the "current_participants" field.
+func (_u *EventUpdate) SetCurrentParticipants(v int) *EventUpdate {
+	_u.mutation.ResetCurrentParticipants()
+	_u.mutation.SetCurrentParticipants(v)
+	return _u
+}
+
+// SetNillableCurrentParticipants sets the "current_participants" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableCurrentParticipants(v *int) *EventUpdate {
+	if v != nil {
+		_u.SetCurrentParticipants(*v)
+	}
+	return _u
+}
+
+// AddCurrentParticipants adds value to the "current_participants" field.
+func (_u *EventUpdate) AddCurrentParticipants(v int) *EventUpdate {
+	_u.mutation.AddCurrentParticipants(v)
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *EventUpdate) SetStartTime(v time.Time) *EventUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableStartTime(v *time.Time) *EventUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *EventUpdate) SetEndTime(v time.Time) *EventUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableEndTime(v *time.Time) *EventUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (_u *EventUpdate) ClearEndTime() *EventUpdate {
+	_u.mutation.ClearEndTime()
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *EventUpdate) SetIsActive(v bool) *EventUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableIsActive(v *bool) *EventUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventUpdate) SetUpdatedAt(v time.Time) *EventUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddParticipantIDs adds the "participants" edge to the EventParticipant entity by IDs.
+func (_u *EventUpdate) AddParticipantIDs(ids ...uuid.UUID) *EventUpdate {
+	_u.mutation.AddParticipantIDs(ids...)
+	return _u
+}
+
+// AddParticipants adds the "participants" edges to the EventParticipant entity.
+func (_u *EventUpdate) AddParticipants(v ...*EventParticipant) *EventUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (_u *EventUpdate) Mutation() *EventMutation {
+	return _u.mutation
+}
+
+// ClearParticipants clears all "participants" edges to the EventParticipant entity.
+func (_u *EventUpdate) ClearParticipants() *EventUpdate {
+	_u.mutation.ClearParticipants()
+	return _u
+}
+
+// RemoveParticipantIDs removes the "participants" edge to EventParticipant entities by IDs.
+func (_u *EventUpdate) RemoveParticipantIDs(ids ...uuid.UUID) *EventUpdate {
+	_u.mutation.RemoveParticipantIDs(ids...)
+	return _u
+}
+
+// RemoveParticipants removes "participants" edges to EventParticipant entities.
+func (_u *EventUpdate) RemoveParticipants(v ...*EventParticipant) *EventUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipantIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := event.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := event.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Event.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := event.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Event.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := event.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "Event.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LocationName(); ok {
+		if err := event.LocationNameValidator(v); err != nil {
+			return &ValidationError{Name: "location_name", err: fmt.Errorf(`ent: validator failed for field "Event.location_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxParticipants(); ok {
+		if err := event.MaxParticipantsValidator(v); err != nil {
+			return &ValidationError{Name: "max_participants", err: fmt.Errorf(`ent: validator failed for field "Event.max_participants": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CurrentParticipants(); ok {
+		if err := event.CurrentParticipantsValidator(v); err != nil {
+			return &ValidationError{Name: "current_participants", err: fmt.Errorf(`ent: validator failed for field "Event.current_participants": %w`, err)}
+		}
+	}
+	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Event.creator"`)
+	}
+	return nil
+}
+
+func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(event.Table, event.Columns, sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(event.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(event.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(event.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(event.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(event.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(event.FieldLocation, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.LocationName(); ok {
+		_spec.SetField(event.FieldLocationName, field.TypeString, value)
+	}
+	if _u.mutation.LocationNameCleared() {
+		_spec.ClearField(event.FieldLocationName, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxParticipants(); ok {
+		_spec.SetField(event.FieldMaxParticipants, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxParticipants(); ok {
+		_spec.AddField(event.FieldMaxParticipants, field.TypeInt, value)
+	}
+	if _u.mutation.MaxParticipantsCleared() {
+		_spec.ClearField(event.FieldMaxParticipants, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CurrentParticipants(); ok {
+		_spec.SetField(event.FieldCurrentParticipants, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentParticipants(); ok {
+		_spec.AddField(event.FieldCurrentParticipants, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(event.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(event.FieldEndTime, field.TypeTime, value)
+	}
+	if _u.mutation.EndTimeCleared() {
+		_spec.ClearField(event.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(event.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(event.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ParticipantsTable,
+			Columns: []string{event.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !_u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ParticipantsTable,
+			Columns: []string{event.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ParticipantsTable,
+			Columns: []string{event.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventUpdateOne is the builder for updating a single Event entity.
+type EventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// SetTitle sets the "title" field.
+func (_u *EventUpdateOne) SetTitle(v string) *EventUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableTitle(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventUpdateOne) SetDescription(v string) *EventUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableDescription(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *EventUpdateOne) ClearDescription() *EventUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *EventUpdateOne) SetEventType(v string) *EventUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableEventType(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *EventUpdateOne) ClearEventType() *EventUpdateOne {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EventUpdateOne) SetLocation(v *schema.Point) *EventUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetLocationName sets the "location_name" field.
+func (_u *EventUpdateOne) SetLocationName(v string) *EventUpdateOne {
+	_u.mutation.SetLocationName(v)
+	return _u
+}
+
+// SetNillableLocationName sets the "location_name" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableLocationName(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetLocationName(*v)
+	}
+	return _u
+}
+
+// ClearLocationName clears the value of the "location_name" field.
+func (_u *EventUpdateOne) ClearLocationName() *EventUpdateOne {
+	_u.mutation.ClearLocationName()
+	return _u
+}
+
+// SetMaxParticipants sets the "max_participants" field.
+func (_u *EventUpdateOne) SetMaxParticipants(v int) *EventUpdateOne {
+	_u.mutation.ResetMaxParticipants()
+	_u.mutation.SetMaxParticipants(v)
+	return _u
+}
+
+// SetNillableMaxParticipants sets the "max_participants" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableMaxParticipants(v *int) *EventUpdateOne {
+	if v != nil {
+		_u.SetMaxParticipants(*v)
+	}
+	return _u
+}
+
+// AddMaxParticipants adds value to the "max_participants" field.
+func (_u *EventUpdateOne) AddMaxParticipants(v int) *EventUpdateOne {
+	_u.mutation.AddMaxParticipants(v)
+	return _u
+}
+
+// ClearMaxParticipants clears the value of the "max_participants" field.
+func (_u *EventUpdateOne) ClearMaxParticipants() *EventUpdateOne {
+	_u.mutation.ClearMaxParticipants()
+	return _u
+}
+
+// SetCurrentParticipants sets the "current_participants" field.
+func (_u *EventUpdateOne) SetCurrentParticipants(v int) *EventUpdateOne {
+	_u.mutation.ResetCurrentParticipants()
+	_u.mutation.SetCurrentParticipants(v)
+	return _u
+}
+
+// SetNillableCurrentParticipants sets the "current_participants" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableCurrentParticipants(v *int) *EventUpdateOne {
+	if v != nil {
+		_u.SetCurrentParticipants(*v)
+	}
+	return _u
+}
+
+// AddCurrentParticipants adds value to the "current_participants" field.
+func (_u *EventUpdateOne) AddCurrentParticipants(v int) *EventUpdateOne {
+	_u.mutation.AddCurrentParticipants(v)
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *EventUpdateOne) SetStartTime(v time.Time) *EventUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableStartTime(v *time.Time) *EventUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *EventUpdateOne) SetEndTime(v time.Time) *EventUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableEndTime(v *time.Time) *EventUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (_u *EventUpdateOne) ClearEndTime() *EventUpdateOne {
+	_u.mutation.ClearEndTime()
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *EventUpdateOne) SetIsActive(v bool) *EventUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableIsActive(v *bool) *EventUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventUpdateOne) SetUpdatedAt(v time.Time) *EventUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddParticipantIDs adds the "participants" edge to the EventParticipant entity by IDs.
+func (_u *EventUpdateOne) AddParticipantIDs(ids ...uuid.UUID) *EventUpdateOne {
+	_u.mutation.AddParticipantIDs(ids...)
+	return _u
+}
+
+// AddParticipants adds the "participants" edges to the EventParticipant entity.
+func (_u *EventUpdateOne) AddParticipants(v ...*EventParticipant) *EventUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (_u *EventUpdateOne) Mutation() *EventMutation {
+	return _u.mutation
+}
+
+// ClearParticipants clears all "participants" edges to the EventParticipant entity.
+func (_u *EventUpdateOne) ClearParticipants() *EventUpdateOne {
+	_u.mutation.ClearParticipants()
+	return _u
+}
+
+// RemoveParticipantIDs removes the "participants" edge to EventParticipant entities by IDs.
+func (_u *EventUpdateOne) RemoveParticipantIDs(ids ...uuid.UUID) *EventUpdateOne {
+	_u.mutation.RemoveParticipantIDs(ids...)
+	return _u
+}
+
+// RemoveParticipants removes "participants" edges to EventParticipant entities.
+func (_u *EventUpdateOne) RemoveParticipants(v ...*EventParticipant) *EventUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipantIDs(ids...)
+}
+
+// Where appends a list predicates to the EventUpdate builder.
+func (_u *EventUpdateOne) Where(ps ...predicate.Event) *EventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventUpdateOne) Select(field string, fields ...string) *EventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Event entity.
+func (_u *EventUpdateOne) Save(ctx context.Context) (*Event, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventUpdateOne) SaveX(ctx context.Context) *Event {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := event.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := event.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Event.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := event.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Event.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := event.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "Event.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LocationName(); ok {
+		if err := event.LocationNameValidator(v); err != nil {
+			return &ValidationError{Name: "location_name", err: fmt.Errorf(`ent: validator failed for field "Event.location_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxParticipants(); ok {
+		if err := event.MaxParticipantsValidator(v); err != nil {
+			return &ValidationError{Name: "max_participants", err: fmt.Errorf(`ent: validator failed for field "Event.max_participants": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CurrentParticipants(); ok {
+		if err := event.CurrentParticipantsValidator(v); err != nil {
+			return &ValidationError{Name: "current_participants", err: fmt.Errorf(`ent: validator failed for field "Event.current_participants": %w`, err)}
+		}
+	}
+	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Event.creator"`)
+	}
+	return nil
+}
+
+func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(event.Table, event.Columns, sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Event.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, event.FieldID)
+		for _, f := range fields {
+			if !event.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != event.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(event.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(event.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(event.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(event.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(event.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(event.FieldLocation, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.LocationName(); ok {
+		_spec.SetField(event.FieldLocationName, field.TypeString, value)
+	}
+	if _u.mutation.LocationNameCleared() {
+		_spec.ClearField(event.FieldLocationName, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxParticipants(); ok {
+		_spec.SetField(event.FieldMaxParticipants, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxParticipants(); ok {
+		_spec.AddField(event.FieldMaxParticipants, field.TypeInt, value)
+	}
+	if _u.mutation.MaxParticipantsCleared() {
+		_spec.ClearField(event.FieldMaxParticipants, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CurrentParticipants(); ok {
+		_spec.SetField(event.FieldCurrentParticipants, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentParticipants(); ok {
+		_spec.AddField(event.FieldCurrentParticipants, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(event.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(event.FieldEndTime, field.TypeTime, value)
+	}
+	if _u.mutation.EndTimeCleared() {
+		_spec.ClearField(event.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(event.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(event.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ParticipantsTable,
+			Columns: []string{event.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !_u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ParticipantsTable,
+			Columns: []string{event.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ParticipantsTable,
+			Columns: []string{event.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Event{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
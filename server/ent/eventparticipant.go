@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"match-me/ent/event"
+	"match-me/ent/eventparticipant"
+	"match-me/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// EventParticipant is the model entity for the EventParticipant schema.
+type EventParticipant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ID of the event
+	EventID uuid.UUID `json:"event_id,omitempty"`
+	// ID of the user who responded
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// RSVP of the user
+	Status eventparticipant.Status `json:"status,omitempty"`
+	// Timestamp when the user first responded
+	JoinedAt time.Time `json:"joined_at,omitempty"`
+	// Timestamp when the RSVP last changed
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EventParticipantQuery when eager-loading is set.
+	Edges        EventParticipantEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EventParticipantEdges holds the relations/edges for other nodes in the graph.
+type EventParticipantEdges struct {
+	// Event holds the value of the event edge.
+	Event *Event `json:"event,omitempty"`
+	// Reference to the participating user
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventParticipantEdges) EventOrErr() (*Event, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: event.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventParticipantEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventParticipant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventparticipant.FieldStatus:
+			values[i] = new(sql.NullString)
+		case eventparticipant.FieldJoinedAt, eventparticipant.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case eventparticipant.FieldID, eventparticipant.FieldEventID, eventparticipant.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventParticipant fields.
+func (_m *EventParticipant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventparticipant.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case eventparticipant.FieldEventID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value != nil {
+				_m.EventID = *value
+			}
+		case eventparticipant.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case eventparticipant.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = eventparticipant.Status(value.String)
+			}
+		case eventparticipant.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				_m.JoinedAt = value.Time
+			}
+		case eventparticipant.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventParticipant.
+// This includes values selected through modifiers, order, etc.
+func (_m *EventParticipant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEvent queries the "event" edge of the EventParticipant entity.
+func (_m *EventParticipant) QueryEvent() *EventQuery {
+	return NewEventParticipantClient(_m.config).QueryEvent(_m)
+}
+
+// QueryUser queries the "user" edge of the EventParticipant entity.
+func (_m *EventParticipant) QueryUser() *UserQuery {
+	return NewEventParticipantClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this EventParticipant.
+// Note that you need to call EventParticipant.Unwrap() before calling this method if this EventParticipant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EventParticipant) Update() *EventParticipantUpdateOne {
+	return NewEventParticipantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EventParticipant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EventParticipant) Unwrap() *EventParticipant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventParticipant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EventParticipant) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventParticipant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("joined_at=")
+	builder.WriteString(_m.JoinedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventParticipants is a parsable slice of EventParticipant.
+type EventParticipants []*EventParticipant
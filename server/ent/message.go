@@ -26,6 +26,10 @@ type Message struct {
 	SenderID uuid.UUID `json:"sender_id,omitempty"`
 	// ID of the user who received the message
 	ReceiverID uuid.UUID `json:"receiver_id,omitempty"`
+	// Idempotency key chosen by the sending client to deduplicate resends
+	ClientMessageID *string `json:"client_message_id,omitempty"`
+	// ID of the earlier message this message replies to
+	ReplyToID *uuid.UUID `json:"reply_to_id,omitempty"`
 	// Type of message content
 	Type message.Type `json:"type,omitempty"`
 	// Text content of the message (for text messages)
@@ -44,6 +48,10 @@ type Message struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Timestamp when the message was read by the receiver
 	ReadAt time.Time `json:"read_at,omitempty"`
+	// Timestamp when the message reached one of the receiver's clients
+	DeliveredAt time.Time `json:"delivered_at,omitempty"`
+	// Timestamp when the message content was last edited
+	EditedAt time.Time `json:"edited_at,omitempty"`
 	// Soft delete flag for the message
 	IsDeleted bool `json:"is_deleted,omitempty"`
 	// Timestamp when the message was deleted
@@ -62,9 +70,17 @@ type MessageEdges struct {
 	Sender *User `json:"sender,omitempty"`
 	// Reference to the user who received the message
 	Receiver *User `json:"receiver,omitempty"`
+	// Previous versions of the message content
+	Edits []*MessageEdit `json:"edits,omitempty"`
+	// Emoji reactions to the message
+	Reactions []*MessageReaction `json:"reactions,omitempty"`
+	// The message this message replies to and the replies it received
+	ReplyTo *Message `json:"reply_to,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*Message `json:"replies,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [7]bool
 }
 
 // ConnectionOrErr returns the Connection value or an error if the edge
@@ -100,16 +116,56 @@ func (e MessageEdges) ReceiverOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "receiver"}
 }
 
+// EditsOrErr returns the Edits value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) EditsOrErr() ([]*MessageEdit, error) {
+	if e.loadedTypes[3] {
+		return e.Edits, nil
+	}
+	return nil, &NotLoadedError{edge: "edits"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ReactionsOrErr() ([]*MessageReaction, error) {
+	if e.loadedTypes[4] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// ReplyToOrErr returns the ReplyTo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ReplyToOrErr() (*Message, error) {
+	if e.ReplyTo != nil {
+		return e.ReplyTo, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "reply_to"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RepliesOrErr() ([]*Message, error) {
+	if e.loadedTypes[6] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case message.FieldReplyToID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case message.FieldIsRead, message.FieldIsDeleted:
 			values[i] = new(sql.NullBool)
-		case message.FieldType, message.FieldContent, message.FieldMediaURL, message.FieldMediaType, message.FieldMediaPublicID:
+		case message.FieldClientMessageID, message.FieldType, message.FieldContent, message.FieldMediaURL, message.FieldMediaType, message.FieldMediaPublicID:
 			values[i] = new(sql.NullString)
-		case message.FieldCreatedAt, message.FieldUpdatedAt, message.FieldReadAt, message.FieldDeletedAt:
+		case message.FieldCreatedAt, message.FieldUpdatedAt, message.FieldReadAt, message.FieldDeliveredAt, message.FieldEditedAt, message.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case message.FieldID, message.FieldConnectionID, message.FieldSenderID, message.FieldReceiverID:
 			values[i] = new(uuid.UUID)
@@ -152,6 +208,20 @@ func (_m *Message) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ReceiverID = *value
 			}
+		case message.FieldClientMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_message_id", values[i])
+			} else if value.Valid {
+				_m.ClientMessageID = new(string)
+				*_m.ClientMessageID = value.String
+			}
+		case message.FieldReplyToID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_id", values[i])
+			} else if value.Valid {
+				_m.ReplyToID = new(uuid.UUID)
+				*_m.ReplyToID = *value.S.(*uuid.UUID)
+			}
 		case message.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -206,6 +276,18 @@ func (_m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ReadAt = value.Time
 			}
+		case message.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				_m.DeliveredAt = value.Time
+			}
+		case message.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				_m.EditedAt = value.Time
+			}
 		case message.FieldIsDeleted:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_deleted", values[i])
@@ -246,6 +328,26 @@ func (_m *Message) QueryReceiver() *UserQuery {
 	return NewMessageClient(_m.config).QueryReceiver(_m)
 }
 
+// QueryEdits queries the "edits" edge of the Message entity.
+func (_m *Message) QueryEdits() *MessageEditQuery {
+	return NewMessageClient(_m.config).QueryEdits(_m)
+}
+
+// QueryReactions queries the "reactions" edge of the Message entity.
+func (_m *Message) QueryReactions() *MessageReactionQuery {
+	return NewMessageClient(_m.config).QueryReactions(_m)
+}
+
+// QueryReplyTo queries the "reply_to" edge of the Message entity.
+func (_m *Message) QueryReplyTo() *MessageQuery {
+	return NewMessageClient(_m.config).QueryReplyTo(_m)
+}
+
+// QueryReplies queries the "replies" edge of the Message entity.
+func (_m *Message) QueryReplies() *MessageQuery {
+	return NewMessageClient(_m.config).QueryReplies(_m)
+}
+
 // Update returns a builder for updating this Message.
 // Note that you need to call Message.Unwrap() before calling this method if this Message
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -278,6 +380,16 @@ func (_m *Message) String() string {
 	builder.WriteString("receiver_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ReceiverID))
 	builder.WriteString(", ")
+	if v := _m.ClientMessageID; v != nil {
+		builder.WriteString("client_message_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ReplyToID; v != nil {
+		builder.WriteString("reply_to_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Type))
 	builder.WriteString(", ")
@@ -305,6 +417,12 @@ func (_m *Message) String() string {
 	builder.WriteString("read_at=")
 	builder.WriteString(_m.ReadAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("delivered_at=")
+	builder.WriteString(_m.DeliveredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("edited_at=")
+	builder.WriteString(_m.EditedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("is_deleted=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsDeleted))
 	builder.WriteString(", ")
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"match-me/ent/connection"
 	"match-me/ent/message"
+	"match-me/ent/messageedit"
+	"match-me/ent/messagereaction"
 	"match-me/ent/user"
 	"time"
 
@@ -41,6 +43,34 @@ func (_c *MessageCreate) SetReceiverID(v uuid.UUID) *MessageCreate {
 	return _c
 }
 
+// SetClientMessageID sets the "client_message_id" field.
+func (_c *MessageCreate) SetClientMessageID(v string) *MessageCreate {
+	_c.mutation.SetClientMessageID(v)
+	return _c
+}
+
+// SetNillableClientMessageID sets the "client_message_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableClientMessageID(v *string) *MessageCreate {
+	if v != nil {
+		_c.SetClientMessageID(*v)
+	}
+	return _c
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (_c *MessageCreate) SetReplyToID(v uuid.UUID) *MessageCreate {
+	_c.mutation.SetReplyToID(v)
+	return _c
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableReplyToID(v *uuid.UUID) *MessageCreate {
+	if v != nil {
+		_c.SetReplyToID(*v)
+	}
+	return _c
+}
+
 // SetType sets the "type" field.
 func (_c *MessageCreate) SetType(v message.Type) *MessageCreate {
 	_c.mutation.SetType(v)
@@ -167,6 +197,34 @@ func (_c *MessageCreate) SetNillableReadAt(v *time.Time) *MessageCreate {
 	return _c
 }
 
+// SetDeliveredAt sets the "delivered_at" field.
+func (_c *MessageCreate) SetDeliveredAt(v time.Time) *MessageCreate {
+	_c.mutation.SetDeliveredAt(v)
+	return _c
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableDeliveredAt(v *time.Time) *MessageCreate {
+	if v != nil {
+		_c.SetDeliveredAt(*v)
+	}
+	return _c
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (_c *MessageCreate) SetEditedAt(v time.Time) *MessageCreate {
+	_c.mutation.SetEditedAt(v)
+	return _c
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableEditedAt(v *time.Time) *MessageCreate {
+	if v != nil {
+		_c.SetEditedAt(*v)
+	}
+	return _c
+}
+
 // SetIsDeleted sets the "is_deleted" field.
 func (_c *MessageCreate) SetIsDeleted(v bool) *MessageCreate {
 	_c.mutation.SetIsDeleted(v)
@@ -224,6 +282,56 @@ func (_c *MessageCreate) SetReceiver(v *User) *MessageCreate {
 	return _c.SetReceiverID(v.ID)
 }
 
+// AddEditIDs adds the "edits" edge to the MessageEdit entity by IDs.
+func (_c *MessageCreate) AddEditIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddEditIDs(ids...)
+	return _c
+}
+
+// AddEdits adds the "edits" edges to the MessageEdit entity.
+func (_c *MessageCreate) AddEdits(v ...*MessageEdit) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEditIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (_c *MessageCreate) AddReactionIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddReactionIDs(ids...)
+	return _c
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (_c *MessageCreate) AddReactions(v ...*MessageReaction) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReactionIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (_c *MessageCreate) SetReplyTo(v *Message) *MessageCreate {
+	return _c.SetReplyToID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (_c *MessageCreate) AddReplyIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddReplyIDs(ids...)
+	return _c
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (_c *MessageCreate) AddReplies(v ...*Message) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplyIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (_c *MessageCreate) Mutation() *MessageMutation {
 	return _c.mutation
@@ -296,6 +404,11 @@ func (_c *MessageCreate) check() error {
 	if _, ok := _c.mutation.ReceiverID(); !ok {
 		return &ValidationError{Name: "receiver_id", err: errors.New(`ent: missing required field "Message.receiver_id"`)}
 	}
+	if v, ok := _c.mutation.ClientMessageID(); ok {
+		if err := message.ClientMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_message_id", err: fmt.Errorf(`ent: validator failed for field "Message.client_message_id": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Message.type"`)}
 	}
@@ -360,6 +473,10 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.ClientMessageID(); ok {
+		_spec.SetField(message.FieldClientMessageID, field.TypeString, value)
+		_node.ClientMessageID = &value
+	}
 	if value, ok := _c.mutation.GetType(); ok {
 		_spec.SetField(message.FieldType, field.TypeEnum, value)
 		_node.Type = value
@@ -396,6 +513,14 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldReadAt, field.TypeTime, value)
 		_node.ReadAt = value
 	}
+	if value, ok := _c.mutation.DeliveredAt(); ok {
+		_spec.SetField(message.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = value
+	}
+	if value, ok := _c.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = value
+	}
 	if value, ok := _c.mutation.IsDeleted(); ok {
 		_spec.SetField(message.FieldIsDeleted, field.TypeBool, value)
 		_node.IsDeleted = value
@@ -455,6 +580,71 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_node.ReceiverID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.EditsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.EditsTable,
+			Columns: []string{message.EditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageedit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReplyToID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"match-me/ent/message"
+	"match-me/ent/messageedit"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// MessageEdit is the model entity for the MessageEdit schema.
+type MessageEdit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ID of the edited message
+	MessageID uuid.UUID `json:"message_id,omitempty"`
+	// Content of the message before this edit
+	PreviousContent string `json:"previous_content,omitempty"`
+	// Timestamp when the edit was made
+	EditedAt time.Time `json:"edited_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageEditQuery when eager-loading is set.
+	Edges        MessageEditEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MessageEditEdges holds the relations/edges for other nodes in the graph.
+type MessageEditEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEditEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageEdit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messageedit.FieldPreviousContent:
+			values[i] = new(sql.NullString)
+		case messageedit.FieldEditedAt:
+			values[i] = new(sql.NullTime)
+		case messageedit.FieldID, messageedit.FieldMessageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageEdit fields.
+func (_m *MessageEdit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messageedit.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case messageedit.FieldMessageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				_m.MessageID = *value
+			}
+		case messageedit.FieldPreviousContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_content", values[i])
+			} else if value.Valid {
+				_m.PreviousContent = value.String
+			}
+		case messageedit.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				_m.EditedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageEdit.
+// This includes values selected through modifiers, order, etc.
+func (_m *MessageEdit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the MessageEdit entity.
+func (_m *MessageEdit) QueryMessage() *MessageQuery {
+	return NewMessageEditClient(_m.config).QueryMessage(_m)
+}
+
+// Update returns a builder for updating this MessageEdit.
+// Note that you need to call MessageEdit.Unwrap() before calling this method if this MessageEdit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MessageEdit) Update() *MessageEditUpdateOne {
+	return NewMessageEditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MessageEdit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MessageEdit) Unwrap() *MessageEdit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageEdit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MessageEdit) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageEdit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("previous_content=")
+	builder.WriteString(_m.PreviousContent)
+	builder.WriteString(", ")
+	builder.WriteString("edited_at=")
+	builder.WriteString(_m.EditedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageEdits is a parsable slice of MessageEdit.
+type MessageEdits []*MessageEdit
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messageedit
+
+import (
+	"match-me/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldLTE(FieldID, id))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldEQ(FieldMessageID, v))
+}
+
+// PreviousContent applies equality check predicate on the "previous_content" field. It's identical to PreviousContentEQ.
+func PreviousContent(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldEQ(FieldPreviousContent, v))
+}
+
+// EditedAt applies equality check predicate on the "edited_at" field. It's identical to EditedAtEQ.
+func EditedAt(v time.Time) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...uuid.UUID) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// PreviousContentEQ applies the EQ predicate on the "previous_content" field.
+func PreviousContentEQ(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldEQ(FieldPreviousContent, v))
+}
+
+// PreviousContentNEQ applies the NEQ predicate on the "previous_content" field.
+func PreviousContentNEQ(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldNEQ(FieldPreviousContent, v))
+}
+
+// PreviousContentIn applies the In predicate on the "previous_content" field.
+func PreviousContentIn(vs ...string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldIn(FieldPreviousContent, vs...))
+}
+
+// PreviousContentNotIn applies the NotIn predicate on the "previous_content" field.
+func PreviousContentNotIn(vs ...string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldNotIn(FieldPreviousContent, vs...))
+}
+
+// PreviousContentGT applies the GT predicate on the "previous_content" field.
+func PreviousContentGT(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldGT(FieldPreviousContent, v))
+}
+
+// PreviousContentGTE applies the GTE predicate on the "previous_content" field.
+func PreviousContentGTE(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldGTE(FieldPreviousContent, v))
+}
+
+// PreviousContentLT applies the LT predicate on the "previous_content" field.
+func PreviousContentLT(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldLT(FieldPreviousContent, v))
+}
+
+// PreviousContentLTE applies the LTE predicate on the "previous_content" field.
+func PreviousContentLTE(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldLTE(FieldPreviousContent, v))
+}
+
+// PreviousContentContains applies the Contains predicate on the "previous_content" field.
+func PreviousContentContains(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldContains(FieldPreviousContent, v))
+}
+
+// PreviousContentHasPrefix applies the HasPrefix predicate on the "previous_content" field.
+func PreviousContentHasPrefix(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldHasPrefix(FieldPreviousContent, v))
+}
+
+// PreviousContentHasSuffix applies the HasSuffix predicate on the "previous_content" field.
+func PreviousContentHasSuffix(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldHasSuffix(FieldPreviousContent, v))
+}
+
+// PreviousContentIsNil applies the IsNil predicate on the "previous_content" field.
+func PreviousContentIsNil() predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldIsNull(FieldPreviousContent))
+}
+
+// PreviousContentNotNil applies the NotNil predicate on the "previous_content" field.
+func PreviousContentNotNil() predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldNotNull(FieldPreviousContent))
+}
+
+// PreviousContentEqualFold applies the EqualFold predicate on the "previous_content" field.
+func PreviousContentEqualFold(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldEqualFold(FieldPreviousContent, v))
+}
+
+// PreviousContentContainsFold applies the ContainsFold predicate on the "previous_content" field.
+func PreviousContentContainsFold(v string) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldContainsFold(FieldPreviousContent, v))
+}
+
+// EditedAtEQ applies the EQ predicate on the "edited_at" field.
+func EditedAtEQ(v time.Time) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// EditedAtNEQ applies the NEQ predicate on the "edited_at" field.
+func EditedAtNEQ(v time.Time) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldNEQ(FieldEditedAt, v))
+}
+
+// EditedAtIn applies the In predicate on the "edited_at" field.
+func EditedAtIn(vs ...time.Time) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldIn(FieldEditedAt, vs...))
+}
+
+// EditedAtNotIn applies the NotIn predicate on the "edited_at" field.
+func EditedAtNotIn(vs ...time.Time) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldNotIn(FieldEditedAt, vs...))
+}
+
+// EditedAtGT applies the GT predicate on the "edited_at" field.
+func EditedAtGT(v time.Time) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldGT(FieldEditedAt, v))
+}
+
+// EditedAtGTE applies the GTE predicate on the "edited_at" field.
+func EditedAtGTE(v time.Time) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldGTE(FieldEditedAt, v))
+}
+
+// EditedAtLT applies the LT predicate on the "edited_at" field.
+func EditedAtLT(v time.Time) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldLT(FieldEditedAt, v))
+}
+
+// EditedAtLTE applies the LTE predicate on the "edited_at" field.
+func EditedAtLTE(v time.Time) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.FieldLTE(FieldEditedAt, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.MessageEdit {
+	return predicate.MessageEdit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.MessageEdit {
+	return predicate.MessageEdit(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageEdit) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageEdit) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageEdit) predicate.MessageEdit {
+	return predicate.MessageEdit(sql.NotPredicates(p))
+}
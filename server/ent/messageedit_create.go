@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"match-me/ent/message"
+	"match-me/ent/messageedit"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MessageEditCreate is the builder for creating a MessageEdit entity.
+type MessageEditCreate struct {
+	config
+	mutation *MessageEditMutation
+	hooks    []Hook
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *MessageEditCreate) SetMessageID(v uuid.UUID) *MessageEditCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetPreviousContent sets the "previous_content" field.
+func (_c *MessageEditCreate) SetPreviousContent(v string) *MessageEditCreate {
+	_c.mutation.SetPreviousContent(v)
+	return _c
+}
+
+// SetNillablePreviousContent sets the "previous_content" field if the given value is not nil.
+func (_c *MessageEditCreate) SetNillablePreviousContent(v *string) *MessageEditCreate {
+	if v != nil {
+		_c.SetPreviousContent(*v)
+	}
+	return _c
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (_c *MessageEditCreate) SetEditedAt(v time.Time) *MessageEditCreate {
+	_c.mutation.SetEditedAt(v)
+	return _c
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (_c *MessageEditCreate) SetNillableEditedAt(v *time.Time) *MessageEditCreate {
+	if v != nil {
+		_c.SetEditedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MessageEditCreate) SetID(v uuid.UUID) *MessageEditCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MessageEditCreate) SetNillableID(v *uuid.UUID) *MessageEditCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_c *MessageEditCreate) SetMessage(v *Message) *MessageEditCreate {
+	return _c.SetMessageID(v.ID)
+}
+
+// Mutation returns the MessageEditMutation object of the builder.
+func (_c *MessageEditCreate) Mutation() *MessageEditMutation {
+	return _c.mutation
+}
+
+// Save creates the MessageEdit in the database.
+func (_c *MessageEditCreate) Save(ctx context.Context) (*MessageEdit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MessageEditCreate) SaveX(ctx context.Context) *MessageEdit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageEditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageEditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MessageEditCreate) defaults() {
+	if _, ok := _c.mutation.EditedAt(); !ok {
+		v := messageedit.DefaultEditedAt()
+		_c.mutation.SetEditedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := messageedit.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MessageEditCreate) check() error {
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "MessageEdit.message_id"`)}
+	}
+	if _, ok := _c.mutation.EditedAt(); !ok {
+		return &ValidationError{Name: "edited_at", err: errors.New(`ent: missing required field "MessageEdit.edited_at"`)}
+	}
+	if len(_c.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageEdit.message"`)}
+	}
+	return nil
+}
+
+func (_c *MessageEditCreate) sqlSave(ctx context.Context) (*MessageEdit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MessageEditCreate) createSpec() (*MessageEdit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageEdit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(messageedit.Table, sqlgraph.NewFieldSpec(messageedit.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.PreviousContent(); ok {
+		_spec.SetField(messageedit.FieldPreviousContent, field.TypeString, value)
+		_node.PreviousContent = value
+	}
+	if value, ok := _c.mutation.EditedAt(); ok {
+		_spec.SetField(messageedit.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = value
+	}
+	if nodes := _c.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messageedit.MessageTable,
+			Columns: []string{messageedit.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MessageEditCreateBulk is the builder for creating many MessageEdit entities in bulk.
+type MessageEditCreateBulk struct {
+	config
+	err      error
+	builders []*MessageEditCreate
+}
+
+// Save creates the MessageEdit entities in the database.
+func (_c *MessageEditCreateBulk) Save(ctx context.Context) ([]*MessageEdit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MessageEdit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageEditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MessageEditCreateBulk) SaveX(ctx context.Context) []*MessageEdit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageEditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageEditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"match-me/ent/message"
+	"match-me/ent/messageedit"
+	"match-me/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MessageEditUpdate is the builder for updating MessageEdit entities.
+type MessageEditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageEditMutation
+}
+
+// Where appends a list predicates to the MessageEditUpdate builder.
+func (_u *MessageEditUpdate) Where(ps ...predicate.MessageEdit) *MessageEditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *MessageEditUpdate) SetMessageID(v uuid.UUID) *MessageEditUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *MessageEditUpdate) SetNillableMessageID(v *uuid.UUID) *MessageEditUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_u *MessageEditUpdate) SetMessage(v *Message) *MessageEditUpdate {
+	return _u.SetMessageID(v.ID)
+}
+
+// Mutation returns the MessageEditMutation object of the builder.
+func (_u *MessageEditUpdate) Mutation() *MessageEditMutation {
+	return _u.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (_u *MessageEditUpdate) ClearMessage() *MessageEditUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MessageEditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageEditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MessageEditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageEditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageEditUpdate) check() error {
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageEdit.message"`)
+	}
+	return nil
+}
+
+func (_u *MessageEditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messageedit.Table, messageedit.Columns, sqlgraph.NewFieldSpec(messageedit.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PreviousContentCleared() {
+		_spec.ClearField(messageedit.FieldPreviousContent, field.TypeString)
+	}
+	if _u.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messageedit.MessageTable,
+			Columns: []string{messageedit.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messageedit.MessageTable,
+			Columns: []string{messageedit.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messageedit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MessageEditUpdateOne is the builder for updating a single MessageEdit entity.
+type MessageEditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageEditMutation
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *MessageEditUpdateOne) SetMessageID(v uuid.UUID) *MessageEditUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *MessageEditUpdateOne) SetNillableMessageID(v *uuid.UUID) *MessageEditUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_u *MessageEditUpdateOne) SetMessage(v *Message) *MessageEditUpdateOne {
+	return _u.SetMessageID(v.ID)
+}
+
+// Mutation returns the MessageEditMutation object of the builder.
+func (_u *MessageEditUpdateOne) Mutation() *MessageEditMutation {
+	return _u.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (_u *MessageEditUpdateOne) ClearMessage() *MessageEditUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// Where appends a list predicates to the MessageEditUpdate builder.
+func (_u *MessageEditUpdateOne) Where(ps ...predicate.MessageEdit) *MessageEditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MessageEditUpdateOne) Select(field string, fields ...string) *MessageEditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MessageEdit entity.
+func (_u *MessageEditUpdateOne) Save(ctx context.Context) (*MessageEdit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageEditUpdateOne) SaveX(ctx context.Context) *MessageEdit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MessageEditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageEditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageEditUpdateOne) check() error {
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageEdit.message"`)
+	}
+	return nil
+}
+
+func (_u *MessageEditUpdateOne) sqlSave(ctx context.Context) (_node *MessageEdit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messageedit.Table, messageedit.Columns, sqlgraph.NewFieldSpec(messageedit.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageEdit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messageedit.FieldID)
+		for _, f := range fields {
+			if !messageedit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messageedit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PreviousContentCleared() {
+		_spec.ClearField(messageedit.FieldPreviousContent, field.TypeString)
+	}
+	if _u.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messageedit.MessageTable,
+			Columns: []string{messageedit.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messageedit.MessageTable,
+			Columns: []string{messageedit.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MessageEdit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messageedit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,26 @@ import (
 )
 
 var (
+	// BrokerPayloadsColumns holds the columns for the "broker_payloads" table.
+	BrokerPayloadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// BrokerPayloadsTable holds the schema information for the "broker_payloads" table.
+	BrokerPayloadsTable = &schema.Table{
+		Name:       "broker_payloads",
+		Columns:    BrokerPayloadsColumns,
+		PrimaryKey: []*schema.Column{BrokerPayloadsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "brokerpayload_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{BrokerPayloadsColumns[3]},
+			},
+		},
+	}
 	// ConnectionsColumns holds the columns for the "connections" table.
 	ConnectionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -165,9 +185,102 @@ var (
 			},
 		},
 	}
+	// EventsColumns holds the columns for the "events" table.
+	EventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "title", Type: field.TypeString, Size: 120},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "event_type", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "location", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "geography(POINT, 4326)"}},
+		{Name: "location_name", Type: field.TypeString, Nullable: true, Size: 200},
+		{Name: "max_participants", Type: field.TypeInt, Nullable: true},
+		{Name: "current_participants", Type: field.TypeInt, Default: 0},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime, Nullable: true},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "creator_id", Type: field.TypeUUID},
+	}
+	// EventsTable holds the schema information for the "events" table.
+	EventsTable = &schema.Table{
+		Name:       "events",
+		Columns:    EventsColumns,
+		PrimaryKey: []*schema.Column{EventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "events_users_creator",
+				Columns:    []*schema.Column{EventsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "event_location",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "GIST",
+				},
+			},
+			{
+				Name:    "event_creator_id",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[13]},
+			},
+			{
+				Name:    "event_is_active_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[10], EventsColumns[8]},
+			},
+		},
+	}
+	// EventParticipantsColumns holds the columns for the "event_participants" table.
+	EventParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"interested", "confirmed", "declined"}},
+		{Name: "joined_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// EventParticipantsTable holds the schema information for the "event_participants" table.
+	EventParticipantsTable = &schema.Table{
+		Name:       "event_participants",
+		Columns:    EventParticipantsColumns,
+		PrimaryKey: []*schema.Column{EventParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "event_participants_events_participants",
+				Columns:    []*schema.Column{EventParticipantsColumns[4]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "event_participants_users_user",
+				Columns:    []*schema.Column{EventParticipantsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventparticipant_event_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{EventParticipantsColumns[4], EventParticipantsColumns[5]},
+			},
+			{
+				Name:    "eventparticipant_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{EventParticipantsColumns[5], EventParticipantsColumns[1]},
+			},
+		},
+	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "client_message_id", Type: field.TypeString, Nullable: true, Size: 64},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "media", "mixed"}, Default: "text"},
 		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "media_url", Type: field.TypeString, Nullable: true},
@@ -177,11 +290,14 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
 		{Name: "is_deleted", Type: field.TypeBool, Default: false},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "connection_id", Type: field.TypeUUID},
 		{Name: "sender_id", Type: field.TypeUUID},
 		{Name: "receiver_id", Type: field.TypeUUID},
+		{Name: "reply_to_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
 	MessagesTable = &schema.Table{
@@ -191,83 +307,261 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_connections_connection",
-				Columns:    []*schema.Column{MessagesColumns[12]},
+				Columns:    []*schema.Column{MessagesColumns[15]},
 				RefColumns: []*schema.Column{ConnectionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "messages_users_sender",
-				Columns:    []*schema.Column{MessagesColumns[13]},
+				Columns:    []*schema.Column{MessagesColumns[16]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "messages_users_receiver",
-				Columns:    []*schema.Column{MessagesColumns[14]},
+				Columns:    []*schema.Column{MessagesColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "messages_messages_replies",
+				Columns:    []*schema.Column{MessagesColumns[18]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "message_connection_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[12]},
+				Columns: []*schema.Column{MessagesColumns[15]},
 			},
 			{
 				Name:    "message_sender_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[13]},
+				Columns: []*schema.Column{MessagesColumns[16]},
 			},
 			{
 				Name:    "message_receiver_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[14]},
+				Columns: []*schema.Column{MessagesColumns[17]},
 			},
 			{
 				Name:    "message_receiver_id_is_read",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[14], MessagesColumns[6]},
+				Columns: []*schema.Column{MessagesColumns[17], MessagesColumns[7]},
+			},
+			{
+				Name:    "message_receiver_id_delivered_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[17], MessagesColumns[11]},
+			},
+			{
+				Name:    "message_sender_id_client_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessagesColumns[16], MessagesColumns[1]},
+			},
+			{
+				Name:    "message_reply_to_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[18], MessagesColumns[8]},
 			},
 			{
 				Name:    "message_connection_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[12], MessagesColumns[7]},
+				Columns: []*schema.Column{MessagesColumns[15], MessagesColumns[8]},
 			},
 			{
 				Name:    "message_type",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[1]},
+				Columns: []*schema.Column{MessagesColumns[2]},
 			},
 			{
 				Name:    "message_is_deleted",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[10]},
+				Columns: []*schema.Column{MessagesColumns[13]},
 			},
 			{
 				Name:    "message_connection_id_is_read",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[12], MessagesColumns[6]},
+				Columns: []*schema.Column{MessagesColumns[15], MessagesColumns[7]},
 			},
 			{
 				Name:    "message_is_deleted_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[10], MessagesColumns[11]},
+				Columns: []*schema.Column{MessagesColumns[13], MessagesColumns[14]},
 			},
 			{
 				Name:    "message_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[7]},
+				Columns: []*schema.Column{MessagesColumns[8]},
 			},
 			{
 				Name:    "message_updated_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[8]},
+				Columns: []*schema.Column{MessagesColumns[9]},
 			},
 			{
 				Name:    "message_type_media_type",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[1], MessagesColumns[4]},
+				Columns: []*schema.Column{MessagesColumns[2], MessagesColumns[5]},
+			},
+		},
+	}
+	// MessageEditsColumns holds the columns for the "message_edits" table.
+	MessageEditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "previous_content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "edited_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// MessageEditsTable holds the schema information for the "message_edits" table.
+	MessageEditsTable = &schema.Table{
+		Name:       "message_edits",
+		Columns:    MessageEditsColumns,
+		PrimaryKey: []*schema.Column{MessageEditsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_edits_messages_edits",
+				Columns:    []*schema.Column{MessageEditsColumns[3]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messageedit_message_id_edited_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessageEditsColumns[3], MessageEditsColumns[2]},
+			},
+		},
+	}
+	// MessageReactionsColumns holds the columns for the "message_reactions" table.
+	MessageReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "emoji", Type: field.TypeString, Size: 32},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// MessageReactionsTable holds the schema information for the "message_reactions" table.
+	MessageReactionsTable = &schema.Table{
+		Name:       "message_reactions",
+		Columns:    MessageReactionsColumns,
+		PrimaryKey: []*schema.Column{MessageReactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_reactions_messages_reactions",
+				Columns:    []*schema.Column{MessageReactionsColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagereaction_message_id_user_id_emoji",
+				Unique:  true,
+				Columns: []*schema.Column{MessageReactionsColumns[4], MessageReactionsColumns[1], MessageReactionsColumns[2]},
+			},
+		},
+	}
+	// PresenceLeasesColumns holds the columns for the "presence_leases" table.
+	PresenceLeasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "node_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// PresenceLeasesTable holds the schema information for the "presence_leases" table.
+	PresenceLeasesTable = &schema.Table{
+		Name:       "presence_leases",
+		Columns:    PresenceLeasesColumns,
+		PrimaryKey: []*schema.Column{PresenceLeasesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "presencelease_node_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{PresenceLeasesColumns[1], PresenceLeasesColumns[2]},
+			},
+			{
+				Name:    "presencelease_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{PresenceLeasesColumns[3]},
+			},
+		},
+	}
+	// ReadMarkersColumns holds the columns for the "read_markers" table.
+	ReadMarkersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "last_read_message_id", Type: field.TypeUUID},
+		{Name: "last_read_message_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "connection_id", Type: field.TypeUUID},
+	}
+	// ReadMarkersTable holds the schema information for the "read_markers" table.
+	ReadMarkersTable = &schema.Table{
+		Name:       "read_markers",
+		Columns:    ReadMarkersColumns,
+		PrimaryKey: []*schema.Column{ReadMarkersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "read_markers_connections_read_markers",
+				Columns:    []*schema.Column{ReadMarkersColumns[5]},
+				RefColumns: []*schema.Column{ConnectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "readmarker_connection_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReadMarkersColumns[5], ReadMarkersColumns[1]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "refresh_token_hash", Type: field.TypeString},
+		{Name: "device", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoke_reason", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[10]},
+			},
+			{
+				Name:    "session_user_id_revoked_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[10], SessionsColumns[8]},
+			},
+			{
+				Name:    "session_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[7]},
 			},
 		},
 	}
@@ -281,6 +575,8 @@ var (
 		{Name: "about_me", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "verification_sent_at", Type: field.TypeTime, Nullable: true},
 		{Name: "age", Type: field.TypeInt},
 		{Name: "preferred_age_min", Type: field.TypeInt, Nullable: true},
 		{Name: "preferred_age_max", Type: field.TypeInt, Nullable: true},
@@ -295,6 +591,11 @@ var (
 		{Name: "food_preferences", Type: field.TypeJSON, Nullable: true},
 		{Name: "communication_style", Type: field.TypeString, Nullable: true},
 		{Name: "prompts", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "show_last_seen", Type: field.TypeBool, Default: true},
+		{Name: "status_mode", Type: field.TypeEnum, Enums: []string{"available", "busy", "invisible"}, Default: "available"},
+		{Name: "status_message", Type: field.TypeString, Nullable: true, Size: 80},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -305,7 +606,7 @@ var (
 			{
 				Name:    "user_coordinates",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[14]},
+				Columns: []*schema.Column{UsersColumns[16]},
 				Annotation: &entsql.IndexAnnotation{
 					Type: "GIST",
 				},
@@ -315,7 +616,7 @@ var (
 	// UserInteractionsColumns holds the columns for the "user_interactions" table.
 	UserInteractionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
-		{Name: "interaction_type", Type: field.TypeEnum, Enums: []string{"declined_request", "skipped_profile", "deleted_connection"}},
+		{Name: "interaction_type", Type: field.TypeEnum, Enums: []string{"declined_request", "skipped_profile", "deleted_connection", "liked", "super_liked"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
@@ -394,6 +695,10 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "photo_url", Type: field.TypeString},
 		{Name: "public_id", Type: field.TypeString},
+		{Name: "medium_url", Type: field.TypeString, Nullable: true},
+		{Name: "medium_public_id", Type: field.TypeString, Nullable: true},
+		{Name: "thumbnail_url", Type: field.TypeString, Nullable: true},
+		{Name: "thumbnail_public_id", Type: field.TypeString, Nullable: true},
 		{Name: "order", Type: field.TypeInt},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
@@ -405,17 +710,32 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_photos_users_photos",
-				Columns:    []*schema.Column{UserPhotosColumns[4]},
+				Columns:    []*schema.Column{UserPhotosColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userphoto_user_id_order",
+				Unique:  true,
+				Columns: []*schema.Column{UserPhotosColumns[8], UserPhotosColumns[7]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BrokerPayloadsTable,
 		ConnectionsTable,
 		ConnectionRequestsTable,
+		EventsTable,
+		EventParticipantsTable,
 		MessagesTable,
+		MessageEditsTable,
+		MessageReactionsTable,
+		PresenceLeasesTable,
+		ReadMarkersTable,
+		SessionsTable,
 		UsersTable,
 		UserInteractionsTable,
 		UserPhotosTable,
@@ -427,9 +747,17 @@ func init() {
 	ConnectionsTable.ForeignKeys[1].RefTable = UsersTable
 	ConnectionRequestsTable.ForeignKeys[0].RefTable = UsersTable
 	ConnectionRequestsTable.ForeignKeys[1].RefTable = UsersTable
+	EventsTable.ForeignKeys[0].RefTable = UsersTable
+	EventParticipantsTable.ForeignKeys[0].RefTable = EventsTable
+	EventParticipantsTable.ForeignKeys[1].RefTable = UsersTable
 	MessagesTable.ForeignKeys[0].RefTable = ConnectionsTable
 	MessagesTable.ForeignKeys[1].RefTable = UsersTable
 	MessagesTable.ForeignKeys[2].RefTable = UsersTable
+	MessagesTable.ForeignKeys[3].RefTable = MessagesTable
+	MessageEditsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageReactionsTable.ForeignKeys[0].RefTable = MessagesTable
+	ReadMarkersTable.ForeignKeys[0].RefTable = ConnectionsTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
 	UserInteractionsTable.ForeignKeys[0].RefTable = UsersTable
 	UserInteractionsTable.ForeignKeys[1].RefTable = UsersTable
 	UserPhotosTable.ForeignKeys[0].RefTable = UsersTable
@@ -6,11 +6,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"match-me/ent/brokerpayload"
 	"match-me/ent/connection"
 	"match-me/ent/connectionrequest"
+	"match-me/ent/event"
+	"match-me/ent/eventparticipant"
 	"match-me/ent/message"
+	"match-me/ent/messageedit"
+	"match-me/ent/messagereaction"
 	"match-me/ent/predicate"
+	"match-me/ent/presencelease"
+	"match-me/ent/readmarker"
 	"match-me/ent/schema"
+	"match-me/ent/session"
 	"match-me/ent/user"
 	"match-me/ent/userinteraction"
 	"match-me/ent/userphoto"
@@ -31,45 +39,48 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeBrokerPayload     = "BrokerPayload"
 	TypeConnection        = "Connection"
 	TypeConnectionRequest = "ConnectionRequest"
+	TypeEvent             = "Event"
+	TypeEventParticipant  = "EventParticipant"
 	TypeMessage           = "Message"
+	TypeMessageEdit       = "MessageEdit"
+	TypeMessageReaction   = "MessageReaction"
+	TypePresenceLease     = "PresenceLease"
+	TypeReadMarker        = "ReadMarker"
+	TypeSession           = "Session"
 	TypeUser              = "User"
 	TypeUserInteraction   = "UserInteraction"
 	TypeUserPhoto         = "UserPhoto"
 )
 
-// ConnectionMutation represents an operation that mutates the Connection nodes in the graph.
-type ConnectionMutation struct {
+// BrokerPayloadMutation represents an operation that mutates the BrokerPayload nodes in the graph.
+type BrokerPayloadMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
-	status        *connection.Status
-	connected_at  *time.Time
-	updated_at    *time.Time
-	dropped_at    *time.Time
+	payload       *[]byte
+	created_at    *time.Time
+	expires_at    *time.Time
 	clearedFields map[string]struct{}
-	user_a        *uuid.UUID
-	cleareduser_a bool
-	user_b        *uuid.UUID
-	cleareduser_b bool
 	done          bool
-	oldValue      func(context.Context) (*Connection, error)
-	predicates    []predicate.Connection
+	oldValue      func(context.Context) (*BrokerPayload, error)
+	predicates    []predicate.BrokerPayload
 }
 
-var _ ent.Mutation = (*ConnectionMutation)(nil)
+var _ ent.Mutation = (*BrokerPayloadMutation)(nil)
 
-// connectionOption allows management of the mutation configuration using functional options.
-type connectionOption func(*ConnectionMutation)
+// brokerpayloadOption allows management of the mutation configuration using functional options.
+type brokerpayloadOption func(*BrokerPayloadMutation)
 
-// newConnectionMutation creates new mutation for the Connection entity.
-func newConnectionMutation(c config, op Op, opts ...connectionOption) *ConnectionMutation {
-	m := &ConnectionMutation{
+// newBrokerPayloadMutation creates new mutation for the BrokerPayload entity.
+func newBrokerPayloadMutation(c config, op Op, opts ...brokerpayloadOption) *BrokerPayloadMutation {
+	m := &BrokerPayloadMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConnection,
+		typ:           TypeBrokerPayload,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -78,20 +89,20 @@ func newConnectionMutation(c config, op Op, opts ...connectionOption) *Connectio
 	return m
 }
 
-// withConnectionID sets the ID field of the mutation.
-func withConnectionID(id uuid.UUID) connectionOption {
-	return func(m *ConnectionMutation) {
+// withBrokerPayloadID sets the ID field of the mutation.
+func withBrokerPayloadID(id uuid.UUID) brokerpayloadOption {
+	return func(m *BrokerPayloadMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Connection
+			value *BrokerPayload
 		)
-		m.oldValue = func(ctx context.Context) (*Connection, error) {
+		m.oldValue = func(ctx context.Context) (*BrokerPayload, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Connection.Get(ctx, id)
+					value, err = m.Client().BrokerPayload.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -100,10 +111,10 @@ func withConnectionID(id uuid.UUID) connectionOption {
 	}
 }
 
-// withConnection sets the old Connection of the mutation.
-func withConnection(node *Connection) connectionOption {
-	return func(m *ConnectionMutation) {
-		m.oldValue = func(context.Context) (*Connection, error) {
+// withBrokerPayload sets the old BrokerPayload of the mutation.
+func withBrokerPayload(node *BrokerPayload) brokerpayloadOption {
+	return func(m *BrokerPayloadMutation) {
+		m.oldValue = func(context.Context) (*BrokerPayload, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -112,7 +123,7 @@ func withConnection(node *Connection) connectionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConnectionMutation) Client() *Client {
+func (m BrokerPayloadMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -120,7 +131,7 @@ func (m ConnectionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConnectionMutation) Tx() (*Tx, error) {
+func (m BrokerPayloadMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -130,14 +141,14 @@ func (m ConnectionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Connection entities.
-func (m *ConnectionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of BrokerPayload entities.
+func (m *BrokerPayloadMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConnectionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BrokerPayloadMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -148,7 +159,7 @@ func (m *ConnectionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConnectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BrokerPayloadMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -157,304 +168,6650 @@ func (m *ConnectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Connection.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BrokerPayload.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStatus sets the "status" field.
-func (m *ConnectionMutation) SetStatus(c connection.Status) {
-	m.status = &c
+// SetPayload sets the "payload" field.
+func (m *BrokerPayloadMutation) SetPayload(b []byte) {
+	m.payload = &b
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ConnectionMutation) Status() (r connection.Status, exists bool) {
-	v := m.status
+// Payload returns the value of the "payload" field in the mutation.
+func (m *BrokerPayloadMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Connection entity.
-// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the BrokerPayload entity.
+// If the BrokerPayload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionMutation) OldStatus(ctx context.Context) (v connection.Status, err error) {
+func (m *BrokerPayloadMutation) OldPayload(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Payload, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ConnectionMutation) ResetStatus() {
-	m.status = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *BrokerPayloadMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetUserAID sets the "user_a_id" field.
-func (m *ConnectionMutation) SetUserAID(u uuid.UUID) {
-	m.user_a = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *BrokerPayloadMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserAID returns the value of the "user_a_id" field in the mutation.
-func (m *ConnectionMutation) UserAID() (r uuid.UUID, exists bool) {
-	v := m.user_a
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BrokerPayloadMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserAID returns the old "user_a_id" field's value of the Connection entity.
-// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BrokerPayload entity.
+// If the BrokerPayload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionMutation) OldUserAID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BrokerPayloadMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserAID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserAID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserAID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserAID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserAID resets all changes to the "user_a_id" field.
-func (m *ConnectionMutation) ResetUserAID() {
-	m.user_a = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BrokerPayloadMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetUserBID sets the "user_b_id" field.
-func (m *ConnectionMutation) SetUserBID(u uuid.UUID) {
-	m.user_b = &u
+// SetExpiresAt sets the "expires_at" field.
+func (m *BrokerPayloadMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// UserBID returns the value of the "user_b_id" field in the mutation.
-func (m *ConnectionMutation) UserBID() (r uuid.UUID, exists bool) {
-	v := m.user_b
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *BrokerPayloadMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserBID returns the old "user_b_id" field's value of the Connection entity.
-// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the BrokerPayload entity.
+// If the BrokerPayload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionMutation) OldUserBID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BrokerPayloadMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserBID is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserBID requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserBID: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.UserBID, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetUserBID resets all changes to the "user_b_id" field.
-func (m *ConnectionMutation) ResetUserBID() {
-	m.user_b = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *BrokerPayloadMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetConnectedAt sets the "connected_at" field.
-func (m *ConnectionMutation) SetConnectedAt(t time.Time) {
-	m.connected_at = &t
+// Where appends a list predicates to the BrokerPayloadMutation builder.
+func (m *BrokerPayloadMutation) Where(ps ...predicate.BrokerPayload) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ConnectedAt returns the value of the "connected_at" field in the mutation.
-func (m *ConnectionMutation) ConnectedAt() (r time.Time, exists bool) {
-	v := m.connected_at
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the BrokerPayloadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BrokerPayloadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BrokerPayload, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldConnectedAt returns the old "connected_at" field's value of the Connection entity.
-// If the Connection object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionMutation) OldConnectedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectedAt: %w", err)
-	}
-	return oldValue.ConnectedAt, nil
+// Op returns the operation name.
+func (m *BrokerPayloadMutation) Op() Op {
+	return m.op
 }
 
-// ResetConnectedAt resets all changes to the "connected_at" field.
-func (m *ConnectionMutation) ResetConnectedAt() {
-	m.connected_at = nil
+// SetOp allows setting the mutation operation.
+func (m *BrokerPayloadMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ConnectionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// Type returns the node type of this mutation (BrokerPayload).
+func (m *BrokerPayloadMutation) Type() string {
+	return m.typ
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConnectionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BrokerPayloadMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.payload != nil {
+		fields = append(fields, brokerpayload.FieldPayload)
 	}
-	return *v, true
+	if m.created_at != nil {
+		fields = append(fields, brokerpayload.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, brokerpayload.FieldExpiresAt)
+	}
+	return fields
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Connection entity.
-// If the Connection object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BrokerPayloadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case brokerpayload.FieldPayload:
+		return m.Payload()
+	case brokerpayload.FieldCreatedAt:
+		return m.CreatedAt()
+	case brokerpayload.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BrokerPayloadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case brokerpayload.FieldPayload:
+		return m.OldPayload(ctx)
+	case brokerpayload.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case brokerpayload.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return oldValue.UpdatedAt, nil
+	return nil, fmt.Errorf("unknown BrokerPayload field %s", name)
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConnectionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BrokerPayloadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case brokerpayload.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case brokerpayload.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case brokerpayload.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BrokerPayload field %s", name)
 }
 
-// SetDroppedAt sets the "dropped_at" field.
-func (m *ConnectionMutation) SetDroppedAt(t time.Time) {
-	m.dropped_at = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BrokerPayloadMutation) AddedFields() []string {
+	return nil
 }
 
-// DroppedAt returns the value of the "dropped_at" field in the mutation.
-func (m *ConnectionMutation) DroppedAt() (r time.Time, exists bool) {
-	v := m.dropped_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BrokerPayloadMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldDroppedAt returns the old "dropped_at" field's value of the Connection entity.
-// If the Connection object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionMutation) OldDroppedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDroppedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDroppedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDroppedAt: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BrokerPayloadMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.DroppedAt, nil
+	return fmt.Errorf("unknown BrokerPayload numeric field %s", name)
 }
 
-// ClearDroppedAt clears the value of the "dropped_at" field.
-func (m *ConnectionMutation) ClearDroppedAt() {
-	m.dropped_at = nil
-	m.clearedFields[connection.FieldDroppedAt] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BrokerPayloadMutation) ClearedFields() []string {
+	return nil
 }
 
-// DroppedAtCleared returns if the "dropped_at" field was cleared in this mutation.
-func (m *ConnectionMutation) DroppedAtCleared() bool {
-	_, ok := m.clearedFields[connection.FieldDroppedAt]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BrokerPayloadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetDroppedAt resets all changes to the "dropped_at" field.
-func (m *ConnectionMutation) ResetDroppedAt() {
-	m.dropped_at = nil
-	delete(m.clearedFields, connection.FieldDroppedAt)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BrokerPayloadMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BrokerPayload nullable field %s", name)
 }
 
-// ClearUserA clears the "user_a" edge to the User entity.
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BrokerPayloadMutation) ResetField(name string) error {
+	switch name {
+	case brokerpayload.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case brokerpayload.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case brokerpayload.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BrokerPayload field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BrokerPayloadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BrokerPayloadMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BrokerPayloadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BrokerPayloadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BrokerPayloadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BrokerPayloadMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BrokerPayloadMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BrokerPayload unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BrokerPayloadMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BrokerPayload edge %s", name)
+}
+
+// ConnectionMutation represents an operation that mutates the Connection nodes in the graph.
+type ConnectionMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	status              *connection.Status
+	connected_at        *time.Time
+	updated_at          *time.Time
+	dropped_at          *time.Time
+	clearedFields       map[string]struct{}
+	user_a              *uuid.UUID
+	cleareduser_a       bool
+	user_b              *uuid.UUID
+	cleareduser_b       bool
+	read_markers        map[uuid.UUID]struct{}
+	removedread_markers map[uuid.UUID]struct{}
+	clearedread_markers bool
+	done                bool
+	oldValue            func(context.Context) (*Connection, error)
+	predicates          []predicate.Connection
+}
+
+var _ ent.Mutation = (*ConnectionMutation)(nil)
+
+// connectionOption allows management of the mutation configuration using functional options.
+type connectionOption func(*ConnectionMutation)
+
+// newConnectionMutation creates new mutation for the Connection entity.
+func newConnectionMutation(c config, op Op, opts ...connectionOption) *ConnectionMutation {
+	m := &ConnectionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConnection,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConnectionID sets the ID field of the mutation.
+func withConnectionID(id uuid.UUID) connectionOption {
+	return func(m *ConnectionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Connection
+		)
+		m.oldValue = func(ctx context.Context) (*Connection, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Connection.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConnection sets the old Connection of the mutation.
+func withConnection(node *Connection) connectionOption {
+	return func(m *ConnectionMutation) {
+		m.oldValue = func(context.Context) (*Connection, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConnectionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConnectionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Connection entities.
+func (m *ConnectionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConnectionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConnectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Connection.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *ConnectionMutation) SetStatus(c connection.Status) {
+	m.status = &c
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ConnectionMutation) Status() (r connection.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldStatus(ctx context.Context) (v connection.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ConnectionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetUserAID sets the "user_a_id" field.
+func (m *ConnectionMutation) SetUserAID(u uuid.UUID) {
+	m.user_a = &u
+}
+
+// UserAID returns the value of the "user_a_id" field in the mutation.
+func (m *ConnectionMutation) UserAID() (r uuid.UUID, exists bool) {
+	v := m.user_a
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAID returns the old "user_a_id" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldUserAID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAID: %w", err)
+	}
+	return oldValue.UserAID, nil
+}
+
+// ResetUserAID resets all changes to the "user_a_id" field.
+func (m *ConnectionMutation) ResetUserAID() {
+	m.user_a = nil
+}
+
+// SetUserBID sets the "user_b_id" field.
+func (m *ConnectionMutation) SetUserBID(u uuid.UUID) {
+	m.user_b = &u
+}
+
+// UserBID returns the value of the "user_b_id" field in the mutation.
+func (m *ConnectionMutation) UserBID() (r uuid.UUID, exists bool) {
+	v := m.user_b
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserBID returns the old "user_b_id" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldUserBID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserBID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserBID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserBID: %w", err)
+	}
+	return oldValue.UserBID, nil
+}
+
+// ResetUserBID resets all changes to the "user_b_id" field.
+func (m *ConnectionMutation) ResetUserBID() {
+	m.user_b = nil
+}
+
+// SetConnectedAt sets the "connected_at" field.
+func (m *ConnectionMutation) SetConnectedAt(t time.Time) {
+	m.connected_at = &t
+}
+
+// ConnectedAt returns the value of the "connected_at" field in the mutation.
+func (m *ConnectionMutation) ConnectedAt() (r time.Time, exists bool) {
+	v := m.connected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectedAt returns the old "connected_at" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldConnectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectedAt: %w", err)
+	}
+	return oldValue.ConnectedAt, nil
+}
+
+// ResetConnectedAt resets all changes to the "connected_at" field.
+func (m *ConnectionMutation) ResetConnectedAt() {
+	m.connected_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConnectionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConnectionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConnectionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDroppedAt sets the "dropped_at" field.
+func (m *ConnectionMutation) SetDroppedAt(t time.Time) {
+	m.dropped_at = &t
+}
+
+// DroppedAt returns the value of the "dropped_at" field in the mutation.
+func (m *ConnectionMutation) DroppedAt() (r time.Time, exists bool) {
+	v := m.dropped_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDroppedAt returns the old "dropped_at" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldDroppedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDroppedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDroppedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDroppedAt: %w", err)
+	}
+	return oldValue.DroppedAt, nil
+}
+
+// ClearDroppedAt clears the value of the "dropped_at" field.
+func (m *ConnectionMutation) ClearDroppedAt() {
+	m.dropped_at = nil
+	m.clearedFields[connection.FieldDroppedAt] = struct{}{}
+}
+
+// DroppedAtCleared returns if the "dropped_at" field was cleared in this mutation.
+func (m *ConnectionMutation) DroppedAtCleared() bool {
+	_, ok := m.clearedFields[connection.FieldDroppedAt]
+	return ok
+}
+
+// ResetDroppedAt resets all changes to the "dropped_at" field.
+func (m *ConnectionMutation) ResetDroppedAt() {
+	m.dropped_at = nil
+	delete(m.clearedFields, connection.FieldDroppedAt)
+}
+
+// ClearUserA clears the "user_a" edge to the User entity.
 func (m *ConnectionMutation) ClearUserA() {
 	m.cleareduser_a = true
 	m.clearedFields[connection.FieldUserAID] = struct{}{}
 }
 
-// UserACleared reports if the "user_a" edge to the User entity was cleared.
-func (m *ConnectionMutation) UserACleared() bool {
-	return m.cleareduser_a
+// UserACleared reports if the "user_a" edge to the User entity was cleared.
+func (m *ConnectionMutation) UserACleared() bool {
+	return m.cleareduser_a
+}
+
+// UserAIDs returns the "user_a" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserAID instead. It exists only for internal usage by the builders.
+func (m *ConnectionMutation) UserAIDs() (ids []uuid.UUID) {
+	if id := m.user_a; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUserA resets all changes to the "user_a" edge.
+func (m *ConnectionMutation) ResetUserA() {
+	m.user_a = nil
+	m.cleareduser_a = false
+}
+
+// ClearUserB clears the "user_b" edge to the User entity.
+func (m *ConnectionMutation) ClearUserB() {
+	m.cleareduser_b = true
+	m.clearedFields[connection.FieldUserBID] = struct{}{}
+}
+
+// UserBCleared reports if the "user_b" edge to the User entity was cleared.
+func (m *ConnectionMutation) UserBCleared() bool {
+	return m.cleareduser_b
+}
+
+// UserBIDs returns the "user_b" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserBID instead. It exists only for internal usage by the builders.
+func (m *ConnectionMutation) UserBIDs() (ids []uuid.UUID) {
+	if id := m.user_b; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUserB resets all changes to the "user_b" edge.
+func (m *ConnectionMutation) ResetUserB() {
+	m.user_b = nil
+	m.cleareduser_b = false
+}
+
+// AddReadMarkerIDs adds the "read_markers" edge to the ReadMarker entity by ids.
+func (m *ConnectionMutation) AddReadMarkerIDs(ids ...uuid.UUID) {
+	if m.read_markers == nil {
+		m.read_markers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.read_markers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReadMarkers clears the "read_markers" edge to the ReadMarker entity.
+func (m *ConnectionMutation) ClearReadMarkers() {
+	m.clearedread_markers = true
+}
+
+// ReadMarkersCleared reports if the "read_markers" edge to the ReadMarker entity was cleared.
+func (m *ConnectionMutation) ReadMarkersCleared() bool {
+	return m.clearedread_markers
+}
+
+// RemoveReadMarkerIDs removes the "read_markers" edge to the ReadMarker entity by IDs.
+func (m *ConnectionMutation) RemoveReadMarkerIDs(ids ...uuid.UUID) {
+	if m.removedread_markers == nil {
+		m.removedread_markers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.read_markers, ids[i])
+		m.removedread_markers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReadMarkers returns the removed IDs of the "read_markers" edge to the ReadMarker entity.
+func (m *ConnectionMutation) RemovedReadMarkersIDs() (ids []uuid.UUID) {
+	for id := range m.removedread_markers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReadMarkersIDs returns the "read_markers" edge IDs in the mutation.
+func (m *ConnectionMutation) ReadMarkersIDs() (ids []uuid.UUID) {
+	for id := range m.read_markers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReadMarkers resets all changes to the "read_markers" edge.
+func (m *ConnectionMutation) ResetReadMarkers() {
+	m.read_markers = nil
+	m.clearedread_markers = false
+	m.removedread_markers = nil
+}
+
+// Where appends a list predicates to the ConnectionMutation builder.
+func (m *ConnectionMutation) Where(ps ...predicate.Connection) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConnectionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConnectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Connection, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConnectionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConnectionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Connection).
+func (m *ConnectionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConnectionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.status != nil {
+		fields = append(fields, connection.FieldStatus)
+	}
+	if m.user_a != nil {
+		fields = append(fields, connection.FieldUserAID)
+	}
+	if m.user_b != nil {
+		fields = append(fields, connection.FieldUserBID)
+	}
+	if m.connected_at != nil {
+		fields = append(fields, connection.FieldConnectedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, connection.FieldUpdatedAt)
+	}
+	if m.dropped_at != nil {
+		fields = append(fields, connection.FieldDroppedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConnectionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case connection.FieldStatus:
+		return m.Status()
+	case connection.FieldUserAID:
+		return m.UserAID()
+	case connection.FieldUserBID:
+		return m.UserBID()
+	case connection.FieldConnectedAt:
+		return m.ConnectedAt()
+	case connection.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case connection.FieldDroppedAt:
+		return m.DroppedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConnectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case connection.FieldStatus:
+		return m.OldStatus(ctx)
+	case connection.FieldUserAID:
+		return m.OldUserAID(ctx)
+	case connection.FieldUserBID:
+		return m.OldUserBID(ctx)
+	case connection.FieldConnectedAt:
+		return m.OldConnectedAt(ctx)
+	case connection.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case connection.FieldDroppedAt:
+		return m.OldDroppedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Connection field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case connection.FieldStatus:
+		v, ok := value.(connection.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case connection.FieldUserAID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAID(v)
+		return nil
+	case connection.FieldUserBID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserBID(v)
+		return nil
+	case connection.FieldConnectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectedAt(v)
+		return nil
+	case connection.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case connection.FieldDroppedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDroppedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Connection field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConnectionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConnectionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Connection numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConnectionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(connection.FieldDroppedAt) {
+		fields = append(fields, connection.FieldDroppedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConnectionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConnectionMutation) ClearField(name string) error {
+	switch name {
+	case connection.FieldDroppedAt:
+		m.ClearDroppedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Connection nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConnectionMutation) ResetField(name string) error {
+	switch name {
+	case connection.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case connection.FieldUserAID:
+		m.ResetUserAID()
+		return nil
+	case connection.FieldUserBID:
+		m.ResetUserBID()
+		return nil
+	case connection.FieldConnectedAt:
+		m.ResetConnectedAt()
+		return nil
+	case connection.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case connection.FieldDroppedAt:
+		m.ResetDroppedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Connection field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConnectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user_a != nil {
+		edges = append(edges, connection.EdgeUserA)
+	}
+	if m.user_b != nil {
+		edges = append(edges, connection.EdgeUserB)
+	}
+	if m.read_markers != nil {
+		edges = append(edges, connection.EdgeReadMarkers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConnectionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case connection.EdgeUserA:
+		if id := m.user_a; id != nil {
+			return []ent.Value{*id}
+		}
+	case connection.EdgeUserB:
+		if id := m.user_b; id != nil {
+			return []ent.Value{*id}
+		}
+	case connection.EdgeReadMarkers:
+		ids := make([]ent.Value, 0, len(m.read_markers))
+		for id := range m.read_markers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConnectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedread_markers != nil {
+		edges = append(edges, connection.EdgeReadMarkers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConnectionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case connection.EdgeReadMarkers:
+		ids := make([]ent.Value, 0, len(m.removedread_markers))
+		for id := range m.removedread_markers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConnectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser_a {
+		edges = append(edges, connection.EdgeUserA)
+	}
+	if m.cleareduser_b {
+		edges = append(edges, connection.EdgeUserB)
+	}
+	if m.clearedread_markers {
+		edges = append(edges, connection.EdgeReadMarkers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConnectionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case connection.EdgeUserA:
+		return m.cleareduser_a
+	case connection.EdgeUserB:
+		return m.cleareduser_b
+	case connection.EdgeReadMarkers:
+		return m.clearedread_markers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConnectionMutation) ClearEdge(name string) error {
+	switch name {
+	case connection.EdgeUserA:
+		m.ClearUserA()
+		return nil
+	case connection.EdgeUserB:
+		m.ClearUserB()
+		return nil
+	}
+	return fmt.Errorf("unknown Connection unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConnectionMutation) ResetEdge(name string) error {
+	switch name {
+	case connection.EdgeUserA:
+		m.ResetUserA()
+		return nil
+	case connection.EdgeUserB:
+		m.ResetUserB()
+		return nil
+	case connection.EdgeReadMarkers:
+		m.ResetReadMarkers()
+		return nil
+	}
+	return fmt.Errorf("unknown Connection edge %s", name)
+}
+
+// ConnectionRequestMutation represents an operation that mutates the ConnectionRequest nodes in the graph.
+type ConnectionRequestMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	status          *connectionrequest.Status
+	message         *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	responded_at    *time.Time
+	expires_at      *time.Time
+	clearedFields   map[string]struct{}
+	sender          *uuid.UUID
+	clearedsender   bool
+	receiver        *uuid.UUID
+	clearedreceiver bool
+	done            bool
+	oldValue        func(context.Context) (*ConnectionRequest, error)
+	predicates      []predicate.ConnectionRequest
+}
+
+var _ ent.Mutation = (*ConnectionRequestMutation)(nil)
+
+// connectionrequestOption allows management of the mutation configuration using functional options.
+type connectionrequestOption func(*ConnectionRequestMutation)
+
+// newConnectionRequestMutation creates new mutation for the ConnectionRequest entity.
+func newConnectionRequestMutation(c config, op Op, opts ...connectionrequestOption) *ConnectionRequestMutation {
+	m := &ConnectionRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConnectionRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConnectionRequestID sets the ID field of the mutation.
+func withConnectionRequestID(id uuid.UUID) connectionrequestOption {
+	return func(m *ConnectionRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConnectionRequest
+		)
+		m.oldValue = func(ctx context.Context) (*ConnectionRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConnectionRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConnectionRequest sets the old ConnectionRequest of the mutation.
+func withConnectionRequest(node *ConnectionRequest) connectionrequestOption {
+	return func(m *ConnectionRequestMutation) {
+		m.oldValue = func(context.Context) (*ConnectionRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConnectionRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConnectionRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConnectionRequest entities.
+func (m *ConnectionRequestMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConnectionRequestMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConnectionRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConnectionRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSenderID sets the "sender_id" field.
+func (m *ConnectionRequestMutation) SetSenderID(u uuid.UUID) {
+	m.sender = &u
+}
+
+// SenderID returns the value of the "sender_id" field in the mutation.
+func (m *ConnectionRequestMutation) SenderID() (r uuid.UUID, exists bool) {
+	v := m.sender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderID returns the old "sender_id" field's value of the ConnectionRequest entity.
+// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionRequestMutation) OldSenderID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+	}
+	return oldValue.SenderID, nil
+}
+
+// ResetSenderID resets all changes to the "sender_id" field.
+func (m *ConnectionRequestMutation) ResetSenderID() {
+	m.sender = nil
+}
+
+// SetReceiverID sets the "receiver_id" field.
+func (m *ConnectionRequestMutation) SetReceiverID(u uuid.UUID) {
+	m.receiver = &u
+}
+
+// ReceiverID returns the value of the "receiver_id" field in the mutation.
+func (m *ConnectionRequestMutation) ReceiverID() (r uuid.UUID, exists bool) {
+	v := m.receiver
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiverID returns the old "receiver_id" field's value of the ConnectionRequest entity.
+// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionRequestMutation) OldReceiverID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiverID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiverID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiverID: %w", err)
+	}
+	return oldValue.ReceiverID, nil
+}
+
+// ResetReceiverID resets all changes to the "receiver_id" field.
+func (m *ConnectionRequestMutation) ResetReceiverID() {
+	m.receiver = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ConnectionRequestMutation) SetStatus(c connectionrequest.Status) {
+	m.status = &c
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ConnectionRequestMutation) Status() (r connectionrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ConnectionRequest entity.
+// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionRequestMutation) OldStatus(ctx context.Context) (v connectionrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ConnectionRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *ConnectionRequestMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ConnectionRequestMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the ConnectionRequest entity.
+// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionRequestMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *ConnectionRequestMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[connectionrequest.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ConnectionRequestMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[connectionrequest.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ConnectionRequestMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, connectionrequest.FieldMessage)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConnectionRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConnectionRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ConnectionRequest entity.
+// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConnectionRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConnectionRequestMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConnectionRequestMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ConnectionRequest entity.
+// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConnectionRequestMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (m *ConnectionRequestMutation) SetRespondedAt(t time.Time) {
+	m.responded_at = &t
+}
+
+// RespondedAt returns the value of the "responded_at" field in the mutation.
+func (m *ConnectionRequestMutation) RespondedAt() (r time.Time, exists bool) {
+	v := m.responded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRespondedAt returns the old "responded_at" field's value of the ConnectionRequest entity.
+// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionRequestMutation) OldRespondedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRespondedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRespondedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRespondedAt: %w", err)
+	}
+	return oldValue.RespondedAt, nil
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (m *ConnectionRequestMutation) ClearRespondedAt() {
+	m.responded_at = nil
+	m.clearedFields[connectionrequest.FieldRespondedAt] = struct{}{}
+}
+
+// RespondedAtCleared returns if the "responded_at" field was cleared in this mutation.
+func (m *ConnectionRequestMutation) RespondedAtCleared() bool {
+	_, ok := m.clearedFields[connectionrequest.FieldRespondedAt]
+	return ok
+}
+
+// ResetRespondedAt resets all changes to the "responded_at" field.
+func (m *ConnectionRequestMutation) ResetRespondedAt() {
+	m.responded_at = nil
+	delete(m.clearedFields, connectionrequest.FieldRespondedAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ConnectionRequestMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ConnectionRequestMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ConnectionRequest entity.
+// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionRequestMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ConnectionRequestMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[connectionrequest.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ConnectionRequestMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[connectionrequest.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ConnectionRequestMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, connectionrequest.FieldExpiresAt)
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (m *ConnectionRequestMutation) ClearSender() {
+	m.clearedsender = true
+	m.clearedFields[connectionrequest.FieldSenderID] = struct{}{}
+}
+
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *ConnectionRequestMutation) SenderCleared() bool {
+	return m.clearedsender
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *ConnectionRequestMutation) SenderIDs() (ids []uuid.UUID) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *ConnectionRequestMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// ClearReceiver clears the "receiver" edge to the User entity.
+func (m *ConnectionRequestMutation) ClearReceiver() {
+	m.clearedreceiver = true
+	m.clearedFields[connectionrequest.FieldReceiverID] = struct{}{}
+}
+
+// ReceiverCleared reports if the "receiver" edge to the User entity was cleared.
+func (m *ConnectionRequestMutation) ReceiverCleared() bool {
+	return m.clearedreceiver
+}
+
+// ReceiverIDs returns the "receiver" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReceiverID instead. It exists only for internal usage by the builders.
+func (m *ConnectionRequestMutation) ReceiverIDs() (ids []uuid.UUID) {
+	if id := m.receiver; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReceiver resets all changes to the "receiver" edge.
+func (m *ConnectionRequestMutation) ResetReceiver() {
+	m.receiver = nil
+	m.clearedreceiver = false
+}
+
+// Where appends a list predicates to the ConnectionRequestMutation builder.
+func (m *ConnectionRequestMutation) Where(ps ...predicate.ConnectionRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConnectionRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConnectionRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConnectionRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConnectionRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConnectionRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConnectionRequest).
+func (m *ConnectionRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConnectionRequestMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.sender != nil {
+		fields = append(fields, connectionrequest.FieldSenderID)
+	}
+	if m.receiver != nil {
+		fields = append(fields, connectionrequest.FieldReceiverID)
+	}
+	if m.status != nil {
+		fields = append(fields, connectionrequest.FieldStatus)
+	}
+	if m.message != nil {
+		fields = append(fields, connectionrequest.FieldMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, connectionrequest.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, connectionrequest.FieldUpdatedAt)
+	}
+	if m.responded_at != nil {
+		fields = append(fields, connectionrequest.FieldRespondedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, connectionrequest.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConnectionRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case connectionrequest.FieldSenderID:
+		return m.SenderID()
+	case connectionrequest.FieldReceiverID:
+		return m.ReceiverID()
+	case connectionrequest.FieldStatus:
+		return m.Status()
+	case connectionrequest.FieldMessage:
+		return m.Message()
+	case connectionrequest.FieldCreatedAt:
+		return m.CreatedAt()
+	case connectionrequest.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case connectionrequest.FieldRespondedAt:
+		return m.RespondedAt()
+	case connectionrequest.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConnectionRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case connectionrequest.FieldSenderID:
+		return m.OldSenderID(ctx)
+	case connectionrequest.FieldReceiverID:
+		return m.OldReceiverID(ctx)
+	case connectionrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case connectionrequest.FieldMessage:
+		return m.OldMessage(ctx)
+	case connectionrequest.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case connectionrequest.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case connectionrequest.FieldRespondedAt:
+		return m.OldRespondedAt(ctx)
+	case connectionrequest.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConnectionRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectionRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case connectionrequest.FieldSenderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderID(v)
+		return nil
+	case connectionrequest.FieldReceiverID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiverID(v)
+		return nil
+	case connectionrequest.FieldStatus:
+		v, ok := value.(connectionrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case connectionrequest.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case connectionrequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case connectionrequest.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case connectionrequest.FieldRespondedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRespondedAt(v)
+		return nil
+	case connectionrequest.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConnectionRequestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConnectionRequestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectionRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConnectionRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConnectionRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(connectionrequest.FieldMessage) {
+		fields = append(fields, connectionrequest.FieldMessage)
+	}
+	if m.FieldCleared(connectionrequest.FieldRespondedAt) {
+		fields = append(fields, connectionrequest.FieldRespondedAt)
+	}
+	if m.FieldCleared(connectionrequest.FieldExpiresAt) {
+		fields = append(fields, connectionrequest.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConnectionRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConnectionRequestMutation) ClearField(name string) error {
+	switch name {
+	case connectionrequest.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case connectionrequest.FieldRespondedAt:
+		m.ClearRespondedAt()
+		return nil
+	case connectionrequest.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConnectionRequestMutation) ResetField(name string) error {
+	switch name {
+	case connectionrequest.FieldSenderID:
+		m.ResetSenderID()
+		return nil
+	case connectionrequest.FieldReceiverID:
+		m.ResetReceiverID()
+		return nil
+	case connectionrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case connectionrequest.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case connectionrequest.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case connectionrequest.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case connectionrequest.FieldRespondedAt:
+		m.ResetRespondedAt()
+		return nil
+	case connectionrequest.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConnectionRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.sender != nil {
+		edges = append(edges, connectionrequest.EdgeSender)
+	}
+	if m.receiver != nil {
+		edges = append(edges, connectionrequest.EdgeReceiver)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConnectionRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case connectionrequest.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case connectionrequest.EdgeReceiver:
+		if id := m.receiver; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConnectionRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConnectionRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConnectionRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsender {
+		edges = append(edges, connectionrequest.EdgeSender)
+	}
+	if m.clearedreceiver {
+		edges = append(edges, connectionrequest.EdgeReceiver)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConnectionRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case connectionrequest.EdgeSender:
+		return m.clearedsender
+	case connectionrequest.EdgeReceiver:
+		return m.clearedreceiver
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConnectionRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case connectionrequest.EdgeSender:
+		m.ClearSender()
+		return nil
+	case connectionrequest.EdgeReceiver:
+		m.ClearReceiver()
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConnectionRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case connectionrequest.EdgeSender:
+		m.ResetSender()
+		return nil
+	case connectionrequest.EdgeReceiver:
+		m.ResetReceiver()
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionRequest edge %s", name)
+}
+
+// EventMutation represents an operation that mutates the Event nodes in the graph.
+type EventMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	title                   *string
+	description             *string
+	event_type              *string
+	location                **schema.Point
+	location_name           *string
+	max_participants        *int
+	addmax_participants     *int
+	current_participants    *int
+	addcurrent_participants *int
+	start_time              *time.Time
+	end_time                *time.Time
+	is_active               *bool
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	creator                 *uuid.UUID
+	clearedcreator          bool
+	participants            map[uuid.UUID]struct{}
+	removedparticipants     map[uuid.UUID]struct{}
+	clearedparticipants     bool
+	done                    bool
+	oldValue                func(context.Context) (*Event, error)
+	predicates              []predicate.Event
+}
+
+var _ ent.Mutation = (*EventMutation)(nil)
+
+// eventOption allows management of the mutation configuration using functional options.
+type eventOption func(*EventMutation)
+
+// newEventMutation creates new mutation for the Event entity.
+func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
+	m := &EventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventID sets the ID field of the mutation.
+func withEventID(id uuid.UUID) eventOption {
+	return func(m *EventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Event
+		)
+		m.oldValue = func(ctx context.Context) (*Event, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Event.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEvent sets the old Event of the mutation.
+func withEvent(node *Event) eventOption {
+	return func(m *EventMutation) {
+		m.oldValue = func(context.Context) (*Event, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Event entities.
+func (m *EventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (m *EventMutation) SetCreatorID(u uuid.UUID) {
+	m.creator = &u
+}
+
+// CreatorID returns the value of the "creator_id" field in the mutation.
+func (m *EventMutation) CreatorID() (r uuid.UUID, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatorID returns the old "creator_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCreatorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatorID: %w", err)
+	}
+	return oldValue.CreatorID, nil
+}
+
+// ResetCreatorID resets all changes to the "creator_id" field.
+func (m *EventMutation) ResetCreatorID() {
+	m.creator = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *EventMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *EventMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *EventMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EventMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EventMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EventMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[event.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EventMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[event.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EventMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, event.FieldDescription)
+}
+
+// SetEventType sets the "event_type" field.
+func (m *EventMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *EventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (m *EventMutation) ClearEventType() {
+	m.event_type = nil
+	m.clearedFields[event.FieldEventType] = struct{}{}
+}
+
+// EventTypeCleared returns if the "event_type" field was cleared in this mutation.
+func (m *EventMutation) EventTypeCleared() bool {
+	_, ok := m.clearedFields[event.FieldEventType]
+	return ok
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *EventMutation) ResetEventType() {
+	m.event_type = nil
+	delete(m.clearedFields, event.FieldEventType)
+}
+
+// SetLocation sets the "location" field.
+func (m *EventMutation) SetLocation(s *schema.Point) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *EventMutation) Location() (r *schema.Point, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldLocation(ctx context.Context) (v *schema.Point, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *EventMutation) ResetLocation() {
+	m.location = nil
+}
+
+// SetLocationName sets the "location_name" field.
+func (m *EventMutation) SetLocationName(s string) {
+	m.location_name = &s
+}
+
+// LocationName returns the value of the "location_name" field in the mutation.
+func (m *EventMutation) LocationName() (r string, exists bool) {
+	v := m.location_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationName returns the old "location_name" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldLocationName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationName: %w", err)
+	}
+	return oldValue.LocationName, nil
+}
+
+// ClearLocationName clears the value of the "location_name" field.
+func (m *EventMutation) ClearLocationName() {
+	m.location_name = nil
+	m.clearedFields[event.FieldLocationName] = struct{}{}
+}
+
+// LocationNameCleared returns if the "location_name" field was cleared in this mutation.
+func (m *EventMutation) LocationNameCleared() bool {
+	_, ok := m.clearedFields[event.FieldLocationName]
+	return ok
+}
+
+// ResetLocationName resets all changes to the "location_name" field.
+func (m *EventMutation) ResetLocationName() {
+	m.location_name = nil
+	delete(m.clearedFields, event.FieldLocationName)
+}
+
+// SetMaxParticipants sets the "max_participants" field.
+func (m *EventMutation) SetMaxParticipants(i int) {
+	m.max_participants = &i
+	m.addmax_participants = nil
+}
+
+// MaxParticipants returns the value of the "max_participants" field in the mutation.
+func (m *EventMutation) MaxParticipants() (r int, exists bool) {
+	v := m.max_participants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxParticipants returns the old "max_participants" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldMaxParticipants(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxParticipants is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxParticipants requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxParticipants: %w", err)
+	}
+	return oldValue.MaxParticipants, nil
+}
+
+// AddMaxParticipants adds i to the "max_participants" field.
+func (m *EventMutation) AddMaxParticipants(i int) {
+	if m.addmax_participants != nil {
+		*m.addmax_participants += i
+	} else {
+		m.addmax_participants = &i
+	}
+}
+
+// AddedMaxParticipants returns the value that was added to the "max_participants" field in this mutation.
+func (m *EventMutation) AddedMaxParticipants() (r int, exists bool) {
+	v := m.addmax_participants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxParticipants clears the value of the "max_participants" field.
+func (m *EventMutation) ClearMaxParticipants() {
+	m.max_participants = nil
+	m.addmax_participants = nil
+	m.clearedFields[event.FieldMaxParticipants] = struct{}{}
+}
+
+// MaxParticipantsCleared returns if the "max_participants" field was cleared in this mutation.
+func (m *EventMutation) MaxParticipantsCleared() bool {
+	_, ok := m.clearedFields[event.FieldMaxParticipants]
+	return ok
+}
+
+// ResetMaxParticipants resets all changes to the "max_participants" field.
+func (m *EventMutation) ResetMaxParticipants() {
+	m.max_participants = nil
+	m.addmax_participants = nil
+	delete(m.clearedFields, event.FieldMaxParticipants)
+}
+
+// SetCurrentParticipants sets the "current_participants" field.
+func (m *EventMutation) SetCurrentParticipants(i int) {
+	m.current_participants = &i
+	m.addcurrent_participants = nil
+}
+
+// CurrentParticipants returns the value of the "current_participants" field in the mutation.
+func (m *EventMutation) CurrentParticipants() (r int, exists bool) {
+	v := m.current_participants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentParticipants returns the old "current_participants" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCurrentParticipants(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentParticipants is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentParticipants requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentParticipants: %w", err)
+	}
+	return oldValue.CurrentParticipants, nil
+}
+
+// AddCurrentParticipants adds i to the "current_participants" field.
+func (m *EventMutation) AddCurrentParticipants(i int) {
+	if m.addcurrent_participants != nil {
+		*m.addcurrent_participants += i
+	} else {
+		m.addcurrent_participants = &i
+	}
+}
+
+// AddedCurrentParticipants returns the value that was added to the "current_participants" field in this mutation.
+func (m *EventMutation) AddedCurrentParticipants() (r int, exists bool) {
+	v := m.addcurrent_participants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurrentParticipants resets all changes to the "current_participants" field.
+func (m *EventMutation) ResetCurrentParticipants() {
+	m.current_participants = nil
+	m.addcurrent_participants = nil
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *EventMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *EventMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *EventMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *EventMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *EventMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (m *EventMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[event.FieldEndTime] = struct{}{}
+}
+
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *EventMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[event.FieldEndTime]
+	return ok
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *EventMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, event.FieldEndTime)
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *EventMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *EventMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *EventMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *EventMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[event.FieldCreatorID] = struct{}{}
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *EventMutation) CreatorCleared() bool {
+	return m.clearedcreator
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *EventMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *EventMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// AddParticipantIDs adds the "participants" edge to the EventParticipant entity by ids.
+func (m *EventMutation) AddParticipantIDs(ids ...uuid.UUID) {
+	if m.participants == nil {
+		m.participants = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.participants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParticipants clears the "participants" edge to the EventParticipant entity.
+func (m *EventMutation) ClearParticipants() {
+	m.clearedparticipants = true
+}
+
+// ParticipantsCleared reports if the "participants" edge to the EventParticipant entity was cleared.
+func (m *EventMutation) ParticipantsCleared() bool {
+	return m.clearedparticipants
+}
+
+// RemoveParticipantIDs removes the "participants" edge to the EventParticipant entity by IDs.
+func (m *EventMutation) RemoveParticipantIDs(ids ...uuid.UUID) {
+	if m.removedparticipants == nil {
+		m.removedparticipants = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.participants, ids[i])
+		m.removedparticipants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParticipants returns the removed IDs of the "participants" edge to the EventParticipant entity.
+func (m *EventMutation) RemovedParticipantsIDs() (ids []uuid.UUID) {
+	for id := range m.removedparticipants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParticipantsIDs returns the "participants" edge IDs in the mutation.
+func (m *EventMutation) ParticipantsIDs() (ids []uuid.UUID) {
+	for id := range m.participants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParticipants resets all changes to the "participants" edge.
+func (m *EventMutation) ResetParticipants() {
+	m.participants = nil
+	m.clearedparticipants = false
+	m.removedparticipants = nil
+}
+
+// Where appends a list predicates to the EventMutation builder.
+func (m *EventMutation) Where(ps ...predicate.Event) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Event, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Event).
+func (m *EventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.creator != nil {
+		fields = append(fields, event.FieldCreatorID)
+	}
+	if m.title != nil {
+		fields = append(fields, event.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, event.FieldDescription)
+	}
+	if m.event_type != nil {
+		fields = append(fields, event.FieldEventType)
+	}
+	if m.location != nil {
+		fields = append(fields, event.FieldLocation)
+	}
+	if m.location_name != nil {
+		fields = append(fields, event.FieldLocationName)
+	}
+	if m.max_participants != nil {
+		fields = append(fields, event.FieldMaxParticipants)
+	}
+	if m.current_participants != nil {
+		fields = append(fields, event.FieldCurrentParticipants)
+	}
+	if m.start_time != nil {
+		fields = append(fields, event.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, event.FieldEndTime)
+	}
+	if m.is_active != nil {
+		fields = append(fields, event.FieldIsActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, event.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, event.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldCreatorID:
+		return m.CreatorID()
+	case event.FieldTitle:
+		return m.Title()
+	case event.FieldDescription:
+		return m.Description()
+	case event.FieldEventType:
+		return m.EventType()
+	case event.FieldLocation:
+		return m.Location()
+	case event.FieldLocationName:
+		return m.LocationName()
+	case event.FieldMaxParticipants:
+		return m.MaxParticipants()
+	case event.FieldCurrentParticipants:
+		return m.CurrentParticipants()
+	case event.FieldStartTime:
+		return m.StartTime()
+	case event.FieldEndTime:
+		return m.EndTime()
+	case event.FieldIsActive:
+		return m.IsActive()
+	case event.FieldCreatedAt:
+		return m.CreatedAt()
+	case event.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case event.FieldCreatorID:
+		return m.OldCreatorID(ctx)
+	case event.FieldTitle:
+		return m.OldTitle(ctx)
+	case event.FieldDescription:
+		return m.OldDescription(ctx)
+	case event.FieldEventType:
+		return m.OldEventType(ctx)
+	case event.FieldLocation:
+		return m.OldLocation(ctx)
+	case event.FieldLocationName:
+		return m.OldLocationName(ctx)
+	case event.FieldMaxParticipants:
+		return m.OldMaxParticipants(ctx)
+	case event.FieldCurrentParticipants:
+		return m.OldCurrentParticipants(ctx)
+	case event.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case event.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case event.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case event.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case event.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Event field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldCreatorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatorID(v)
+		return nil
+	case event.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case event.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case event.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case event.FieldLocation:
+		v, ok := value.(*schema.Point)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case event.FieldLocationName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationName(v)
+		return nil
+	case event.FieldMaxParticipants:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxParticipants(v)
+		return nil
+	case event.FieldCurrentParticipants:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentParticipants(v)
+		return nil
+	case event.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case event.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case event.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case event.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case event.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_participants != nil {
+		fields = append(fields, event.FieldMaxParticipants)
+	}
+	if m.addcurrent_participants != nil {
+		fields = append(fields, event.FieldCurrentParticipants)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldMaxParticipants:
+		return m.AddedMaxParticipants()
+	case event.FieldCurrentParticipants:
+		return m.AddedCurrentParticipants()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldMaxParticipants:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxParticipants(v)
+		return nil
+	case event.FieldCurrentParticipants:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentParticipants(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(event.FieldDescription) {
+		fields = append(fields, event.FieldDescription)
+	}
+	if m.FieldCleared(event.FieldEventType) {
+		fields = append(fields, event.FieldEventType)
+	}
+	if m.FieldCleared(event.FieldLocationName) {
+		fields = append(fields, event.FieldLocationName)
+	}
+	if m.FieldCleared(event.FieldMaxParticipants) {
+		fields = append(fields, event.FieldMaxParticipants)
+	}
+	if m.FieldCleared(event.FieldEndTime) {
+		fields = append(fields, event.FieldEndTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventMutation) ClearField(name string) error {
+	switch name {
+	case event.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case event.FieldEventType:
+		m.ClearEventType()
+		return nil
+	case event.FieldLocationName:
+		m.ClearLocationName()
+		return nil
+	case event.FieldMaxParticipants:
+		m.ClearMaxParticipants()
+		return nil
+	case event.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Event nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventMutation) ResetField(name string) error {
+	switch name {
+	case event.FieldCreatorID:
+		m.ResetCreatorID()
+		return nil
+	case event.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case event.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case event.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case event.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case event.FieldLocationName:
+		m.ResetLocationName()
+		return nil
+	case event.FieldMaxParticipants:
+		m.ResetMaxParticipants()
+		return nil
+	case event.FieldCurrentParticipants:
+		m.ResetCurrentParticipants()
+		return nil
+	case event.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case event.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case event.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case event.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case event.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.creator != nil {
+		edges = append(edges, event.EdgeCreator)
+	}
+	if m.participants != nil {
+		edges = append(edges, event.EdgeParticipants)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	case event.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.participants))
+		for id := range m.participants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedparticipants != nil {
+		edges = append(edges, event.EdgeParticipants)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.removedparticipants))
+		for id := range m.removedparticipants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcreator {
+		edges = append(edges, event.EdgeCreator)
+	}
+	if m.clearedparticipants {
+		edges = append(edges, event.EdgeParticipants)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case event.EdgeCreator:
+		return m.clearedcreator
+	case event.EdgeParticipants:
+		return m.clearedparticipants
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventMutation) ClearEdge(name string) error {
+	switch name {
+	case event.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown Event unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventMutation) ResetEdge(name string) error {
+	switch name {
+	case event.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	case event.EdgeParticipants:
+		m.ResetParticipants()
+		return nil
+	}
+	return fmt.Errorf("unknown Event edge %s", name)
+}
+
+// EventParticipantMutation represents an operation that mutates the EventParticipant nodes in the graph.
+type EventParticipantMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	status        *eventparticipant.Status
+	joined_at     *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	event         *uuid.UUID
+	clearedevent  bool
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*EventParticipant, error)
+	predicates    []predicate.EventParticipant
+}
+
+var _ ent.Mutation = (*EventParticipantMutation)(nil)
+
+// eventparticipantOption allows management of the mutation configuration using functional options.
+type eventparticipantOption func(*EventParticipantMutation)
+
+// newEventParticipantMutation creates new mutation for the EventParticipant entity.
+func newEventParticipantMutation(c config, op Op, opts ...eventparticipantOption) *EventParticipantMutation {
+	m := &EventParticipantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEventParticipant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventParticipantID sets the ID field of the mutation.
+func withEventParticipantID(id uuid.UUID) eventparticipantOption {
+	return func(m *EventParticipantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EventParticipant
+		)
+		m.oldValue = func(ctx context.Context) (*EventParticipant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EventParticipant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEventParticipant sets the old EventParticipant of the mutation.
+func withEventParticipant(node *EventParticipant) eventparticipantOption {
+	return func(m *EventParticipantMutation) {
+		m.oldValue = func(context.Context) (*EventParticipant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventParticipantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventParticipantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EventParticipant entities.
+func (m *EventParticipantMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventParticipantMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventParticipantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EventParticipant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEventID sets the "event_id" field.
+func (m *EventParticipantMutation) SetEventID(u uuid.UUID) {
+	m.event = &u
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *EventParticipantMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the EventParticipant entity.
+// If the EventParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventParticipantMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *EventParticipantMutation) ResetEventID() {
+	m.event = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *EventParticipantMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EventParticipantMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the EventParticipant entity.
+// If the EventParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventParticipantMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EventParticipantMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *EventParticipantMutation) SetStatus(e eventparticipant.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EventParticipantMutation) Status() (r eventparticipant.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the EventParticipant entity.
+// If the EventParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventParticipantMutation) OldStatus(ctx context.Context) (v eventparticipant.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EventParticipantMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *EventParticipantMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *EventParticipantMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the EventParticipant entity.
+// If the EventParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventParticipantMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *EventParticipantMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventParticipantMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventParticipantMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EventParticipant entity.
+// If the EventParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventParticipantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventParticipantMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (m *EventParticipantMutation) ClearEvent() {
+	m.clearedevent = true
+	m.clearedFields[eventparticipant.FieldEventID] = struct{}{}
+}
+
+// EventCleared reports if the "event" edge to the Event entity was cleared.
+func (m *EventParticipantMutation) EventCleared() bool {
+	return m.clearedevent
+}
+
+// EventIDs returns the "event" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EventID instead. It exists only for internal usage by the builders.
+func (m *EventParticipantMutation) EventIDs() (ids []uuid.UUID) {
+	if id := m.event; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEvent resets all changes to the "event" edge.
+func (m *EventParticipantMutation) ResetEvent() {
+	m.event = nil
+	m.clearedevent = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *EventParticipantMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[eventparticipant.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *EventParticipantMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *EventParticipantMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *EventParticipantMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the EventParticipantMutation builder.
+func (m *EventParticipantMutation) Where(ps ...predicate.EventParticipant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventParticipantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventParticipantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventParticipant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventParticipantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventParticipantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EventParticipant).
+func (m *EventParticipantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventParticipantMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.event != nil {
+		fields = append(fields, eventparticipant.FieldEventID)
+	}
+	if m.user != nil {
+		fields = append(fields, eventparticipant.FieldUserID)
+	}
+	if m.status != nil {
+		fields = append(fields, eventparticipant.FieldStatus)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, eventparticipant.FieldJoinedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, eventparticipant.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventParticipantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventparticipant.FieldEventID:
+		return m.EventID()
+	case eventparticipant.FieldUserID:
+		return m.UserID()
+	case eventparticipant.FieldStatus:
+		return m.Status()
+	case eventparticipant.FieldJoinedAt:
+		return m.JoinedAt()
+	case eventparticipant.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventParticipantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventparticipant.FieldEventID:
+		return m.OldEventID(ctx)
+	case eventparticipant.FieldUserID:
+		return m.OldUserID(ctx)
+	case eventparticipant.FieldStatus:
+		return m.OldStatus(ctx)
+	case eventparticipant.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case eventparticipant.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventParticipant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventParticipantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventparticipant.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case eventparticipant.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case eventparticipant.FieldStatus:
+		v, ok := value.(eventparticipant.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case eventparticipant.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	case eventparticipant.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventParticipant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventParticipantMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventParticipantMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventParticipantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EventParticipant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventParticipantMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventParticipantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventParticipantMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EventParticipant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventParticipantMutation) ResetField(name string) error {
+	switch name {
+	case eventparticipant.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case eventparticipant.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case eventparticipant.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case eventparticipant.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case eventparticipant.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EventParticipant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventParticipantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.event != nil {
+		edges = append(edges, eventparticipant.EdgeEvent)
+	}
+	if m.user != nil {
+		edges = append(edges, eventparticipant.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventParticipantMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case eventparticipant.EdgeEvent:
+		if id := m.event; id != nil {
+			return []ent.Value{*id}
+		}
+	case eventparticipant.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventParticipantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventParticipantMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventParticipantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedevent {
+		edges = append(edges, eventparticipant.EdgeEvent)
+	}
+	if m.cleareduser {
+		edges = append(edges, eventparticipant.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventParticipantMutation) EdgeCleared(name string) bool {
+	switch name {
+	case eventparticipant.EdgeEvent:
+		return m.clearedevent
+	case eventparticipant.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventParticipantMutation) ClearEdge(name string) error {
+	switch name {
+	case eventparticipant.EdgeEvent:
+		m.ClearEvent()
+		return nil
+	case eventparticipant.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown EventParticipant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventParticipantMutation) ResetEdge(name string) error {
+	switch name {
+	case eventparticipant.EdgeEvent:
+		m.ResetEvent()
+		return nil
+	case eventparticipant.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown EventParticipant edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	client_message_id *string
+	_type             *message.Type
+	content           *string
+	media_url         *string
+	media_type        *string
+	media_public_id   *string
+	is_read           *bool
+	created_at        *time.Time
+	updated_at        *time.Time
+	read_at           *time.Time
+	delivered_at      *time.Time
+	edited_at         *time.Time
+	is_deleted        *bool
+	deleted_at        *time.Time
+	clearedFields     map[string]struct{}
+	connection        *uuid.UUID
+	clearedconnection bool
+	sender            *uuid.UUID
+	clearedsender     bool
+	receiver          *uuid.UUID
+	clearedreceiver   bool
+	edits             map[uuid.UUID]struct{}
+	removededits      map[uuid.UUID]struct{}
+	clearededits      bool
+	reactions         map[uuid.UUID]struct{}
+	removedreactions  map[uuid.UUID]struct{}
+	clearedreactions  bool
+	reply_to          *uuid.UUID
+	clearedreply_to   bool
+	replies           map[uuid.UUID]struct{}
+	removedreplies    map[uuid.UUID]struct{}
+	clearedreplies    bool
+	done              bool
+	oldValue          func(context.Context) (*Message, error)
+	predicates        []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id uuid.UUID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (m *MessageMutation) SetConnectionID(u uuid.UUID) {
+	m.connection = &u
+}
+
+// ConnectionID returns the value of the "connection_id" field in the mutation.
+func (m *MessageMutation) ConnectionID() (r uuid.UUID, exists bool) {
+	v := m.connection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectionID returns the old "connection_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldConnectionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectionID: %w", err)
+	}
+	return oldValue.ConnectionID, nil
+}
+
+// ResetConnectionID resets all changes to the "connection_id" field.
+func (m *MessageMutation) ResetConnectionID() {
+	m.connection = nil
+}
+
+// SetSenderID sets the "sender_id" field.
+func (m *MessageMutation) SetSenderID(u uuid.UUID) {
+	m.sender = &u
+}
+
+// SenderID returns the value of the "sender_id" field in the mutation.
+func (m *MessageMutation) SenderID() (r uuid.UUID, exists bool) {
+	v := m.sender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderID returns the old "sender_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSenderID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+	}
+	return oldValue.SenderID, nil
+}
+
+// ResetSenderID resets all changes to the "sender_id" field.
+func (m *MessageMutation) ResetSenderID() {
+	m.sender = nil
+}
+
+// SetReceiverID sets the "receiver_id" field.
+func (m *MessageMutation) SetReceiverID(u uuid.UUID) {
+	m.receiver = &u
+}
+
+// ReceiverID returns the value of the "receiver_id" field in the mutation.
+func (m *MessageMutation) ReceiverID() (r uuid.UUID, exists bool) {
+	v := m.receiver
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiverID returns the old "receiver_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReceiverID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiverID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiverID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiverID: %w", err)
+	}
+	return oldValue.ReceiverID, nil
+}
+
+// ResetReceiverID resets all changes to the "receiver_id" field.
+func (m *MessageMutation) ResetReceiverID() {
+	m.receiver = nil
+}
+
+// SetClientMessageID sets the "client_message_id" field.
+func (m *MessageMutation) SetClientMessageID(s string) {
+	m.client_message_id = &s
+}
+
+// ClientMessageID returns the value of the "client_message_id" field in the mutation.
+func (m *MessageMutation) ClientMessageID() (r string, exists bool) {
+	v := m.client_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientMessageID returns the old "client_message_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldClientMessageID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientMessageID: %w", err)
+	}
+	return oldValue.ClientMessageID, nil
+}
+
+// ClearClientMessageID clears the value of the "client_message_id" field.
+func (m *MessageMutation) ClearClientMessageID() {
+	m.client_message_id = nil
+	m.clearedFields[message.FieldClientMessageID] = struct{}{}
+}
+
+// ClientMessageIDCleared returns if the "client_message_id" field was cleared in this mutation.
+func (m *MessageMutation) ClientMessageIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldClientMessageID]
+	return ok
+}
+
+// ResetClientMessageID resets all changes to the "client_message_id" field.
+func (m *MessageMutation) ResetClientMessageID() {
+	m.client_message_id = nil
+	delete(m.clearedFields, message.FieldClientMessageID)
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (m *MessageMutation) SetReplyToID(u uuid.UUID) {
+	m.reply_to = &u
+}
+
+// ReplyToID returns the value of the "reply_to_id" field in the mutation.
+func (m *MessageMutation) ReplyToID() (r uuid.UUID, exists bool) {
+	v := m.reply_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToID returns the old "reply_to_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReplyToID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
+	}
+	return oldValue.ReplyToID, nil
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (m *MessageMutation) ClearReplyToID() {
+	m.reply_to = nil
+	m.clearedFields[message.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToIDCleared returns if the "reply_to_id" field was cleared in this mutation.
+func (m *MessageMutation) ReplyToIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldReplyToID]
+	return ok
+}
+
+// ResetReplyToID resets all changes to the "reply_to_id" field.
+func (m *MessageMutation) ResetReplyToID() {
+	m.reply_to = nil
+	delete(m.clearedFields, message.FieldReplyToID)
+}
+
+// SetType sets the "type" field.
+func (m *MessageMutation) SetType(value message.Type) {
+	m._type = &value
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *MessageMutation) GetType() (r message.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldType(ctx context.Context) (v message.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *MessageMutation) ResetType() {
+	m._type = nil
+}
+
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *MessageMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[message.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *MessageMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[message.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, message.FieldContent)
+}
+
+// SetMediaURL sets the "media_url" field.
+func (m *MessageMutation) SetMediaURL(s string) {
+	m.media_url = &s
+}
+
+// MediaURL returns the value of the "media_url" field in the mutation.
+func (m *MessageMutation) MediaURL() (r string, exists bool) {
+	v := m.media_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaURL returns the old "media_url" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMediaURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaURL: %w", err)
+	}
+	return oldValue.MediaURL, nil
+}
+
+// ClearMediaURL clears the value of the "media_url" field.
+func (m *MessageMutation) ClearMediaURL() {
+	m.media_url = nil
+	m.clearedFields[message.FieldMediaURL] = struct{}{}
+}
+
+// MediaURLCleared returns if the "media_url" field was cleared in this mutation.
+func (m *MessageMutation) MediaURLCleared() bool {
+	_, ok := m.clearedFields[message.FieldMediaURL]
+	return ok
+}
+
+// ResetMediaURL resets all changes to the "media_url" field.
+func (m *MessageMutation) ResetMediaURL() {
+	m.media_url = nil
+	delete(m.clearedFields, message.FieldMediaURL)
+}
+
+// SetMediaType sets the "media_type" field.
+func (m *MessageMutation) SetMediaType(s string) {
+	m.media_type = &s
+}
+
+// MediaType returns the value of the "media_type" field in the mutation.
+func (m *MessageMutation) MediaType() (r string, exists bool) {
+	v := m.media_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaType returns the old "media_type" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMediaType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaType: %w", err)
+	}
+	return oldValue.MediaType, nil
+}
+
+// ClearMediaType clears the value of the "media_type" field.
+func (m *MessageMutation) ClearMediaType() {
+	m.media_type = nil
+	m.clearedFields[message.FieldMediaType] = struct{}{}
+}
+
+// MediaTypeCleared returns if the "media_type" field was cleared in this mutation.
+func (m *MessageMutation) MediaTypeCleared() bool {
+	_, ok := m.clearedFields[message.FieldMediaType]
+	return ok
+}
+
+// ResetMediaType resets all changes to the "media_type" field.
+func (m *MessageMutation) ResetMediaType() {
+	m.media_type = nil
+	delete(m.clearedFields, message.FieldMediaType)
+}
+
+// SetMediaPublicID sets the "media_public_id" field.
+func (m *MessageMutation) SetMediaPublicID(s string) {
+	m.media_public_id = &s
+}
+
+// MediaPublicID returns the value of the "media_public_id" field in the mutation.
+func (m *MessageMutation) MediaPublicID() (r string, exists bool) {
+	v := m.media_public_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaPublicID returns the old "media_public_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMediaPublicID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaPublicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaPublicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaPublicID: %w", err)
+	}
+	return oldValue.MediaPublicID, nil
+}
+
+// ClearMediaPublicID clears the value of the "media_public_id" field.
+func (m *MessageMutation) ClearMediaPublicID() {
+	m.media_public_id = nil
+	m.clearedFields[message.FieldMediaPublicID] = struct{}{}
+}
+
+// MediaPublicIDCleared returns if the "media_public_id" field was cleared in this mutation.
+func (m *MessageMutation) MediaPublicIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldMediaPublicID]
+	return ok
+}
+
+// ResetMediaPublicID resets all changes to the "media_public_id" field.
+func (m *MessageMutation) ResetMediaPublicID() {
+	m.media_public_id = nil
+	delete(m.clearedFields, message.FieldMediaPublicID)
+}
+
+// SetIsRead sets the "is_read" field.
+func (m *MessageMutation) SetIsRead(b bool) {
+	m.is_read = &b
+}
+
+// IsRead returns the value of the "is_read" field in the mutation.
+func (m *MessageMutation) IsRead() (r bool, exists bool) {
+	v := m.is_read
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsRead returns the old "is_read" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsRead(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRead is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRead requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRead: %w", err)
+	}
+	return oldValue.IsRead, nil
+}
+
+// ResetIsRead resets all changes to the "is_read" field.
+func (m *MessageMutation) ResetIsRead() {
+	m.is_read = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *MessageMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *MessageMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReadAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (m *MessageMutation) ClearReadAt() {
+	m.read_at = nil
+	m.clearedFields[message.FieldReadAt] = struct{}{}
+}
+
+// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
+func (m *MessageMutation) ReadAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldReadAt]
+	return ok
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *MessageMutation) ResetReadAt() {
+	m.read_at = nil
+	delete(m.clearedFields, message.FieldReadAt)
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *MessageMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *MessageMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDeliveredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *MessageMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[message.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *MessageMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *MessageMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, message.FieldDeliveredAt)
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *MessageMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *MessageMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEditedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *MessageMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[message.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *MessageMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *MessageMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, message.FieldEditedAt)
+}
+
+// SetIsDeleted sets the "is_deleted" field.
+func (m *MessageMutation) SetIsDeleted(b bool) {
+	m.is_deleted = &b
+}
+
+// IsDeleted returns the value of the "is_deleted" field in the mutation.
+func (m *MessageMutation) IsDeleted() (r bool, exists bool) {
+	v := m.is_deleted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDeleted returns the old "is_deleted" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsDeleted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDeleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDeleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDeleted: %w", err)
+	}
+	return oldValue.IsDeleted, nil
+}
+
+// ResetIsDeleted resets all changes to the "is_deleted" field.
+func (m *MessageMutation) ResetIsDeleted() {
+	m.is_deleted = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MessageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MessageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[message.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MessageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MessageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, message.FieldDeletedAt)
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (m *MessageMutation) ClearConnection() {
+	m.clearedconnection = true
+	m.clearedFields[message.FieldConnectionID] = struct{}{}
+}
+
+// ConnectionCleared reports if the "connection" edge to the Connection entity was cleared.
+func (m *MessageMutation) ConnectionCleared() bool {
+	return m.clearedconnection
+}
+
+// ConnectionIDs returns the "connection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConnectionID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ConnectionIDs() (ids []uuid.UUID) {
+	if id := m.connection; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetConnection resets all changes to the "connection" edge.
+func (m *MessageMutation) ResetConnection() {
+	m.connection = nil
+	m.clearedconnection = false
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (m *MessageMutation) ClearSender() {
+	m.clearedsender = true
+	m.clearedFields[message.FieldSenderID] = struct{}{}
+}
+
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *MessageMutation) SenderCleared() bool {
+	return m.clearedsender
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) SenderIDs() (ids []uuid.UUID) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *MessageMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// ClearReceiver clears the "receiver" edge to the User entity.
+func (m *MessageMutation) ClearReceiver() {
+	m.clearedreceiver = true
+	m.clearedFields[message.FieldReceiverID] = struct{}{}
+}
+
+// ReceiverCleared reports if the "receiver" edge to the User entity was cleared.
+func (m *MessageMutation) ReceiverCleared() bool {
+	return m.clearedreceiver
+}
+
+// ReceiverIDs returns the "receiver" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReceiverID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ReceiverIDs() (ids []uuid.UUID) {
+	if id := m.receiver; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReceiver resets all changes to the "receiver" edge.
+func (m *MessageMutation) ResetReceiver() {
+	m.receiver = nil
+	m.clearedreceiver = false
+}
+
+// AddEditIDs adds the "edits" edge to the MessageEdit entity by ids.
+func (m *MessageMutation) AddEditIDs(ids ...uuid.UUID) {
+	if m.edits == nil {
+		m.edits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.edits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEdits clears the "edits" edge to the MessageEdit entity.
+func (m *MessageMutation) ClearEdits() {
+	m.clearededits = true
+}
+
+// EditsCleared reports if the "edits" edge to the MessageEdit entity was cleared.
+func (m *MessageMutation) EditsCleared() bool {
+	return m.clearededits
+}
+
+// RemoveEditIDs removes the "edits" edge to the MessageEdit entity by IDs.
+func (m *MessageMutation) RemoveEditIDs(ids ...uuid.UUID) {
+	if m.removededits == nil {
+		m.removededits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.edits, ids[i])
+		m.removededits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEdits returns the removed IDs of the "edits" edge to the MessageEdit entity.
+func (m *MessageMutation) RemovedEditsIDs() (ids []uuid.UUID) {
+	for id := range m.removededits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EditsIDs returns the "edits" edge IDs in the mutation.
+func (m *MessageMutation) EditsIDs() (ids []uuid.UUID) {
+	for id := range m.edits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEdits resets all changes to the "edits" edge.
+func (m *MessageMutation) ResetEdits() {
+	m.edits = nil
+	m.clearededits = false
+	m.removededits = nil
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by ids.
+func (m *MessageMutation) AddReactionIDs(ids ...uuid.UUID) {
+	if m.reactions == nil {
+		m.reactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReactions clears the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) ClearReactions() {
+	m.clearedreactions = true
+}
+
+// ReactionsCleared reports if the "reactions" edge to the MessageReaction entity was cleared.
+func (m *MessageMutation) ReactionsCleared() bool {
+	return m.clearedreactions
+}
+
+// RemoveReactionIDs removes the "reactions" edge to the MessageReaction entity by IDs.
+func (m *MessageMutation) RemoveReactionIDs(ids ...uuid.UUID) {
+	if m.removedreactions == nil {
+		m.removedreactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reactions, ids[i])
+		m.removedreactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReactions returns the removed IDs of the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) RemovedReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReactionsIDs returns the "reactions" edge IDs in the mutation.
+func (m *MessageMutation) ReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReactions resets all changes to the "reactions" edge.
+func (m *MessageMutation) ResetReactions() {
+	m.reactions = nil
+	m.clearedreactions = false
+	m.removedreactions = nil
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (m *MessageMutation) ClearReplyTo() {
+	m.clearedreply_to = true
+	m.clearedFields[message.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToCleared reports if the "reply_to" edge to the Message entity was cleared.
+func (m *MessageMutation) ReplyToCleared() bool {
+	return m.ReplyToIDCleared() || m.clearedreply_to
+}
+
+// ReplyToIDs returns the "reply_to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReplyToID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ReplyToIDs() (ids []uuid.UUID) {
+	if id := m.reply_to; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReplyTo resets all changes to the "reply_to" edge.
+func (m *MessageMutation) ResetReplyTo() {
+	m.reply_to = nil
+	m.clearedreply_to = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by ids.
+func (m *MessageMutation) AddReplyIDs(ids ...uuid.UUID) {
+	if m.replies == nil {
+		m.replies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the Message entity.
+func (m *MessageMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the Message entity was cleared.
+func (m *MessageMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the Message entity by IDs.
+func (m *MessageMutation) RemoveReplyIDs(ids ...uuid.UUID) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Message entity.
+func (m *MessageMutation) RemovedRepliesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *MessageMutation) RepliesIDs() (ids []uuid.UUID) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *MessageMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.connection != nil {
+		fields = append(fields, message.FieldConnectionID)
+	}
+	if m.sender != nil {
+		fields = append(fields, message.FieldSenderID)
+	}
+	if m.receiver != nil {
+		fields = append(fields, message.FieldReceiverID)
+	}
+	if m.client_message_id != nil {
+		fields = append(fields, message.FieldClientMessageID)
+	}
+	if m.reply_to != nil {
+		fields = append(fields, message.FieldReplyToID)
+	}
+	if m._type != nil {
+		fields = append(fields, message.FieldType)
+	}
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.media_url != nil {
+		fields = append(fields, message.FieldMediaURL)
+	}
+	if m.media_type != nil {
+		fields = append(fields, message.FieldMediaType)
+	}
+	if m.media_public_id != nil {
+		fields = append(fields, message.FieldMediaPublicID)
+	}
+	if m.is_read != nil {
+		fields = append(fields, message.FieldIsRead)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, message.FieldUpdatedAt)
+	}
+	if m.read_at != nil {
+		fields = append(fields, message.FieldReadAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, message.FieldDeliveredAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.is_deleted != nil {
+		fields = append(fields, message.FieldIsDeleted)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldConnectionID:
+		return m.ConnectionID()
+	case message.FieldSenderID:
+		return m.SenderID()
+	case message.FieldReceiverID:
+		return m.ReceiverID()
+	case message.FieldClientMessageID:
+		return m.ClientMessageID()
+	case message.FieldReplyToID:
+		return m.ReplyToID()
+	case message.FieldType:
+		return m.GetType()
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldMediaURL:
+		return m.MediaURL()
+	case message.FieldMediaType:
+		return m.MediaType()
+	case message.FieldMediaPublicID:
+		return m.MediaPublicID()
+	case message.FieldIsRead:
+		return m.IsRead()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	case message.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case message.FieldReadAt:
+		return m.ReadAt()
+	case message.FieldDeliveredAt:
+		return m.DeliveredAt()
+	case message.FieldEditedAt:
+		return m.EditedAt()
+	case message.FieldIsDeleted:
+		return m.IsDeleted()
+	case message.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldConnectionID:
+		return m.OldConnectionID(ctx)
+	case message.FieldSenderID:
+		return m.OldSenderID(ctx)
+	case message.FieldReceiverID:
+		return m.OldReceiverID(ctx)
+	case message.FieldClientMessageID:
+		return m.OldClientMessageID(ctx)
+	case message.FieldReplyToID:
+		return m.OldReplyToID(ctx)
+	case message.FieldType:
+		return m.OldType(ctx)
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldMediaURL:
+		return m.OldMediaURL(ctx)
+	case message.FieldMediaType:
+		return m.OldMediaType(ctx)
+	case message.FieldMediaPublicID:
+		return m.OldMediaPublicID(ctx)
+	case message.FieldIsRead:
+		return m.OldIsRead(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case message.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case message.FieldReadAt:
+		return m.OldReadAt(ctx)
+	case message.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	case message.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case message.FieldIsDeleted:
+		return m.OldIsDeleted(ctx)
+	case message.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldConnectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectionID(v)
+		return nil
+	case message.FieldSenderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderID(v)
+		return nil
+	case message.FieldReceiverID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiverID(v)
+		return nil
+	case message.FieldClientMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientMessageID(v)
+		return nil
+	case message.FieldReplyToID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToID(v)
+		return nil
+	case message.FieldType:
+		v, ok := value.(message.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case message.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case message.FieldMediaURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaURL(v)
+		return nil
+	case message.FieldMediaType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaType(v)
+		return nil
+	case message.FieldMediaPublicID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaPublicID(v)
+		return nil
+	case message.FieldIsRead:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsRead(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case message.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case message.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
+		return nil
+	case message.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	case message.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case message.FieldIsDeleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDeleted(v)
+		return nil
+	case message.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldClientMessageID) {
+		fields = append(fields, message.FieldClientMessageID)
+	}
+	if m.FieldCleared(message.FieldReplyToID) {
+		fields = append(fields, message.FieldReplyToID)
+	}
+	if m.FieldCleared(message.FieldContent) {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.FieldCleared(message.FieldMediaURL) {
+		fields = append(fields, message.FieldMediaURL)
+	}
+	if m.FieldCleared(message.FieldMediaType) {
+		fields = append(fields, message.FieldMediaType)
+	}
+	if m.FieldCleared(message.FieldMediaPublicID) {
+		fields = append(fields, message.FieldMediaPublicID)
+	}
+	if m.FieldCleared(message.FieldReadAt) {
+		fields = append(fields, message.FieldReadAt)
+	}
+	if m.FieldCleared(message.FieldDeliveredAt) {
+		fields = append(fields, message.FieldDeliveredAt)
+	}
+	if m.FieldCleared(message.FieldEditedAt) {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.FieldCleared(message.FieldDeletedAt) {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldClientMessageID:
+		m.ClearClientMessageID()
+		return nil
+	case message.FieldReplyToID:
+		m.ClearReplyToID()
+		return nil
+	case message.FieldContent:
+		m.ClearContent()
+		return nil
+	case message.FieldMediaURL:
+		m.ClearMediaURL()
+		return nil
+	case message.FieldMediaType:
+		m.ClearMediaType()
+		return nil
+	case message.FieldMediaPublicID:
+		m.ClearMediaPublicID()
+		return nil
+	case message.FieldReadAt:
+		m.ClearReadAt()
+		return nil
+	case message.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	case message.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case message.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldConnectionID:
+		m.ResetConnectionID()
+		return nil
+	case message.FieldSenderID:
+		m.ResetSenderID()
+		return nil
+	case message.FieldReceiverID:
+		m.ResetReceiverID()
+		return nil
+	case message.FieldClientMessageID:
+		m.ResetClientMessageID()
+		return nil
+	case message.FieldReplyToID:
+		m.ResetReplyToID()
+		return nil
+	case message.FieldType:
+		m.ResetType()
+		return nil
+	case message.FieldContent:
+		m.ResetContent()
+		return nil
+	case message.FieldMediaURL:
+		m.ResetMediaURL()
+		return nil
+	case message.FieldMediaType:
+		m.ResetMediaType()
+		return nil
+	case message.FieldMediaPublicID:
+		m.ResetMediaPublicID()
+		return nil
+	case message.FieldIsRead:
+		m.ResetIsRead()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case message.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case message.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	case message.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	case message.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case message.FieldIsDeleted:
+		m.ResetIsDeleted()
+		return nil
+	case message.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.connection != nil {
+		edges = append(edges, message.EdgeConnection)
+	}
+	if m.sender != nil {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.receiver != nil {
+		edges = append(edges, message.EdgeReceiver)
+	}
+	if m.edits != nil {
+		edges = append(edges, message.EdgeEdits)
+	}
+	if m.reactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.reply_to != nil {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.replies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeConnection:
+		if id := m.connection; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReceiver:
+		if id := m.receiver; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeEdits:
+		ids := make([]ent.Value, 0, len(m.edits))
+		for id := range m.edits {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.reactions))
+		for id := range m.reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReplyTo:
+		if id := m.reply_to; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removededits != nil {
+		edges = append(edges, message.EdgeEdits)
+	}
+	if m.removedreactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.removedreplies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeEdits:
+		ids := make([]ent.Value, 0, len(m.removededits))
+		for id := range m.removededits {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.removedreactions))
+		for id := range m.removedreactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedconnection {
+		edges = append(edges, message.EdgeConnection)
+	}
+	if m.clearedsender {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.clearedreceiver {
+		edges = append(edges, message.EdgeReceiver)
+	}
+	if m.clearededits {
+		edges = append(edges, message.EdgeEdits)
+	}
+	if m.clearedreactions {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.clearedreply_to {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.clearedreplies {
+		edges = append(edges, message.EdgeReplies)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeConnection:
+		return m.clearedconnection
+	case message.EdgeSender:
+		return m.clearedsender
+	case message.EdgeReceiver:
+		return m.clearedreceiver
+	case message.EdgeEdits:
+		return m.clearededits
+	case message.EdgeReactions:
+		return m.clearedreactions
+	case message.EdgeReplyTo:
+		return m.clearedreply_to
+	case message.EdgeReplies:
+		return m.clearedreplies
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeConnection:
+		m.ClearConnection()
+		return nil
+	case message.EdgeSender:
+		m.ClearSender()
+		return nil
+	case message.EdgeReceiver:
+		m.ClearReceiver()
+		return nil
+	case message.EdgeReplyTo:
+		m.ClearReplyTo()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeConnection:
+		m.ResetConnection()
+		return nil
+	case message.EdgeSender:
+		m.ResetSender()
+		return nil
+	case message.EdgeReceiver:
+		m.ResetReceiver()
+		return nil
+	case message.EdgeEdits:
+		m.ResetEdits()
+		return nil
+	case message.EdgeReactions:
+		m.ResetReactions()
+		return nil
+	case message.EdgeReplyTo:
+		m.ResetReplyTo()
+		return nil
+	case message.EdgeReplies:
+		m.ResetReplies()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// MessageEditMutation represents an operation that mutates the MessageEdit nodes in the graph.
+type MessageEditMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	previous_content *string
+	edited_at        *time.Time
+	clearedFields    map[string]struct{}
+	message          *uuid.UUID
+	clearedmessage   bool
+	done             bool
+	oldValue         func(context.Context) (*MessageEdit, error)
+	predicates       []predicate.MessageEdit
+}
+
+var _ ent.Mutation = (*MessageEditMutation)(nil)
+
+// messageeditOption allows management of the mutation configuration using functional options.
+type messageeditOption func(*MessageEditMutation)
+
+// newMessageEditMutation creates new mutation for the MessageEdit entity.
+func newMessageEditMutation(c config, op Op, opts ...messageeditOption) *MessageEditMutation {
+	m := &MessageEditMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageEdit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageEditID sets the ID field of the mutation.
+func withMessageEditID(id uuid.UUID) messageeditOption {
+	return func(m *MessageEditMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageEdit
+		)
+		m.oldValue = func(ctx context.Context) (*MessageEdit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageEdit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageEdit sets the old MessageEdit of the mutation.
+func withMessageEdit(node *MessageEdit) messageeditOption {
+	return func(m *MessageEditMutation) {
+		m.oldValue = func(context.Context) (*MessageEdit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageEditMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageEditMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageEdit entities.
+func (m *MessageEditMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageEditMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageEditMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageEdit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageEditMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageEditMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageEdit entity.
+// If the MessageEdit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageEditMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageEditMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetPreviousContent sets the "previous_content" field.
+func (m *MessageEditMutation) SetPreviousContent(s string) {
+	m.previous_content = &s
+}
+
+// PreviousContent returns the value of the "previous_content" field in the mutation.
+func (m *MessageEditMutation) PreviousContent() (r string, exists bool) {
+	v := m.previous_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousContent returns the old "previous_content" field's value of the MessageEdit entity.
+// If the MessageEdit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageEditMutation) OldPreviousContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousContent: %w", err)
+	}
+	return oldValue.PreviousContent, nil
+}
+
+// ClearPreviousContent clears the value of the "previous_content" field.
+func (m *MessageEditMutation) ClearPreviousContent() {
+	m.previous_content = nil
+	m.clearedFields[messageedit.FieldPreviousContent] = struct{}{}
+}
+
+// PreviousContentCleared returns if the "previous_content" field was cleared in this mutation.
+func (m *MessageEditMutation) PreviousContentCleared() bool {
+	_, ok := m.clearedFields[messageedit.FieldPreviousContent]
+	return ok
+}
+
+// ResetPreviousContent resets all changes to the "previous_content" field.
+func (m *MessageEditMutation) ResetPreviousContent() {
+	m.previous_content = nil
+	delete(m.clearedFields, messageedit.FieldPreviousContent)
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *MessageEditMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *MessageEditMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the MessageEdit entity.
+// If the MessageEdit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageEditMutation) OldEditedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *MessageEditMutation) ResetEditedAt() {
+	m.edited_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageEditMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messageedit.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageEditMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageEditMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageEditMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageEditMutation builder.
+func (m *MessageEditMutation) Where(ps ...predicate.MessageEdit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageEditMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageEditMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageEdit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageEditMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageEditMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageEdit).
+func (m *MessageEditMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageEditMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.message != nil {
+		fields = append(fields, messageedit.FieldMessageID)
+	}
+	if m.previous_content != nil {
+		fields = append(fields, messageedit.FieldPreviousContent)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, messageedit.FieldEditedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageEditMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messageedit.FieldMessageID:
+		return m.MessageID()
+	case messageedit.FieldPreviousContent:
+		return m.PreviousContent()
+	case messageedit.FieldEditedAt:
+		return m.EditedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageEditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messageedit.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messageedit.FieldPreviousContent:
+		return m.OldPreviousContent(ctx)
+	case messageedit.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageEdit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageEditMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messageedit.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messageedit.FieldPreviousContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousContent(v)
+		return nil
+	case messageedit.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageEdit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageEditMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageEditMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageEditMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageEdit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageEditMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messageedit.FieldPreviousContent) {
+		fields = append(fields, messageedit.FieldPreviousContent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageEditMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageEditMutation) ClearField(name string) error {
+	switch name {
+	case messageedit.FieldPreviousContent:
+		m.ClearPreviousContent()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageEdit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageEditMutation) ResetField(name string) error {
+	switch name {
+	case messageedit.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messageedit.FieldPreviousContent:
+		m.ResetPreviousContent()
+		return nil
+	case messageedit.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageEdit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageEditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, messageedit.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageEditMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messageedit.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageEditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageEditMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageEditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, messageedit.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageEditMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messageedit.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageEditMutation) ClearEdge(name string) error {
+	switch name {
+	case messageedit.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageEdit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageEditMutation) ResetEdge(name string) error {
+	switch name {
+	case messageedit.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageEdit edge %s", name)
+}
+
+// MessageReactionMutation represents an operation that mutates the MessageReaction nodes in the graph.
+type MessageReactionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	user_id        *uuid.UUID
+	emoji          *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*MessageReaction, error)
+	predicates     []predicate.MessageReaction
+}
+
+var _ ent.Mutation = (*MessageReactionMutation)(nil)
+
+// messagereactionOption allows management of the mutation configuration using functional options.
+type messagereactionOption func(*MessageReactionMutation)
+
+// newMessageReactionMutation creates new mutation for the MessageReaction entity.
+func newMessageReactionMutation(c config, op Op, opts ...messagereactionOption) *MessageReactionMutation {
+	m := &MessageReactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageReaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageReactionID sets the ID field of the mutation.
+func withMessageReactionID(id uuid.UUID) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageReaction
+		)
+		m.oldValue = func(ctx context.Context) (*MessageReaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageReaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageReaction sets the old MessageReaction of the mutation.
+func withMessageReaction(node *MessageReaction) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		m.oldValue = func(context.Context) (*MessageReaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageReactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageReactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageReaction entities.
+func (m *MessageReactionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageReactionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageReactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageReaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageReactionMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageReactionMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageReactionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MessageReactionMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MessageReactionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MessageReactionMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// UserAIDs returns the "user_a" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserAID instead. It exists only for internal usage by the builders.
-func (m *ConnectionMutation) UserAIDs() (ids []uuid.UUID) {
-	if id := m.user_a; id != nil {
-		ids = append(ids, *id)
+// SetEmoji sets the "emoji" field.
+func (m *MessageReactionMutation) SetEmoji(s string) {
+	m.emoji = &s
+}
+
+// Emoji returns the value of the "emoji" field in the mutation.
+func (m *MessageReactionMutation) Emoji() (r string, exists bool) {
+	v := m.emoji
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUserA resets all changes to the "user_a" edge.
-func (m *ConnectionMutation) ResetUserA() {
-	m.user_a = nil
-	m.cleareduser_a = false
+// OldEmoji returns the old "emoji" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldEmoji(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmoji requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+	}
+	return oldValue.Emoji, nil
 }
 
-// ClearUserB clears the "user_b" edge to the User entity.
-func (m *ConnectionMutation) ClearUserB() {
-	m.cleareduser_b = true
-	m.clearedFields[connection.FieldUserBID] = struct{}{}
+// ResetEmoji resets all changes to the "emoji" field.
+func (m *MessageReactionMutation) ResetEmoji() {
+	m.emoji = nil
 }
 
-// UserBCleared reports if the "user_b" edge to the User entity was cleared.
-func (m *ConnectionMutation) UserBCleared() bool {
-	return m.cleareduser_b
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageReactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserBIDs returns the "user_b" edge IDs in the mutation.
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageReactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageReactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageReactionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagereaction.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageReactionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserBID instead. It exists only for internal usage by the builders.
-func (m *ConnectionMutation) UserBIDs() (ids []uuid.UUID) {
-	if id := m.user_b; id != nil {
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageReactionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUserB resets all changes to the "user_b" edge.
-func (m *ConnectionMutation) ResetUserB() {
-	m.user_b = nil
-	m.cleareduser_b = false
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageReactionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
 }
 
-// Where appends a list predicates to the ConnectionMutation builder.
-func (m *ConnectionMutation) Where(ps ...predicate.Connection) {
+// Where appends a list predicates to the MessageReactionMutation builder.
+func (m *MessageReactionMutation) Where(ps ...predicate.MessageReaction) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ConnectionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MessageReactionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConnectionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Connection, len(ps))
+func (m *MessageReactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageReaction, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -462,42 +6819,36 @@ func (m *ConnectionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ConnectionMutation) Op() Op {
+func (m *MessageReactionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ConnectionMutation) SetOp(op Op) {
+func (m *MessageReactionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Connection).
-func (m *ConnectionMutation) Type() string {
+// Type returns the node type of this mutation (MessageReaction).
+func (m *MessageReactionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConnectionMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.status != nil {
-		fields = append(fields, connection.FieldStatus)
-	}
-	if m.user_a != nil {
-		fields = append(fields, connection.FieldUserAID)
-	}
-	if m.user_b != nil {
-		fields = append(fields, connection.FieldUserBID)
+func (m *MessageReactionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.message != nil {
+		fields = append(fields, messagereaction.FieldMessageID)
 	}
-	if m.connected_at != nil {
-		fields = append(fields, connection.FieldConnectedAt)
+	if m.user_id != nil {
+		fields = append(fields, messagereaction.FieldUserID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, connection.FieldUpdatedAt)
+	if m.emoji != nil {
+		fields = append(fields, messagereaction.FieldEmoji)
 	}
-	if m.dropped_at != nil {
-		fields = append(fields, connection.FieldDroppedAt)
+	if m.created_at != nil {
+		fields = append(fields, messagereaction.FieldCreatedAt)
 	}
 	return fields
 }
@@ -505,20 +6856,16 @@ func (m *ConnectionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConnectionMutation) Field(name string) (ent.Value, bool) {
+func (m *MessageReactionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case connection.FieldStatus:
-		return m.Status()
-	case connection.FieldUserAID:
-		return m.UserAID()
-	case connection.FieldUserBID:
-		return m.UserBID()
-	case connection.FieldConnectedAt:
-		return m.ConnectedAt()
-	case connection.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case connection.FieldDroppedAt:
-		return m.DroppedAt()
+	case messagereaction.FieldMessageID:
+		return m.MessageID()
+	case messagereaction.FieldUserID:
+		return m.UserID()
+	case messagereaction.FieldEmoji:
+		return m.Emoji()
+	case messagereaction.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -526,173 +6873,133 @@ func (m *ConnectionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConnectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case connection.FieldStatus:
-		return m.OldStatus(ctx)
-	case connection.FieldUserAID:
-		return m.OldUserAID(ctx)
-	case connection.FieldUserBID:
-		return m.OldUserBID(ctx)
-	case connection.FieldConnectedAt:
-		return m.OldConnectedAt(ctx)
-	case connection.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case connection.FieldDroppedAt:
-		return m.OldDroppedAt(ctx)
+	case messagereaction.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagereaction.FieldUserID:
+		return m.OldUserID(ctx)
+	case messagereaction.FieldEmoji:
+		return m.OldEmoji(ctx)
+	case messagereaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Connection field %s", name)
+	return nil, fmt.Errorf("unknown MessageReaction field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConnectionMutation) SetField(name string, value ent.Value) error {
+func (m *MessageReactionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case connection.FieldStatus:
-		v, ok := value.(connection.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case connection.FieldUserAID:
+	case messagereaction.FieldMessageID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserAID(v)
+		m.SetMessageID(v)
 		return nil
-	case connection.FieldUserBID:
+	case messagereaction.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserBID(v)
-		return nil
-	case connection.FieldConnectedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConnectedAt(v)
+		m.SetUserID(v)
 		return nil
-	case connection.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case messagereaction.FieldEmoji:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetEmoji(v)
 		return nil
-	case connection.FieldDroppedAt:
+	case messagereaction.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDroppedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Connection field %s", name)
+	return fmt.Errorf("unknown MessageReaction field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConnectionMutation) AddedFields() []string {
+func (m *MessageReactionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConnectionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MessageReactionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConnectionMutation) AddField(name string, value ent.Value) error {
+func (m *MessageReactionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Connection numeric field %s", name)
+	return fmt.Errorf("unknown MessageReaction numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConnectionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(connection.FieldDroppedAt) {
-		fields = append(fields, connection.FieldDroppedAt)
-	}
-	return fields
+func (m *MessageReactionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConnectionMutation) FieldCleared(name string) bool {
+func (m *MessageReactionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConnectionMutation) ClearField(name string) error {
-	switch name {
-	case connection.FieldDroppedAt:
-		m.ClearDroppedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Connection nullable field %s", name)
+func (m *MessageReactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageReaction nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConnectionMutation) ResetField(name string) error {
+func (m *MessageReactionMutation) ResetField(name string) error {
 	switch name {
-	case connection.FieldStatus:
-		m.ResetStatus()
+	case messagereaction.FieldMessageID:
+		m.ResetMessageID()
 		return nil
-	case connection.FieldUserAID:
-		m.ResetUserAID()
-		return nil
-	case connection.FieldUserBID:
-		m.ResetUserBID()
-		return nil
-	case connection.FieldConnectedAt:
-		m.ResetConnectedAt()
+	case messagereaction.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case connection.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case messagereaction.FieldEmoji:
+		m.ResetEmoji()
 		return nil
-	case connection.FieldDroppedAt:
-		m.ResetDroppedAt()
+	case messagereaction.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Connection field %s", name)
+	return fmt.Errorf("unknown MessageReaction field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConnectionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user_a != nil {
-		edges = append(edges, connection.EdgeUserA)
-	}
-	if m.user_b != nil {
-		edges = append(edges, connection.EdgeUserB)
+func (m *MessageReactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, messagereaction.EdgeMessage)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConnectionMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageReactionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case connection.EdgeUserA:
-		if id := m.user_a; id != nil {
-			return []ent.Value{*id}
-		}
-	case connection.EdgeUserB:
-		if id := m.user_b; id != nil {
+	case messagereaction.EdgeMessage:
+		if id := m.message; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -700,102 +7007,84 @@ func (m *ConnectionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConnectionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *MessageReactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConnectionMutation) RemovedIDs(name string) []ent.Value {
+func (m *MessageReactionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConnectionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser_a {
-		edges = append(edges, connection.EdgeUserA)
-	}
-	if m.cleareduser_b {
-		edges = append(edges, connection.EdgeUserB)
+func (m *MessageReactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, messagereaction.EdgeMessage)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConnectionMutation) EdgeCleared(name string) bool {
+func (m *MessageReactionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case connection.EdgeUserA:
-		return m.cleareduser_a
-	case connection.EdgeUserB:
-		return m.cleareduser_b
+	case messagereaction.EdgeMessage:
+		return m.clearedmessage
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConnectionMutation) ClearEdge(name string) error {
+func (m *MessageReactionMutation) ClearEdge(name string) error {
 	switch name {
-	case connection.EdgeUserA:
-		m.ClearUserA()
-		return nil
-	case connection.EdgeUserB:
-		m.ClearUserB()
+	case messagereaction.EdgeMessage:
+		m.ClearMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Connection unique edge %s", name)
+	return fmt.Errorf("unknown MessageReaction unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ConnectionMutation) ResetEdge(name string) error {
-	switch name {
-	case connection.EdgeUserA:
-		m.ResetUserA()
-		return nil
-	case connection.EdgeUserB:
-		m.ResetUserB()
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageReactionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagereaction.EdgeMessage:
+		m.ResetMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Connection edge %s", name)
+	return fmt.Errorf("unknown MessageReaction edge %s", name)
 }
 
-// ConnectionRequestMutation represents an operation that mutates the ConnectionRequest nodes in the graph.
-type ConnectionRequestMutation struct {
+// PresenceLeaseMutation represents an operation that mutates the PresenceLease nodes in the graph.
+type PresenceLeaseMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	status          *connectionrequest.Status
-	message         *string
-	created_at      *time.Time
-	updated_at      *time.Time
-	responded_at    *time.Time
-	expires_at      *time.Time
-	clearedFields   map[string]struct{}
-	sender          *uuid.UUID
-	clearedsender   bool
-	receiver        *uuid.UUID
-	clearedreceiver bool
-	done            bool
-	oldValue        func(context.Context) (*ConnectionRequest, error)
-	predicates      []predicate.ConnectionRequest
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	node_id       *string
+	user_id       *uuid.UUID
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PresenceLease, error)
+	predicates    []predicate.PresenceLease
 }
 
-var _ ent.Mutation = (*ConnectionRequestMutation)(nil)
+var _ ent.Mutation = (*PresenceLeaseMutation)(nil)
 
-// connectionrequestOption allows management of the mutation configuration using functional options.
-type connectionrequestOption func(*ConnectionRequestMutation)
+// presenceleaseOption allows management of the mutation configuration using functional options.
+type presenceleaseOption func(*PresenceLeaseMutation)
 
-// newConnectionRequestMutation creates new mutation for the ConnectionRequest entity.
-func newConnectionRequestMutation(c config, op Op, opts ...connectionrequestOption) *ConnectionRequestMutation {
-	m := &ConnectionRequestMutation{
+// newPresenceLeaseMutation creates new mutation for the PresenceLease entity.
+func newPresenceLeaseMutation(c config, op Op, opts ...presenceleaseOption) *PresenceLeaseMutation {
+	m := &PresenceLeaseMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConnectionRequest,
+		typ:           TypePresenceLease,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -804,20 +7093,20 @@ func newConnectionRequestMutation(c config, op Op, opts ...connectionrequestOpti
 	return m
 }
 
-// withConnectionRequestID sets the ID field of the mutation.
-func withConnectionRequestID(id uuid.UUID) connectionrequestOption {
-	return func(m *ConnectionRequestMutation) {
+// withPresenceLeaseID sets the ID field of the mutation.
+func withPresenceLeaseID(id uuid.UUID) presenceleaseOption {
+	return func(m *PresenceLeaseMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ConnectionRequest
+			value *PresenceLease
 		)
-		m.oldValue = func(ctx context.Context) (*ConnectionRequest, error) {
+		m.oldValue = func(ctx context.Context) (*PresenceLease, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ConnectionRequest.Get(ctx, id)
+					value, err = m.Client().PresenceLease.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -826,10 +7115,10 @@ func withConnectionRequestID(id uuid.UUID) connectionrequestOption {
 	}
 }
 
-// withConnectionRequest sets the old ConnectionRequest of the mutation.
-func withConnectionRequest(node *ConnectionRequest) connectionrequestOption {
-	return func(m *ConnectionRequestMutation) {
-		m.oldValue = func(context.Context) (*ConnectionRequest, error) {
+// withPresenceLease sets the old PresenceLease of the mutation.
+func withPresenceLease(node *PresenceLease) presenceleaseOption {
+	return func(m *PresenceLeaseMutation) {
+		m.oldValue = func(context.Context) (*PresenceLease, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -838,7 +7127,7 @@ func withConnectionRequest(node *ConnectionRequest) connectionrequestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConnectionRequestMutation) Client() *Client {
+func (m PresenceLeaseMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -846,7 +7135,7 @@ func (m ConnectionRequestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConnectionRequestMutation) Tx() (*Tx, error) {
+func (m PresenceLeaseMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -856,14 +7145,14 @@ func (m ConnectionRequestMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ConnectionRequest entities.
-func (m *ConnectionRequestMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of PresenceLease entities.
+func (m *PresenceLeaseMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConnectionRequestMutation) ID() (id uuid.UUID, exists bool) {
+func (m *PresenceLeaseMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -874,7 +7163,7 @@ func (m *ConnectionRequestMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConnectionRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *PresenceLeaseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -883,402 +7172,671 @@ func (m *ConnectionRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ConnectionRequest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PresenceLease.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSenderID sets the "sender_id" field.
-func (m *ConnectionRequestMutation) SetSenderID(u uuid.UUID) {
-	m.sender = &u
+// SetNodeID sets the "node_id" field.
+func (m *PresenceLeaseMutation) SetNodeID(s string) {
+	m.node_id = &s
 }
 
-// SenderID returns the value of the "sender_id" field in the mutation.
-func (m *ConnectionRequestMutation) SenderID() (r uuid.UUID, exists bool) {
-	v := m.sender
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *PresenceLeaseMutation) NodeID() (r string, exists bool) {
+	v := m.node_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSenderID returns the old "sender_id" field's value of the ConnectionRequest entity.
-// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldNodeID returns the old "node_id" field's value of the PresenceLease entity.
+// If the PresenceLease object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionRequestMutation) OldSenderID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *PresenceLeaseMutation) OldNodeID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderID requires an ID field in the mutation")
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
 	}
-	return oldValue.SenderID, nil
+	return oldValue.NodeID, nil
 }
 
-// ResetSenderID resets all changes to the "sender_id" field.
-func (m *ConnectionRequestMutation) ResetSenderID() {
-	m.sender = nil
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *PresenceLeaseMutation) ResetNodeID() {
+	m.node_id = nil
 }
 
-// SetReceiverID sets the "receiver_id" field.
-func (m *ConnectionRequestMutation) SetReceiverID(u uuid.UUID) {
-	m.receiver = &u
+// SetUserID sets the "user_id" field.
+func (m *PresenceLeaseMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// ReceiverID returns the value of the "receiver_id" field in the mutation.
-func (m *ConnectionRequestMutation) ReceiverID() (r uuid.UUID, exists bool) {
-	v := m.receiver
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PresenceLeaseMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PresenceLease entity.
+// If the PresenceLease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PresenceLeaseMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PresenceLeaseMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PresenceLeaseMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PresenceLeaseMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReceiverID returns the old "receiver_id" field's value of the ConnectionRequest entity.
-// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionRequestMutation) OldReceiverID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReceiverID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReceiverID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReceiverID: %w", err)
-	}
-	return oldValue.ReceiverID, nil
+// OldExpiresAt returns the old "expires_at" field's value of the PresenceLease entity.
+// If the PresenceLease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PresenceLeaseMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PresenceLeaseMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the PresenceLeaseMutation builder.
+func (m *PresenceLeaseMutation) Where(ps ...predicate.PresenceLease) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PresenceLeaseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PresenceLeaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PresenceLease, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PresenceLeaseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PresenceLeaseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PresenceLease).
+func (m *PresenceLeaseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PresenceLeaseMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.node_id != nil {
+		fields = append(fields, presencelease.FieldNodeID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, presencelease.FieldUserID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, presencelease.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PresenceLeaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case presencelease.FieldNodeID:
+		return m.NodeID()
+	case presencelease.FieldUserID:
+		return m.UserID()
+	case presencelease.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PresenceLeaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case presencelease.FieldNodeID:
+		return m.OldNodeID(ctx)
+	case presencelease.FieldUserID:
+		return m.OldUserID(ctx)
+	case presencelease.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PresenceLease field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PresenceLeaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case presencelease.FieldNodeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	case presencelease.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case presencelease.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PresenceLease field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PresenceLeaseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PresenceLeaseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PresenceLeaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PresenceLease numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PresenceLeaseMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PresenceLeaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PresenceLeaseMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PresenceLease nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PresenceLeaseMutation) ResetField(name string) error {
+	switch name {
+	case presencelease.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	case presencelease.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case presencelease.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PresenceLease field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PresenceLeaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PresenceLeaseMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PresenceLeaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PresenceLeaseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PresenceLeaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetReceiverID resets all changes to the "receiver_id" field.
-func (m *ConnectionRequestMutation) ResetReceiverID() {
-	m.receiver = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PresenceLeaseMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetStatus sets the "status" field.
-func (m *ConnectionRequestMutation) SetStatus(c connectionrequest.Status) {
-	m.status = &c
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PresenceLeaseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PresenceLease unique edge %s", name)
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ConnectionRequestMutation) Status() (r connectionrequest.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PresenceLeaseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PresenceLease edge %s", name)
 }
 
-// OldStatus returns the old "status" field's value of the ConnectionRequest entity.
-// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionRequestMutation) OldStatus(ctx context.Context) (v connectionrequest.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+// ReadMarkerMutation represents an operation that mutates the ReadMarker nodes in the graph.
+type ReadMarkerMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	user_id              *uuid.UUID
+	last_read_message_id *uuid.UUID
+	last_read_message_at *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	connection           *uuid.UUID
+	clearedconnection    bool
+	done                 bool
+	oldValue             func(context.Context) (*ReadMarker, error)
+	predicates           []predicate.ReadMarker
+}
+
+var _ ent.Mutation = (*ReadMarkerMutation)(nil)
+
+// readmarkerOption allows management of the mutation configuration using functional options.
+type readmarkerOption func(*ReadMarkerMutation)
+
+// newReadMarkerMutation creates new mutation for the ReadMarker entity.
+func newReadMarkerMutation(c config, op Op, opts ...readmarkerOption) *ReadMarkerMutation {
+	m := &ReadMarkerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReadMarker,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Status, nil
+	return m
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ConnectionRequestMutation) ResetStatus() {
-	m.status = nil
+// withReadMarkerID sets the ID field of the mutation.
+func withReadMarkerID(id uuid.UUID) readmarkerOption {
+	return func(m *ReadMarkerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReadMarker
+		)
+		m.oldValue = func(ctx context.Context) (*ReadMarker, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReadMarker.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetMessage sets the "message" field.
-func (m *ConnectionRequestMutation) SetMessage(s string) {
-	m.message = &s
+// withReadMarker sets the old ReadMarker of the mutation.
+func withReadMarker(node *ReadMarker) readmarkerOption {
+	return func(m *ReadMarkerMutation) {
+		m.oldValue = func(context.Context) (*ReadMarker, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *ConnectionRequestMutation) Message() (r string, exists bool) {
-	v := m.message
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReadMarkerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldMessage returns the old "message" field's value of the ConnectionRequest entity.
-// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionRequestMutation) OldMessage(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReadMarkerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Message, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearMessage clears the value of the "message" field.
-func (m *ConnectionRequestMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[connectionrequest.FieldMessage] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReadMarker entities.
+func (m *ReadMarkerMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *ConnectionRequestMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[connectionrequest.FieldMessage]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReadMarkerMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *ConnectionRequestMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, connectionrequest.FieldMessage)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReadMarkerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReadMarker.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ConnectionRequestMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetConnectionID sets the "connection_id" field.
+func (m *ReadMarkerMutation) SetConnectionID(u uuid.UUID) {
+	m.connection = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ConnectionRequestMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ConnectionID returns the value of the "connection_id" field in the mutation.
+func (m *ReadMarkerMutation) ConnectionID() (r uuid.UUID, exists bool) {
+	v := m.connection
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ConnectionRequest entity.
-// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldConnectionID returns the old "connection_id" field's value of the ReadMarker entity.
+// If the ReadMarker object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReadMarkerMutation) OldConnectionID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnectionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldConnectionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldConnectionID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ConnectionID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ConnectionRequestMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetConnectionID resets all changes to the "connection_id" field.
+func (m *ReadMarkerMutation) ResetConnectionID() {
+	m.connection = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ConnectionRequestMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetUserID sets the "user_id" field.
+func (m *ReadMarkerMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConnectionRequestMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReadMarkerMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ConnectionRequest entity.
-// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ReadMarker entity.
+// If the ReadMarker object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReadMarkerMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.UserID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConnectionRequestMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReadMarkerMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetRespondedAt sets the "responded_at" field.
-func (m *ConnectionRequestMutation) SetRespondedAt(t time.Time) {
-	m.responded_at = &t
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (m *ReadMarkerMutation) SetLastReadMessageID(u uuid.UUID) {
+	m.last_read_message_id = &u
 }
 
-// RespondedAt returns the value of the "responded_at" field in the mutation.
-func (m *ConnectionRequestMutation) RespondedAt() (r time.Time, exists bool) {
-	v := m.responded_at
+// LastReadMessageID returns the value of the "last_read_message_id" field in the mutation.
+func (m *ReadMarkerMutation) LastReadMessageID() (r uuid.UUID, exists bool) {
+	v := m.last_read_message_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRespondedAt returns the old "responded_at" field's value of the ConnectionRequest entity.
-// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldLastReadMessageID returns the old "last_read_message_id" field's value of the ReadMarker entity.
+// If the ReadMarker object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionRequestMutation) OldRespondedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReadMarkerMutation) OldLastReadMessageID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRespondedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastReadMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRespondedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastReadMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRespondedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastReadMessageID: %w", err)
 	}
-	return oldValue.RespondedAt, nil
-}
-
-// ClearRespondedAt clears the value of the "responded_at" field.
-func (m *ConnectionRequestMutation) ClearRespondedAt() {
-	m.responded_at = nil
-	m.clearedFields[connectionrequest.FieldRespondedAt] = struct{}{}
-}
-
-// RespondedAtCleared returns if the "responded_at" field was cleared in this mutation.
-func (m *ConnectionRequestMutation) RespondedAtCleared() bool {
-	_, ok := m.clearedFields[connectionrequest.FieldRespondedAt]
-	return ok
+	return oldValue.LastReadMessageID, nil
 }
 
-// ResetRespondedAt resets all changes to the "responded_at" field.
-func (m *ConnectionRequestMutation) ResetRespondedAt() {
-	m.responded_at = nil
-	delete(m.clearedFields, connectionrequest.FieldRespondedAt)
+// ResetLastReadMessageID resets all changes to the "last_read_message_id" field.
+func (m *ReadMarkerMutation) ResetLastReadMessageID() {
+	m.last_read_message_id = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *ConnectionRequestMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetLastReadMessageAt sets the "last_read_message_at" field.
+func (m *ReadMarkerMutation) SetLastReadMessageAt(t time.Time) {
+	m.last_read_message_at = &t
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *ConnectionRequestMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// LastReadMessageAt returns the value of the "last_read_message_at" field in the mutation.
+func (m *ReadMarkerMutation) LastReadMessageAt() (r time.Time, exists bool) {
+	v := m.last_read_message_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the ConnectionRequest entity.
-// If the ConnectionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldLastReadMessageAt returns the old "last_read_message_at" field's value of the ReadMarker entity.
+// If the ReadMarker object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionRequestMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReadMarkerMutation) OldLastReadMessageAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastReadMessageAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldLastReadMessageAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
-	}
-	return oldValue.ExpiresAt, nil
-}
-
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (m *ConnectionRequestMutation) ClearExpiresAt() {
-	m.expires_at = nil
-	m.clearedFields[connectionrequest.FieldExpiresAt] = struct{}{}
-}
-
-// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
-func (m *ConnectionRequestMutation) ExpiresAtCleared() bool {
-	_, ok := m.clearedFields[connectionrequest.FieldExpiresAt]
-	return ok
+		return v, fmt.Errorf("querying old value for OldLastReadMessageAt: %w", err)
+	}
+	return oldValue.LastReadMessageAt, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *ConnectionRequestMutation) ResetExpiresAt() {
-	m.expires_at = nil
-	delete(m.clearedFields, connectionrequest.FieldExpiresAt)
+// ResetLastReadMessageAt resets all changes to the "last_read_message_at" field.
+func (m *ReadMarkerMutation) ResetLastReadMessageAt() {
+	m.last_read_message_at = nil
 }
 
-// ClearSender clears the "sender" edge to the User entity.
-func (m *ConnectionRequestMutation) ClearSender() {
-	m.clearedsender = true
-	m.clearedFields[connectionrequest.FieldSenderID] = struct{}{}
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReadMarkerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// SenderCleared reports if the "sender" edge to the User entity was cleared.
-func (m *ConnectionRequestMutation) SenderCleared() bool {
-	return m.clearedsender
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReadMarkerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SenderIDs returns the "sender" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SenderID instead. It exists only for internal usage by the builders.
-func (m *ConnectionRequestMutation) SenderIDs() (ids []uuid.UUID) {
-	if id := m.sender; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the ReadMarker entity.
+// If the ReadMarker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadMarkerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetSender resets all changes to the "sender" edge.
-func (m *ConnectionRequestMutation) ResetSender() {
-	m.sender = nil
-	m.clearedsender = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReadMarkerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearReceiver clears the "receiver" edge to the User entity.
-func (m *ConnectionRequestMutation) ClearReceiver() {
-	m.clearedreceiver = true
-	m.clearedFields[connectionrequest.FieldReceiverID] = struct{}{}
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (m *ReadMarkerMutation) ClearConnection() {
+	m.clearedconnection = true
+	m.clearedFields[readmarker.FieldConnectionID] = struct{}{}
 }
 
-// ReceiverCleared reports if the "receiver" edge to the User entity was cleared.
-func (m *ConnectionRequestMutation) ReceiverCleared() bool {
-	return m.clearedreceiver
+// ConnectionCleared reports if the "connection" edge to the Connection entity was cleared.
+func (m *ReadMarkerMutation) ConnectionCleared() bool {
+	return m.clearedconnection
 }
 
-// ReceiverIDs returns the "receiver" edge IDs in the mutation.
+// ConnectionIDs returns the "connection" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ReceiverID instead. It exists only for internal usage by the builders.
-func (m *ConnectionRequestMutation) ReceiverIDs() (ids []uuid.UUID) {
-	if id := m.receiver; id != nil {
+// ConnectionID instead. It exists only for internal usage by the builders.
+func (m *ReadMarkerMutation) ConnectionIDs() (ids []uuid.UUID) {
+	if id := m.connection; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetReceiver resets all changes to the "receiver" edge.
-func (m *ConnectionRequestMutation) ResetReceiver() {
-	m.receiver = nil
-	m.clearedreceiver = false
+// ResetConnection resets all changes to the "connection" edge.
+func (m *ReadMarkerMutation) ResetConnection() {
+	m.connection = nil
+	m.clearedconnection = false
 }
 
-// Where appends a list predicates to the ConnectionRequestMutation builder.
-func (m *ConnectionRequestMutation) Where(ps ...predicate.ConnectionRequest) {
+// Where appends a list predicates to the ReadMarkerMutation builder.
+func (m *ReadMarkerMutation) Where(ps ...predicate.ReadMarker) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ConnectionRequestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReadMarkerMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConnectionRequestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ConnectionRequest, len(ps))
+func (m *ReadMarkerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReadMarker, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1286,48 +7844,39 @@ func (m *ConnectionRequestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ConnectionRequestMutation) Op() Op {
+func (m *ReadMarkerMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ConnectionRequestMutation) SetOp(op Op) {
+func (m *ReadMarkerMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ConnectionRequest).
-func (m *ConnectionRequestMutation) Type() string {
+// Type returns the node type of this mutation (ReadMarker).
+func (m *ReadMarkerMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConnectionRequestMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.sender != nil {
-		fields = append(fields, connectionrequest.FieldSenderID)
-	}
-	if m.receiver != nil {
-		fields = append(fields, connectionrequest.FieldReceiverID)
+func (m *ReadMarkerMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.connection != nil {
+		fields = append(fields, readmarker.FieldConnectionID)
 	}
-	if m.status != nil {
-		fields = append(fields, connectionrequest.FieldStatus)
+	if m.user_id != nil {
+		fields = append(fields, readmarker.FieldUserID)
 	}
-	if m.message != nil {
-		fields = append(fields, connectionrequest.FieldMessage)
+	if m.last_read_message_id != nil {
+		fields = append(fields, readmarker.FieldLastReadMessageID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, connectionrequest.FieldCreatedAt)
+	if m.last_read_message_at != nil {
+		fields = append(fields, readmarker.FieldLastReadMessageAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, connectionrequest.FieldUpdatedAt)
-	}
-	if m.responded_at != nil {
-		fields = append(fields, connectionrequest.FieldRespondedAt)
-	}
-	if m.expires_at != nil {
-		fields = append(fields, connectionrequest.FieldExpiresAt)
+		fields = append(fields, readmarker.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1335,24 +7884,18 @@ func (m *ConnectionRequestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConnectionRequestMutation) Field(name string) (ent.Value, bool) {
+func (m *ReadMarkerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case connectionrequest.FieldSenderID:
-		return m.SenderID()
-	case connectionrequest.FieldReceiverID:
-		return m.ReceiverID()
-	case connectionrequest.FieldStatus:
-		return m.Status()
-	case connectionrequest.FieldMessage:
-		return m.Message()
-	case connectionrequest.FieldCreatedAt:
-		return m.CreatedAt()
-	case connectionrequest.FieldUpdatedAt:
+	case readmarker.FieldConnectionID:
+		return m.ConnectionID()
+	case readmarker.FieldUserID:
+		return m.UserID()
+	case readmarker.FieldLastReadMessageID:
+		return m.LastReadMessageID()
+	case readmarker.FieldLastReadMessageAt:
+		return m.LastReadMessageAt()
+	case readmarker.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case connectionrequest.FieldRespondedAt:
-		return m.RespondedAt()
-	case connectionrequest.FieldExpiresAt:
-		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -1360,209 +7903,145 @@ func (m *ConnectionRequestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConnectionRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReadMarkerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case connectionrequest.FieldSenderID:
-		return m.OldSenderID(ctx)
-	case connectionrequest.FieldReceiverID:
-		return m.OldReceiverID(ctx)
-	case connectionrequest.FieldStatus:
-		return m.OldStatus(ctx)
-	case connectionrequest.FieldMessage:
-		return m.OldMessage(ctx)
-	case connectionrequest.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case connectionrequest.FieldUpdatedAt:
+	case readmarker.FieldConnectionID:
+		return m.OldConnectionID(ctx)
+	case readmarker.FieldUserID:
+		return m.OldUserID(ctx)
+	case readmarker.FieldLastReadMessageID:
+		return m.OldLastReadMessageID(ctx)
+	case readmarker.FieldLastReadMessageAt:
+		return m.OldLastReadMessageAt(ctx)
+	case readmarker.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case connectionrequest.FieldRespondedAt:
-		return m.OldRespondedAt(ctx)
-	case connectionrequest.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ConnectionRequest field %s", name)
+	return nil, fmt.Errorf("unknown ReadMarker field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConnectionRequestMutation) SetField(name string, value ent.Value) error {
+func (m *ReadMarkerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case connectionrequest.FieldSenderID:
+	case readmarker.FieldConnectionID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSenderID(v)
+		m.SetConnectionID(v)
 		return nil
-	case connectionrequest.FieldReceiverID:
+	case readmarker.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReceiverID(v)
-		return nil
-	case connectionrequest.FieldStatus:
-		v, ok := value.(connectionrequest.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetUserID(v)
 		return nil
-	case connectionrequest.FieldMessage:
-		v, ok := value.(string)
+	case readmarker.FieldLastReadMessageID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessage(v)
+		m.SetLastReadMessageID(v)
 		return nil
-	case connectionrequest.FieldCreatedAt:
+	case readmarker.FieldLastReadMessageAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetLastReadMessageAt(v)
 		return nil
-	case connectionrequest.FieldUpdatedAt:
+	case readmarker.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case connectionrequest.FieldRespondedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRespondedAt(v)
-		return nil
-	case connectionrequest.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ConnectionRequest field %s", name)
+	return fmt.Errorf("unknown ReadMarker field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConnectionRequestMutation) AddedFields() []string {
+func (m *ReadMarkerMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConnectionRequestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReadMarkerMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConnectionRequestMutation) AddField(name string, value ent.Value) error {
+func (m *ReadMarkerMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ConnectionRequest numeric field %s", name)
+	return fmt.Errorf("unknown ReadMarker numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConnectionRequestMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(connectionrequest.FieldMessage) {
-		fields = append(fields, connectionrequest.FieldMessage)
-	}
-	if m.FieldCleared(connectionrequest.FieldRespondedAt) {
-		fields = append(fields, connectionrequest.FieldRespondedAt)
-	}
-	if m.FieldCleared(connectionrequest.FieldExpiresAt) {
-		fields = append(fields, connectionrequest.FieldExpiresAt)
-	}
-	return fields
+func (m *ReadMarkerMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConnectionRequestMutation) FieldCleared(name string) bool {
+func (m *ReadMarkerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConnectionRequestMutation) ClearField(name string) error {
-	switch name {
-	case connectionrequest.FieldMessage:
-		m.ClearMessage()
-		return nil
-	case connectionrequest.FieldRespondedAt:
-		m.ClearRespondedAt()
-		return nil
-	case connectionrequest.FieldExpiresAt:
-		m.ClearExpiresAt()
-		return nil
-	}
-	return fmt.Errorf("unknown ConnectionRequest nullable field %s", name)
+func (m *ReadMarkerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReadMarker nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConnectionRequestMutation) ResetField(name string) error {
+func (m *ReadMarkerMutation) ResetField(name string) error {
 	switch name {
-	case connectionrequest.FieldSenderID:
-		m.ResetSenderID()
-		return nil
-	case connectionrequest.FieldReceiverID:
-		m.ResetReceiverID()
+	case readmarker.FieldConnectionID:
+		m.ResetConnectionID()
 		return nil
-	case connectionrequest.FieldStatus:
-		m.ResetStatus()
+	case readmarker.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case connectionrequest.FieldMessage:
-		m.ResetMessage()
+	case readmarker.FieldLastReadMessageID:
+		m.ResetLastReadMessageID()
 		return nil
-	case connectionrequest.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case readmarker.FieldLastReadMessageAt:
+		m.ResetLastReadMessageAt()
 		return nil
-	case connectionrequest.FieldUpdatedAt:
+	case readmarker.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case connectionrequest.FieldRespondedAt:
-		m.ResetRespondedAt()
-		return nil
-	case connectionrequest.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
 	}
-	return fmt.Errorf("unknown ConnectionRequest field %s", name)
+	return fmt.Errorf("unknown ReadMarker field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConnectionRequestMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.sender != nil {
-		edges = append(edges, connectionrequest.EdgeSender)
-	}
-	if m.receiver != nil {
-		edges = append(edges, connectionrequest.EdgeReceiver)
+func (m *ReadMarkerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.connection != nil {
+		edges = append(edges, readmarker.EdgeConnection)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConnectionRequestMutation) AddedIDs(name string) []ent.Value {
+func (m *ReadMarkerMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case connectionrequest.EdgeSender:
-		if id := m.sender; id != nil {
-			return []ent.Value{*id}
-		}
-	case connectionrequest.EdgeReceiver:
-		if id := m.receiver; id != nil {
+	case readmarker.EdgeConnection:
+		if id := m.connection; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1570,109 +8049,92 @@ func (m *ConnectionRequestMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConnectionRequestMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ReadMarkerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConnectionRequestMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReadMarkerMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConnectionRequestMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedsender {
-		edges = append(edges, connectionrequest.EdgeSender)
-	}
-	if m.clearedreceiver {
-		edges = append(edges, connectionrequest.EdgeReceiver)
+func (m *ReadMarkerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedconnection {
+		edges = append(edges, readmarker.EdgeConnection)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConnectionRequestMutation) EdgeCleared(name string) bool {
+func (m *ReadMarkerMutation) EdgeCleared(name string) bool {
 	switch name {
-	case connectionrequest.EdgeSender:
-		return m.clearedsender
-	case connectionrequest.EdgeReceiver:
-		return m.clearedreceiver
+	case readmarker.EdgeConnection:
+		return m.clearedconnection
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConnectionRequestMutation) ClearEdge(name string) error {
+func (m *ReadMarkerMutation) ClearEdge(name string) error {
 	switch name {
-	case connectionrequest.EdgeSender:
-		m.ClearSender()
-		return nil
-	case connectionrequest.EdgeReceiver:
-		m.ClearReceiver()
+	case readmarker.EdgeConnection:
+		m.ClearConnection()
 		return nil
 	}
-	return fmt.Errorf("unknown ConnectionRequest unique edge %s", name)
+	return fmt.Errorf("unknown ReadMarker unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConnectionRequestMutation) ResetEdge(name string) error {
+func (m *ReadMarkerMutation) ResetEdge(name string) error {
 	switch name {
-	case connectionrequest.EdgeSender:
-		m.ResetSender()
-		return nil
-	case connectionrequest.EdgeReceiver:
-		m.ResetReceiver()
+	case readmarker.EdgeConnection:
+		m.ResetConnection()
 		return nil
 	}
-	return fmt.Errorf("unknown ConnectionRequest edge %s", name)
+	return fmt.Errorf("unknown ReadMarker edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	_type             *message.Type
-	content           *string
-	media_url         *string
-	media_type        *string
-	media_public_id   *string
-	is_read           *bool
-	created_at        *time.Time
-	updated_at        *time.Time
-	read_at           *time.Time
-	is_deleted        *bool
-	deleted_at        *time.Time
-	clearedFields     map[string]struct{}
-	connection        *uuid.UUID
-	clearedconnection bool
-	sender            *uuid.UUID
-	clearedsender     bool
-	receiver          *uuid.UUID
-	clearedreceiver   bool
-	done              bool
-	oldValue          func(context.Context) (*Message, error)
-	predicates        []predicate.Message
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	refresh_token_hash *string
+	device             *string
+	ip_address         *string
+	user_agent         *string
+	created_at         *time.Time
+	last_used_at       *time.Time
+	expires_at         *time.Time
+	revoked_at         *time.Time
+	revoke_reason      *string
+	clearedFields      map[string]struct{}
+	user               *uuid.UUID
+	cleareduser        bool
+	done               bool
+	oldValue           func(context.Context) (*Session, error)
+	predicates         []predicate.Session
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*SessionMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1681,20 +8143,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id uuid.UUID) messageOption {
-	return func(m *MessageMutation) {
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id uuid.UUID) sessionOption {
+	return func(m *SessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *Session
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*Session, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().Session.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1703,10 +8165,10 @@ func withMessageID(id uuid.UUID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1715,7 +8177,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m SessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1723,7 +8185,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m SessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1733,14 +8195,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Session entities.
+func (m *SessionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SessionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1751,7 +8213,7 @@ func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1760,395 +8222,238 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetConnectionID sets the "connection_id" field.
-func (m *MessageMutation) SetConnectionID(u uuid.UUID) {
-	m.connection = &u
-}
-
-// ConnectionID returns the value of the "connection_id" field in the mutation.
-func (m *MessageMutation) ConnectionID() (r uuid.UUID, exists bool) {
-	v := m.connection
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldConnectionID returns the old "connection_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldConnectionID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectionID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectionID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectionID: %w", err)
-	}
-	return oldValue.ConnectionID, nil
-}
-
-// ResetConnectionID resets all changes to the "connection_id" field.
-func (m *MessageMutation) ResetConnectionID() {
-	m.connection = nil
-}
-
-// SetSenderID sets the "sender_id" field.
-func (m *MessageMutation) SetSenderID(u uuid.UUID) {
-	m.sender = &u
-}
-
-// SenderID returns the value of the "sender_id" field in the mutation.
-func (m *MessageMutation) SenderID() (r uuid.UUID, exists bool) {
-	v := m.sender
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSenderID returns the old "sender_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSenderID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
-	}
-	return oldValue.SenderID, nil
-}
-
-// ResetSenderID resets all changes to the "sender_id" field.
-func (m *MessageMutation) ResetSenderID() {
-	m.sender = nil
-}
-
-// SetReceiverID sets the "receiver_id" field.
-func (m *MessageMutation) SetReceiverID(u uuid.UUID) {
-	m.receiver = &u
-}
-
-// ReceiverID returns the value of the "receiver_id" field in the mutation.
-func (m *MessageMutation) ReceiverID() (r uuid.UUID, exists bool) {
-	v := m.receiver
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldReceiverID returns the old "receiver_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldReceiverID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReceiverID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReceiverID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReceiverID: %w", err)
-	}
-	return oldValue.ReceiverID, nil
-}
-
-// ResetReceiverID resets all changes to the "receiver_id" field.
-func (m *MessageMutation) ResetReceiverID() {
-	m.receiver = nil
-}
-
-// SetType sets the "type" field.
-func (m *MessageMutation) SetType(value message.Type) {
-	m._type = &value
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *MessageMutation) GetType() (r message.Type, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldType(ctx context.Context) (v message.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *MessageMutation) ResetType() {
-	m._type = nil
-}
-
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(s string) {
-	m.content = &s
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r string, exists bool) {
-	v := m.content
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Content, nil
-}
-
-// ClearContent clears the value of the "content" field.
-func (m *MessageMutation) ClearContent() {
-	m.content = nil
-	m.clearedFields[message.FieldContent] = struct{}{}
-}
-
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *MessageMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[message.FieldContent]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
-	delete(m.clearedFields, message.FieldContent)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetMediaURL sets the "media_url" field.
-func (m *MessageMutation) SetMediaURL(s string) {
-	m.media_url = &s
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (m *SessionMutation) SetRefreshTokenHash(s string) {
+	m.refresh_token_hash = &s
 }
 
-// MediaURL returns the value of the "media_url" field in the mutation.
-func (m *MessageMutation) MediaURL() (r string, exists bool) {
-	v := m.media_url
+// RefreshTokenHash returns the value of the "refresh_token_hash" field in the mutation.
+func (m *SessionMutation) RefreshTokenHash() (r string, exists bool) {
+	v := m.refresh_token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMediaURL returns the old "media_url" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRefreshTokenHash returns the old "refresh_token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldMediaURL(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldRefreshTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMediaURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldRefreshTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMediaURL requires an ID field in the mutation")
+		return v, errors.New("OldRefreshTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMediaURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldRefreshTokenHash: %w", err)
 	}
-	return oldValue.MediaURL, nil
-}
-
-// ClearMediaURL clears the value of the "media_url" field.
-func (m *MessageMutation) ClearMediaURL() {
-	m.media_url = nil
-	m.clearedFields[message.FieldMediaURL] = struct{}{}
-}
-
-// MediaURLCleared returns if the "media_url" field was cleared in this mutation.
-func (m *MessageMutation) MediaURLCleared() bool {
-	_, ok := m.clearedFields[message.FieldMediaURL]
-	return ok
+	return oldValue.RefreshTokenHash, nil
 }
 
-// ResetMediaURL resets all changes to the "media_url" field.
-func (m *MessageMutation) ResetMediaURL() {
-	m.media_url = nil
-	delete(m.clearedFields, message.FieldMediaURL)
+// ResetRefreshTokenHash resets all changes to the "refresh_token_hash" field.
+func (m *SessionMutation) ResetRefreshTokenHash() {
+	m.refresh_token_hash = nil
 }
 
-// SetMediaType sets the "media_type" field.
-func (m *MessageMutation) SetMediaType(s string) {
-	m.media_type = &s
+// SetDevice sets the "device" field.
+func (m *SessionMutation) SetDevice(s string) {
+	m.device = &s
 }
 
-// MediaType returns the value of the "media_type" field in the mutation.
-func (m *MessageMutation) MediaType() (r string, exists bool) {
-	v := m.media_type
+// Device returns the value of the "device" field in the mutation.
+func (m *SessionMutation) Device() (r string, exists bool) {
+	v := m.device
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMediaType returns the old "media_type" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldDevice returns the old "device" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldMediaType(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldDevice(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMediaType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMediaType requires an ID field in the mutation")
+		return v, errors.New("OldDevice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMediaType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
 	}
-	return oldValue.MediaType, nil
+	return oldValue.Device, nil
 }
 
-// ClearMediaType clears the value of the "media_type" field.
-func (m *MessageMutation) ClearMediaType() {
-	m.media_type = nil
-	m.clearedFields[message.FieldMediaType] = struct{}{}
+// ClearDevice clears the value of the "device" field.
+func (m *SessionMutation) ClearDevice() {
+	m.device = nil
+	m.clearedFields[session.FieldDevice] = struct{}{}
 }
 
-// MediaTypeCleared returns if the "media_type" field was cleared in this mutation.
-func (m *MessageMutation) MediaTypeCleared() bool {
-	_, ok := m.clearedFields[message.FieldMediaType]
+// DeviceCleared returns if the "device" field was cleared in this mutation.
+func (m *SessionMutation) DeviceCleared() bool {
+	_, ok := m.clearedFields[session.FieldDevice]
 	return ok
 }
 
-// ResetMediaType resets all changes to the "media_type" field.
-func (m *MessageMutation) ResetMediaType() {
-	m.media_type = nil
-	delete(m.clearedFields, message.FieldMediaType)
+// ResetDevice resets all changes to the "device" field.
+func (m *SessionMutation) ResetDevice() {
+	m.device = nil
+	delete(m.clearedFields, session.FieldDevice)
 }
 
-// SetMediaPublicID sets the "media_public_id" field.
-func (m *MessageMutation) SetMediaPublicID(s string) {
-	m.media_public_id = &s
+// SetIPAddress sets the "ip_address" field.
+func (m *SessionMutation) SetIPAddress(s string) {
+	m.ip_address = &s
 }
 
-// MediaPublicID returns the value of the "media_public_id" field in the mutation.
-func (m *MessageMutation) MediaPublicID() (r string, exists bool) {
-	v := m.media_public_id
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *SessionMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMediaPublicID returns the old "media_public_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldIPAddress returns the old "ip_address" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldMediaPublicID(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldIPAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMediaPublicID is only allowed on UpdateOne operations")
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMediaPublicID requires an ID field in the mutation")
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMediaPublicID: %w", err)
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
 	}
-	return oldValue.MediaPublicID, nil
+	return oldValue.IPAddress, nil
 }
 
-// ClearMediaPublicID clears the value of the "media_public_id" field.
-func (m *MessageMutation) ClearMediaPublicID() {
-	m.media_public_id = nil
-	m.clearedFields[message.FieldMediaPublicID] = struct{}{}
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *SessionMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[session.FieldIPAddress] = struct{}{}
 }
 
-// MediaPublicIDCleared returns if the "media_public_id" field was cleared in this mutation.
-func (m *MessageMutation) MediaPublicIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldMediaPublicID]
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *SessionMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[session.FieldIPAddress]
 	return ok
 }
 
-// ResetMediaPublicID resets all changes to the "media_public_id" field.
-func (m *MessageMutation) ResetMediaPublicID() {
-	m.media_public_id = nil
-	delete(m.clearedFields, message.FieldMediaPublicID)
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *SessionMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, session.FieldIPAddress)
 }
 
-// SetIsRead sets the "is_read" field.
-func (m *MessageMutation) SetIsRead(b bool) {
-	m.is_read = &b
+// SetUserAgent sets the "user_agent" field.
+func (m *SessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// IsRead returns the value of the "is_read" field in the mutation.
-func (m *MessageMutation) IsRead() (r bool, exists bool) {
-	v := m.is_read
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsRead returns the old "is_read" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUserAgent returns the old "user_agent" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsRead(ctx context.Context) (v bool, err error) {
+func (m *SessionMutation) OldUserAgent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRead is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRead requires an ID field in the mutation")
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRead: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	return oldValue.IsRead, nil
+	return oldValue.UserAgent, nil
 }
 
-// ResetIsRead resets all changes to the "is_read" field.
-func (m *MessageMutation) ResetIsRead() {
-	m.is_read = nil
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *SessionMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[session.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *SessionMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[session.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, session.FieldUserAgent)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2156,10 +8461,10 @@ func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2174,270 +8479,216 @@ func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
+func (m *SessionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *MessageMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *SessionMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *SessionMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldLastUsedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MessageMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *SessionMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
 }
 
-// SetReadAt sets the "read_at" field.
-func (m *MessageMutation) SetReadAt(t time.Time) {
-	m.read_at = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// ReadAt returns the value of the "read_at" field in the mutation.
-func (m *MessageMutation) ReadAt() (r time.Time, exists bool) {
-	v := m.read_at
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReadAt returns the old "read_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldReadAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReadAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.ReadAt, nil
-}
-
-// ClearReadAt clears the value of the "read_at" field.
-func (m *MessageMutation) ClearReadAt() {
-	m.read_at = nil
-	m.clearedFields[message.FieldReadAt] = struct{}{}
-}
-
-// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
-func (m *MessageMutation) ReadAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldReadAt]
-	return ok
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetReadAt resets all changes to the "read_at" field.
-func (m *MessageMutation) ResetReadAt() {
-	m.read_at = nil
-	delete(m.clearedFields, message.FieldReadAt)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetIsDeleted sets the "is_deleted" field.
-func (m *MessageMutation) SetIsDeleted(b bool) {
-	m.is_deleted = &b
+// SetRevokedAt sets the "revoked_at" field.
+func (m *SessionMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// IsDeleted returns the value of the "is_deleted" field in the mutation.
-func (m *MessageMutation) IsDeleted() (r bool, exists bool) {
-	v := m.is_deleted
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *SessionMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDeleted returns the old "is_deleted" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsDeleted(ctx context.Context) (v bool, err error) {
+func (m *SessionMutation) OldRevokedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDeleted is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDeleted requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDeleted: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.IsDeleted, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ResetIsDeleted resets all changes to the "is_deleted" field.
-func (m *MessageMutation) ResetIsDeleted() {
-	m.is_deleted = nil
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *SessionMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[session.FieldRevokedAt] = struct{}{}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *MessageMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *SessionMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevokedAt]
+	return ok
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *SessionMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, session.FieldRevokedAt)
+}
+
+// SetRevokeReason sets the "revoke_reason" field.
+func (m *SessionMutation) SetRevokeReason(s string) {
+	m.revoke_reason = &s
+}
+
+// RevokeReason returns the value of the "revoke_reason" field in the mutation.
+func (m *SessionMutation) RevokeReason() (r string, exists bool) {
+	v := m.revoke_reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokeReason returns the old "revoke_reason" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldRevokeReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokeReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldRevokeReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *MessageMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[message.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *MessageMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *MessageMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, message.FieldDeletedAt)
-}
-
-// ClearConnection clears the "connection" edge to the Connection entity.
-func (m *MessageMutation) ClearConnection() {
-	m.clearedconnection = true
-	m.clearedFields[message.FieldConnectionID] = struct{}{}
-}
-
-// ConnectionCleared reports if the "connection" edge to the Connection entity was cleared.
-func (m *MessageMutation) ConnectionCleared() bool {
-	return m.clearedconnection
-}
-
-// ConnectionIDs returns the "connection" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ConnectionID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ConnectionIDs() (ids []uuid.UUID) {
-	if id := m.connection; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldRevokeReason: %w", err)
 	}
-	return
-}
-
-// ResetConnection resets all changes to the "connection" edge.
-func (m *MessageMutation) ResetConnection() {
-	m.connection = nil
-	m.clearedconnection = false
-}
-
-// ClearSender clears the "sender" edge to the User entity.
-func (m *MessageMutation) ClearSender() {
-	m.clearedsender = true
-	m.clearedFields[message.FieldSenderID] = struct{}{}
-}
-
-// SenderCleared reports if the "sender" edge to the User entity was cleared.
-func (m *MessageMutation) SenderCleared() bool {
-	return m.clearedsender
+	return oldValue.RevokeReason, nil
 }
 
-// SenderIDs returns the "sender" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SenderID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) SenderIDs() (ids []uuid.UUID) {
-	if id := m.sender; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearRevokeReason clears the value of the "revoke_reason" field.
+func (m *SessionMutation) ClearRevokeReason() {
+	m.revoke_reason = nil
+	m.clearedFields[session.FieldRevokeReason] = struct{}{}
 }
 
-// ResetSender resets all changes to the "sender" edge.
-func (m *MessageMutation) ResetSender() {
-	m.sender = nil
-	m.clearedsender = false
+// RevokeReasonCleared returns if the "revoke_reason" field was cleared in this mutation.
+func (m *SessionMutation) RevokeReasonCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevokeReason]
+	return ok
 }
 
-// ClearReceiver clears the "receiver" edge to the User entity.
-func (m *MessageMutation) ClearReceiver() {
-	m.clearedreceiver = true
-	m.clearedFields[message.FieldReceiverID] = struct{}{}
+// ResetRevokeReason resets all changes to the "revoke_reason" field.
+func (m *SessionMutation) ResetRevokeReason() {
+	m.revoke_reason = nil
+	delete(m.clearedFields, session.FieldRevokeReason)
 }
 
-// ReceiverCleared reports if the "receiver" edge to the User entity was cleared.
-func (m *MessageMutation) ReceiverCleared() bool {
-	return m.clearedreceiver
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[session.FieldUserID] = struct{}{}
 }
 
-// ReceiverIDs returns the "receiver" edge IDs in the mutation.
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ReceiverID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ReceiverIDs() (ids []uuid.UUID) {
-	if id := m.receiver; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetReceiver resets all changes to the "receiver" edge.
-func (m *MessageMutation) ResetReceiver() {
-	m.receiver = nil
-	m.clearedreceiver = false
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2445,66 +8696,54 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *SessionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *SessionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.connection != nil {
-		fields = append(fields, message.FieldConnectionID)
-	}
-	if m.sender != nil {
-		fields = append(fields, message.FieldSenderID)
-	}
-	if m.receiver != nil {
-		fields = append(fields, message.FieldReceiverID)
-	}
-	if m._type != nil {
-		fields = append(fields, message.FieldType)
-	}
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user != nil {
+		fields = append(fields, session.FieldUserID)
 	}
-	if m.media_url != nil {
-		fields = append(fields, message.FieldMediaURL)
+	if m.refresh_token_hash != nil {
+		fields = append(fields, session.FieldRefreshTokenHash)
 	}
-	if m.media_type != nil {
-		fields = append(fields, message.FieldMediaType)
+	if m.device != nil {
+		fields = append(fields, session.FieldDevice)
 	}
-	if m.media_public_id != nil {
-		fields = append(fields, message.FieldMediaPublicID)
+	if m.ip_address != nil {
+		fields = append(fields, session.FieldIPAddress)
 	}
-	if m.is_read != nil {
-		fields = append(fields, message.FieldIsRead)
+	if m.user_agent != nil {
+		fields = append(fields, session.FieldUserAgent)
 	}
 	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+		fields = append(fields, session.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, message.FieldUpdatedAt)
+	if m.last_used_at != nil {
+		fields = append(fields, session.FieldLastUsedAt)
 	}
-	if m.read_at != nil {
-		fields = append(fields, message.FieldReadAt)
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
 	}
-	if m.is_deleted != nil {
-		fields = append(fields, message.FieldIsDeleted)
+	if m.revoked_at != nil {
+		fields = append(fields, session.FieldRevokedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, message.FieldDeletedAt)
+	if m.revoke_reason != nil {
+		fields = append(fields, session.FieldRevokeReason)
 	}
 	return fields
 }
@@ -2512,36 +8751,28 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldConnectionID:
-		return m.ConnectionID()
-	case message.FieldSenderID:
-		return m.SenderID()
-	case message.FieldReceiverID:
-		return m.ReceiverID()
-	case message.FieldType:
-		return m.GetType()
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldMediaURL:
-		return m.MediaURL()
-	case message.FieldMediaType:
-		return m.MediaType()
-	case message.FieldMediaPublicID:
-		return m.MediaPublicID()
-	case message.FieldIsRead:
-		return m.IsRead()
-	case message.FieldCreatedAt:
+	case session.FieldUserID:
+		return m.UserID()
+	case session.FieldRefreshTokenHash:
+		return m.RefreshTokenHash()
+	case session.FieldDevice:
+		return m.Device()
+	case session.FieldIPAddress:
+		return m.IPAddress()
+	case session.FieldUserAgent:
+		return m.UserAgent()
+	case session.FieldCreatedAt:
 		return m.CreatedAt()
-	case message.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case message.FieldReadAt:
-		return m.ReadAt()
-	case message.FieldIsDeleted:
-		return m.IsDeleted()
-	case message.FieldDeletedAt:
-		return m.DeletedAt()
+	case session.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldRevokedAt:
+		return m.RevokedAt()
+	case session.FieldRevokeReason:
+		return m.RevokeReason()
 	}
 	return nil, false
 }
@@ -2549,306 +8780,238 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldConnectionID:
-		return m.OldConnectionID(ctx)
-	case message.FieldSenderID:
-		return m.OldSenderID(ctx)
-	case message.FieldReceiverID:
-		return m.OldReceiverID(ctx)
-	case message.FieldType:
-		return m.OldType(ctx)
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldMediaURL:
-		return m.OldMediaURL(ctx)
-	case message.FieldMediaType:
-		return m.OldMediaType(ctx)
-	case message.FieldMediaPublicID:
-		return m.OldMediaPublicID(ctx)
-	case message.FieldIsRead:
-		return m.OldIsRead(ctx)
-	case message.FieldCreatedAt:
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
+	case session.FieldRefreshTokenHash:
+		return m.OldRefreshTokenHash(ctx)
+	case session.FieldDevice:
+		return m.OldDevice(ctx)
+	case session.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case session.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case session.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case message.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case message.FieldReadAt:
-		return m.OldReadAt(ctx)
-	case message.FieldIsDeleted:
-		return m.OldIsDeleted(ctx)
-	case message.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+	case session.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case session.FieldRevokeReason:
+		return m.OldRevokeReason(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown Session field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldConnectionID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConnectionID(v)
-		return nil
-	case message.FieldSenderID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSenderID(v)
-		return nil
-	case message.FieldReceiverID:
+	case session.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReceiverID(v)
-		return nil
-	case message.FieldType:
-		v, ok := value.(message.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
+		m.SetUserID(v)
 		return nil
-	case message.FieldContent:
+	case session.FieldRefreshTokenHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetRefreshTokenHash(v)
 		return nil
-	case message.FieldMediaURL:
+	case session.FieldDevice:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMediaURL(v)
+		m.SetDevice(v)
 		return nil
-	case message.FieldMediaType:
+	case session.FieldIPAddress:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMediaType(v)
+		m.SetIPAddress(v)
 		return nil
-	case message.FieldMediaPublicID:
+	case session.FieldUserAgent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMediaPublicID(v)
-		return nil
-	case message.FieldIsRead:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsRead(v)
+		m.SetUserAgent(v)
 		return nil
-	case message.FieldCreatedAt:
+	case session.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case message.FieldUpdatedAt:
+	case session.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case message.FieldReadAt:
+	case session.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReadAt(v)
+		m.SetExpiresAt(v)
 		return nil
-	case message.FieldIsDeleted:
-		v, ok := value.(bool)
+	case session.FieldRevokedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDeleted(v)
+		m.SetRevokedAt(v)
 		return nil
-	case message.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case session.FieldRevokeReason:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetRevokeReason(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *SessionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown Session numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *SessionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldContent) {
-		fields = append(fields, message.FieldContent)
-	}
-	if m.FieldCleared(message.FieldMediaURL) {
-		fields = append(fields, message.FieldMediaURL)
+	if m.FieldCleared(session.FieldDevice) {
+		fields = append(fields, session.FieldDevice)
 	}
-	if m.FieldCleared(message.FieldMediaType) {
-		fields = append(fields, message.FieldMediaType)
+	if m.FieldCleared(session.FieldIPAddress) {
+		fields = append(fields, session.FieldIPAddress)
 	}
-	if m.FieldCleared(message.FieldMediaPublicID) {
-		fields = append(fields, message.FieldMediaPublicID)
+	if m.FieldCleared(session.FieldUserAgent) {
+		fields = append(fields, session.FieldUserAgent)
 	}
-	if m.FieldCleared(message.FieldReadAt) {
-		fields = append(fields, message.FieldReadAt)
+	if m.FieldCleared(session.FieldRevokedAt) {
+		fields = append(fields, session.FieldRevokedAt)
 	}
-	if m.FieldCleared(message.FieldDeletedAt) {
-		fields = append(fields, message.FieldDeletedAt)
+	if m.FieldCleared(session.FieldRevokeReason) {
+		fields = append(fields, session.FieldRevokeReason)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *SessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *SessionMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldContent:
-		m.ClearContent()
-		return nil
-	case message.FieldMediaURL:
-		m.ClearMediaURL()
+	case session.FieldDevice:
+		m.ClearDevice()
 		return nil
-	case message.FieldMediaType:
-		m.ClearMediaType()
+	case session.FieldIPAddress:
+		m.ClearIPAddress()
 		return nil
-	case message.FieldMediaPublicID:
-		m.ClearMediaPublicID()
+	case session.FieldUserAgent:
+		m.ClearUserAgent()
 		return nil
-	case message.FieldReadAt:
-		m.ClearReadAt()
+	case session.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
-	case message.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case session.FieldRevokeReason:
+		m.ClearRevokeReason()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown Session nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *SessionMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldConnectionID:
-		m.ResetConnectionID()
-		return nil
-	case message.FieldSenderID:
-		m.ResetSenderID()
-		return nil
-	case message.FieldReceiverID:
-		m.ResetReceiverID()
-		return nil
-	case message.FieldType:
-		m.ResetType()
-		return nil
-	case message.FieldContent:
-		m.ResetContent()
-		return nil
-	case message.FieldMediaURL:
-		m.ResetMediaURL()
+	case session.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case message.FieldMediaType:
-		m.ResetMediaType()
+	case session.FieldRefreshTokenHash:
+		m.ResetRefreshTokenHash()
 		return nil
-	case message.FieldMediaPublicID:
-		m.ResetMediaPublicID()
+	case session.FieldDevice:
+		m.ResetDevice()
 		return nil
-	case message.FieldIsRead:
-		m.ResetIsRead()
+	case session.FieldIPAddress:
+		m.ResetIPAddress()
 		return nil
-	case message.FieldCreatedAt:
+	case session.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case session.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case message.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case session.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case message.FieldReadAt:
-		m.ResetReadAt()
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case message.FieldIsDeleted:
-		m.ResetIsDeleted()
+	case session.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
-	case message.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case session.FieldRevokeReason:
+		m.ResetRevokeReason()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.connection != nil {
-		edges = append(edges, message.EdgeConnection)
-	}
-	if m.sender != nil {
-		edges = append(edges, message.EdgeSender)
-	}
-	if m.receiver != nil {
-		edges = append(edges, message.EdgeReceiver)
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeConnection:
-		if id := m.connection; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeSender:
-		if id := m.sender; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeReceiver:
-		if id := m.receiver; id != nil {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2856,78 +9019,56 @@ func (m *MessageMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedconnection {
-		edges = append(edges, message.EdgeConnection)
-	}
-	if m.clearedsender {
-		edges = append(edges, message.EdgeSender)
-	}
-	if m.clearedreceiver {
-		edges = append(edges, message.EdgeReceiver)
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *SessionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeConnection:
-		return m.clearedconnection
-	case message.EdgeSender:
-		return m.clearedsender
-	case message.EdgeReceiver:
-		return m.clearedreceiver
+	case session.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *SessionMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeConnection:
-		m.ClearConnection()
-		return nil
-	case message.EdgeSender:
-		m.ClearSender()
-		return nil
-	case message.EdgeReceiver:
-		m.ClearReceiver()
+	case session.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown Session unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *SessionMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeConnection:
-		m.ResetConnection()
-		return nil
-	case message.EdgeSender:
-		m.ResetSender()
-		return nil
-	case message.EdgeReceiver:
-		m.ResetReceiver()
+	case session.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown Session edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -2943,6 +9084,8 @@ type UserMutation struct {
 	about_me                *string
 	created_at              *time.Time
 	updated_at              *time.Time
+	email_verified_at       *time.Time
+	verification_sent_at    *time.Time
 	age                     *int
 	addage                  *int
 	preferred_age_min       *int
@@ -2967,10 +9110,18 @@ type UserMutation struct {
 	communication_style     *string
 	prompts                 *[]schema.Prompt
 	appendprompts           []schema.Prompt
+	last_seen_at            *time.Time
+	show_last_seen          *bool
+	status_mode             *user.StatusMode
+	status_message          *string
+	role                    *user.Role
 	clearedFields           map[string]struct{}
 	photos                  map[uuid.UUID]struct{}
 	removedphotos           map[uuid.UUID]struct{}
 	clearedphotos           bool
+	sessions                map[uuid.UUID]struct{}
+	removedsessions         map[uuid.UUID]struct{}
+	clearedsessions         bool
 	done                    bool
 	oldValue                func(context.Context) (*User, error)
 	predicates              []predicate.User
@@ -3345,6 +9496,104 @@ func (m *UserMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (m *UserMutation) SetEmailVerifiedAt(t time.Time) {
+	m.email_verified_at = &t
+}
+
+// EmailVerifiedAt returns the value of the "email_verified_at" field in the mutation.
+func (m *UserMutation) EmailVerifiedAt() (r time.Time, exists bool) {
+	v := m.email_verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailVerifiedAt returns the old "email_verified_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmailVerifiedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailVerifiedAt: %w", err)
+	}
+	return oldValue.EmailVerifiedAt, nil
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (m *UserMutation) ClearEmailVerifiedAt() {
+	m.email_verified_at = nil
+	m.clearedFields[user.FieldEmailVerifiedAt] = struct{}{}
+}
+
+// EmailVerifiedAtCleared returns if the "email_verified_at" field was cleared in this mutation.
+func (m *UserMutation) EmailVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmailVerifiedAt]
+	return ok
+}
+
+// ResetEmailVerifiedAt resets all changes to the "email_verified_at" field.
+func (m *UserMutation) ResetEmailVerifiedAt() {
+	m.email_verified_at = nil
+	delete(m.clearedFields, user.FieldEmailVerifiedAt)
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (m *UserMutation) SetVerificationSentAt(t time.Time) {
+	m.verification_sent_at = &t
+}
+
+// VerificationSentAt returns the value of the "verification_sent_at" field in the mutation.
+func (m *UserMutation) VerificationSentAt() (r time.Time, exists bool) {
+	v := m.verification_sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationSentAt returns the old "verification_sent_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldVerificationSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationSentAt: %w", err)
+	}
+	return oldValue.VerificationSentAt, nil
+}
+
+// ClearVerificationSentAt clears the value of the "verification_sent_at" field.
+func (m *UserMutation) ClearVerificationSentAt() {
+	m.verification_sent_at = nil
+	m.clearedFields[user.FieldVerificationSentAt] = struct{}{}
+}
+
+// VerificationSentAtCleared returns if the "verification_sent_at" field was cleared in this mutation.
+func (m *UserMutation) VerificationSentAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldVerificationSentAt]
+	return ok
+}
+
+// ResetVerificationSentAt resets all changes to the "verification_sent_at" field.
+func (m *UserMutation) ResetVerificationSentAt() {
+	m.verification_sent_at = nil
+	delete(m.clearedFields, user.FieldVerificationSentAt)
+}
+
 // SetAge sets the "age" field.
 func (m *UserMutation) SetAge(i int) {
 	m.age = &i
@@ -4169,11 +10418,217 @@ func (m *UserMutation) PromptsCleared() bool {
 	return ok
 }
 
-// ResetPrompts resets all changes to the "prompts" field.
-func (m *UserMutation) ResetPrompts() {
-	m.prompts = nil
-	m.appendprompts = nil
-	delete(m.clearedFields, user.FieldPrompts)
+// ResetPrompts resets all changes to the "prompts" field.
+func (m *UserMutation) ResetPrompts() {
+	m.prompts = nil
+	m.appendprompts = nil
+	delete(m.clearedFields, user.FieldPrompts)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *UserMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *UserMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[user.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *UserMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *UserMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, user.FieldLastSeenAt)
+}
+
+// SetShowLastSeen sets the "show_last_seen" field.
+func (m *UserMutation) SetShowLastSeen(b bool) {
+	m.show_last_seen = &b
+}
+
+// ShowLastSeen returns the value of the "show_last_seen" field in the mutation.
+func (m *UserMutation) ShowLastSeen() (r bool, exists bool) {
+	v := m.show_last_seen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShowLastSeen returns the old "show_last_seen" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldShowLastSeen(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShowLastSeen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShowLastSeen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShowLastSeen: %w", err)
+	}
+	return oldValue.ShowLastSeen, nil
+}
+
+// ResetShowLastSeen resets all changes to the "show_last_seen" field.
+func (m *UserMutation) ResetShowLastSeen() {
+	m.show_last_seen = nil
+}
+
+// SetStatusMode sets the "status_mode" field.
+func (m *UserMutation) SetStatusMode(um user.StatusMode) {
+	m.status_mode = &um
+}
+
+// StatusMode returns the value of the "status_mode" field in the mutation.
+func (m *UserMutation) StatusMode() (r user.StatusMode, exists bool) {
+	v := m.status_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusMode returns the old "status_mode" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatusMode(ctx context.Context) (v user.StatusMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusMode: %w", err)
+	}
+	return oldValue.StatusMode, nil
+}
+
+// ResetStatusMode resets all changes to the "status_mode" field.
+func (m *UserMutation) ResetStatusMode() {
+	m.status_mode = nil
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (m *UserMutation) SetStatusMessage(s string) {
+	m.status_message = &s
+}
+
+// StatusMessage returns the value of the "status_message" field in the mutation.
+func (m *UserMutation) StatusMessage() (r string, exists bool) {
+	v := m.status_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusMessage returns the old "status_message" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatusMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusMessage: %w", err)
+	}
+	return oldValue.StatusMessage, nil
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (m *UserMutation) ClearStatusMessage() {
+	m.status_message = nil
+	m.clearedFields[user.FieldStatusMessage] = struct{}{}
+}
+
+// StatusMessageCleared returns if the "status_message" field was cleared in this mutation.
+func (m *UserMutation) StatusMessageCleared() bool {
+	_, ok := m.clearedFields[user.FieldStatusMessage]
+	return ok
+}
+
+// ResetStatusMessage resets all changes to the "status_message" field.
+func (m *UserMutation) ResetStatusMessage() {
+	m.status_message = nil
+	delete(m.clearedFields, user.FieldStatusMessage)
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
 // AddPhotoIDs adds the "photos" edge to the UserPhoto entity by ids.
@@ -4230,6 +10685,60 @@ func (m *UserMutation) ResetPhotos() {
 	m.removedphotos = nil
 }
 
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...uuid.UUID) {
+	if m.sessions == nil {
+		m.sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...uuid.UUID) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []uuid.UUID) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -4264,7 +10773,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 21)
+	fields := make([]string, 0, 28)
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
@@ -4286,6 +10795,12 @@ func (m *UserMutation) Fields() []string {
 	if m.updated_at != nil {
 		fields = append(fields, user.FieldUpdatedAt)
 	}
+	if m.email_verified_at != nil {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
+	if m.verification_sent_at != nil {
+		fields = append(fields, user.FieldVerificationSentAt)
+	}
 	if m.age != nil {
 		fields = append(fields, user.FieldAge)
 	}
@@ -4328,6 +10843,21 @@ func (m *UserMutation) Fields() []string {
 	if m.prompts != nil {
 		fields = append(fields, user.FieldPrompts)
 	}
+	if m.last_seen_at != nil {
+		fields = append(fields, user.FieldLastSeenAt)
+	}
+	if m.show_last_seen != nil {
+		fields = append(fields, user.FieldShowLastSeen)
+	}
+	if m.status_mode != nil {
+		fields = append(fields, user.FieldStatusMode)
+	}
+	if m.status_message != nil {
+		fields = append(fields, user.FieldStatusMessage)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
 	return fields
 }
 
@@ -4350,6 +10880,10 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedAt()
 	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case user.FieldEmailVerifiedAt:
+		return m.EmailVerifiedAt()
+	case user.FieldVerificationSentAt:
+		return m.VerificationSentAt()
 	case user.FieldAge:
 		return m.Age()
 	case user.FieldPreferredAgeMin:
@@ -4378,6 +10912,16 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.CommunicationStyle()
 	case user.FieldPrompts:
 		return m.Prompts()
+	case user.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case user.FieldShowLastSeen:
+		return m.ShowLastSeen()
+	case user.FieldStatusMode:
+		return m.StatusMode()
+	case user.FieldStatusMessage:
+		return m.StatusMessage()
+	case user.FieldRole:
+		return m.Role()
 	}
 	return nil, false
 }
@@ -4401,6 +10945,10 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldCreatedAt(ctx)
 	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case user.FieldEmailVerifiedAt:
+		return m.OldEmailVerifiedAt(ctx)
+	case user.FieldVerificationSentAt:
+		return m.OldVerificationSentAt(ctx)
 	case user.FieldAge:
 		return m.OldAge(ctx)
 	case user.FieldPreferredAgeMin:
@@ -4429,6 +10977,16 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldCommunicationStyle(ctx)
 	case user.FieldPrompts:
 		return m.OldPrompts(ctx)
+	case user.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case user.FieldShowLastSeen:
+		return m.OldShowLastSeen(ctx)
+	case user.FieldStatusMode:
+		return m.OldStatusMode(ctx)
+	case user.FieldStatusMessage:
+		return m.OldStatusMessage(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -4487,6 +11045,20 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case user.FieldEmailVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailVerifiedAt(v)
+		return nil
+	case user.FieldVerificationSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationSentAt(v)
+		return nil
 	case user.FieldAge:
 		v, ok := value.(int)
 		if !ok {
@@ -4585,6 +11157,41 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPrompts(v)
 		return nil
+	case user.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case user.FieldShowLastSeen:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShowLastSeen(v)
+		return nil
+	case user.FieldStatusMode:
+		v, ok := value.(user.StatusMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusMode(v)
+		return nil
+	case user.FieldStatusMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusMessage(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -4681,6 +11288,12 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldAboutMe) {
 		fields = append(fields, user.FieldAboutMe)
 	}
+	if m.FieldCleared(user.FieldEmailVerifiedAt) {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
+	if m.FieldCleared(user.FieldVerificationSentAt) {
+		fields = append(fields, user.FieldVerificationSentAt)
+	}
 	if m.FieldCleared(user.FieldPreferredAgeMin) {
 		fields = append(fields, user.FieldPreferredAgeMin)
 	}
@@ -4714,6 +11327,12 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldPrompts) {
 		fields = append(fields, user.FieldPrompts)
 	}
+	if m.FieldCleared(user.FieldLastSeenAt) {
+		fields = append(fields, user.FieldLastSeenAt)
+	}
+	if m.FieldCleared(user.FieldStatusMessage) {
+		fields = append(fields, user.FieldStatusMessage)
+	}
 	return fields
 }
 
@@ -4731,6 +11350,12 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldAboutMe:
 		m.ClearAboutMe()
 		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ClearEmailVerifiedAt()
+		return nil
+	case user.FieldVerificationSentAt:
+		m.ClearVerificationSentAt()
+		return nil
 	case user.FieldPreferredAgeMin:
 		m.ClearPreferredAgeMin()
 		return nil
@@ -4764,6 +11389,12 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldPrompts:
 		m.ClearPrompts()
 		return nil
+	case user.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	case user.FieldStatusMessage:
+		m.ClearStatusMessage()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -4793,6 +11424,12 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ResetEmailVerifiedAt()
+		return nil
+	case user.FieldVerificationSentAt:
+		m.ResetVerificationSentAt()
+		return nil
 	case user.FieldAge:
 		m.ResetAge()
 		return nil
@@ -4835,16 +11472,34 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldPrompts:
 		m.ResetPrompts()
 		return nil
+	case user.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case user.FieldShowLastSeen:
+		m.ResetShowLastSeen()
+		return nil
+	case user.FieldStatusMode:
+		m.ResetStatusMode()
+		return nil
+	case user.FieldStatusMessage:
+		m.ResetStatusMessage()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.photos != nil {
 		edges = append(edges, user.EdgePhotos)
 	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
 	return edges
 }
 
@@ -4858,16 +11513,25 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.removedphotos != nil {
 		edges = append(edges, user.EdgePhotos)
 	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
 	return edges
 }
 
@@ -4881,16 +11545,25 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.clearedphotos {
 		edges = append(edges, user.EdgePhotos)
 	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
 	return edges
 }
 
@@ -4900,6 +11573,8 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
 	case user.EdgePhotos:
 		return m.clearedphotos
+	case user.EdgeSessions:
+		return m.clearedsessions
 	}
 	return false
 }
@@ -4919,6 +11594,9 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgePhotos:
 		m.ResetPhotos()
 		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -5669,19 +12347,23 @@ func (m *UserInteractionMutation) ResetEdge(name string) error {
 // UserPhotoMutation represents an operation that mutates the UserPhoto nodes in the graph.
 type UserPhotoMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	photo_url     *string
-	public_id     *string
-	_order        *int
-	add_order     *int
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*UserPhoto, error)
-	predicates    []predicate.UserPhoto
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	photo_url           *string
+	public_id           *string
+	medium_url          *string
+	medium_public_id    *string
+	thumbnail_url       *string
+	thumbnail_public_id *string
+	_order              *int
+	add_order           *int
+	clearedFields       map[string]struct{}
+	user                *uuid.UUID
+	cleareduser         bool
+	done                bool
+	oldValue            func(context.Context) (*UserPhoto, error)
+	predicates          []predicate.UserPhoto
 }
 
 var _ ent.Mutation = (*UserPhotoMutation)(nil)
@@ -5860,6 +12542,202 @@ func (m *UserPhotoMutation) ResetPublicID() {
 	m.public_id = nil
 }
 
+// SetMediumURL sets the "medium_url" field.
+func (m *UserPhotoMutation) SetMediumURL(s string) {
+	m.medium_url = &s
+}
+
+// MediumURL returns the value of the "medium_url" field in the mutation.
+func (m *UserPhotoMutation) MediumURL() (r string, exists bool) {
+	v := m.medium_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediumURL returns the old "medium_url" field's value of the UserPhoto entity.
+// If the UserPhoto object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPhotoMutation) OldMediumURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediumURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediumURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediumURL: %w", err)
+	}
+	return oldValue.MediumURL, nil
+}
+
+// ClearMediumURL clears the value of the "medium_url" field.
+func (m *UserPhotoMutation) ClearMediumURL() {
+	m.medium_url = nil
+	m.clearedFields[userphoto.FieldMediumURL] = struct{}{}
+}
+
+// MediumURLCleared returns if the "medium_url" field was cleared in this mutation.
+func (m *UserPhotoMutation) MediumURLCleared() bool {
+	_, ok := m.clearedFields[userphoto.FieldMediumURL]
+	return ok
+}
+
+// ResetMediumURL resets all changes to the "medium_url" field.
+func (m *UserPhotoMutation) ResetMediumURL() {
+	m.medium_url = nil
+	delete(m.clearedFields, userphoto.FieldMediumURL)
+}
+
+// SetMediumPublicID sets the "medium_public_id" field.
+func (m *UserPhotoMutation) SetMediumPublicID(s string) {
+	m.medium_public_id = &s
+}
+
+// MediumPublicID returns the value of the "medium_public_id" field in the mutation.
+func (m *UserPhotoMutation) MediumPublicID() (r string, exists bool) {
+	v := m.medium_public_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediumPublicID returns the old "medium_public_id" field's value of the UserPhoto entity.
+// If the UserPhoto object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPhotoMutation) OldMediumPublicID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediumPublicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediumPublicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediumPublicID: %w", err)
+	}
+	return oldValue.MediumPublicID, nil
+}
+
+// ClearMediumPublicID clears the value of the "medium_public_id" field.
+func (m *UserPhotoMutation) ClearMediumPublicID() {
+	m.medium_public_id = nil
+	m.clearedFields[userphoto.FieldMediumPublicID] = struct{}{}
+}
+
+// MediumPublicIDCleared returns if the "medium_public_id" field was cleared in this mutation.
+func (m *UserPhotoMutation) MediumPublicIDCleared() bool {
+	_, ok := m.clearedFields[userphoto.FieldMediumPublicID]
+	return ok
+}
+
+// ResetMediumPublicID resets all changes to the "medium_public_id" field.
+func (m *UserPhotoMutation) ResetMediumPublicID() {
+	m.medium_public_id = nil
+	delete(m.clearedFields, userphoto.FieldMediumPublicID)
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *UserPhotoMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
+}
+
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *UserPhotoMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the UserPhoto entity.
+// If the UserPhoto object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPhotoMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+	}
+	return oldValue.ThumbnailURL, nil
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (m *UserPhotoMutation) ClearThumbnailURL() {
+	m.thumbnail_url = nil
+	m.clearedFields[userphoto.FieldThumbnailURL] = struct{}{}
+}
+
+// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
+func (m *UserPhotoMutation) ThumbnailURLCleared() bool {
+	_, ok := m.clearedFields[userphoto.FieldThumbnailURL]
+	return ok
+}
+
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *UserPhotoMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
+	delete(m.clearedFields, userphoto.FieldThumbnailURL)
+}
+
+// SetThumbnailPublicID sets the "thumbnail_public_id" field.
+func (m *UserPhotoMutation) SetThumbnailPublicID(s string) {
+	m.thumbnail_public_id = &s
+}
+
+// ThumbnailPublicID returns the value of the "thumbnail_public_id" field in the mutation.
+func (m *UserPhotoMutation) ThumbnailPublicID() (r string, exists bool) {
+	v := m.thumbnail_public_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailPublicID returns the old "thumbnail_public_id" field's value of the UserPhoto entity.
+// If the UserPhoto object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPhotoMutation) OldThumbnailPublicID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailPublicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailPublicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailPublicID: %w", err)
+	}
+	return oldValue.ThumbnailPublicID, nil
+}
+
+// ClearThumbnailPublicID clears the value of the "thumbnail_public_id" field.
+func (m *UserPhotoMutation) ClearThumbnailPublicID() {
+	m.thumbnail_public_id = nil
+	m.clearedFields[userphoto.FieldThumbnailPublicID] = struct{}{}
+}
+
+// ThumbnailPublicIDCleared returns if the "thumbnail_public_id" field was cleared in this mutation.
+func (m *UserPhotoMutation) ThumbnailPublicIDCleared() bool {
+	_, ok := m.clearedFields[userphoto.FieldThumbnailPublicID]
+	return ok
+}
+
+// ResetThumbnailPublicID resets all changes to the "thumbnail_public_id" field.
+func (m *UserPhotoMutation) ResetThumbnailPublicID() {
+	m.thumbnail_public_id = nil
+	delete(m.clearedFields, userphoto.FieldThumbnailPublicID)
+}
+
 // SetOrder sets the "order" field.
 func (m *UserPhotoMutation) SetOrder(i int) {
 	m._order = &i
@@ -6013,13 +12891,25 @@ func (m *UserPhotoMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserPhotoMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 8)
 	if m.photo_url != nil {
 		fields = append(fields, userphoto.FieldPhotoURL)
 	}
 	if m.public_id != nil {
 		fields = append(fields, userphoto.FieldPublicID)
 	}
+	if m.medium_url != nil {
+		fields = append(fields, userphoto.FieldMediumURL)
+	}
+	if m.medium_public_id != nil {
+		fields = append(fields, userphoto.FieldMediumPublicID)
+	}
+	if m.thumbnail_url != nil {
+		fields = append(fields, userphoto.FieldThumbnailURL)
+	}
+	if m.thumbnail_public_id != nil {
+		fields = append(fields, userphoto.FieldThumbnailPublicID)
+	}
 	if m._order != nil {
 		fields = append(fields, userphoto.FieldOrder)
 	}
@@ -6038,6 +12928,14 @@ func (m *UserPhotoMutation) Field(name string) (ent.Value, bool) {
 		return m.PhotoURL()
 	case userphoto.FieldPublicID:
 		return m.PublicID()
+	case userphoto.FieldMediumURL:
+		return m.MediumURL()
+	case userphoto.FieldMediumPublicID:
+		return m.MediumPublicID()
+	case userphoto.FieldThumbnailURL:
+		return m.ThumbnailURL()
+	case userphoto.FieldThumbnailPublicID:
+		return m.ThumbnailPublicID()
 	case userphoto.FieldOrder:
 		return m.Order()
 	case userphoto.FieldUserID:
@@ -6055,6 +12953,14 @@ func (m *UserPhotoMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldPhotoURL(ctx)
 	case userphoto.FieldPublicID:
 		return m.OldPublicID(ctx)
+	case userphoto.FieldMediumURL:
+		return m.OldMediumURL(ctx)
+	case userphoto.FieldMediumPublicID:
+		return m.OldMediumPublicID(ctx)
+	case userphoto.FieldThumbnailURL:
+		return m.OldThumbnailURL(ctx)
+	case userphoto.FieldThumbnailPublicID:
+		return m.OldThumbnailPublicID(ctx)
 	case userphoto.FieldOrder:
 		return m.OldOrder(ctx)
 	case userphoto.FieldUserID:
@@ -6082,6 +12988,34 @@ func (m *UserPhotoMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPublicID(v)
 		return nil
+	case userphoto.FieldMediumURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediumURL(v)
+		return nil
+	case userphoto.FieldMediumPublicID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediumPublicID(v)
+		return nil
+	case userphoto.FieldThumbnailURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailURL(v)
+		return nil
+	case userphoto.FieldThumbnailPublicID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailPublicID(v)
+		return nil
 	case userphoto.FieldOrder:
 		v, ok := value.(int)
 		if !ok {
@@ -6140,7 +13074,20 @@ func (m *UserPhotoMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *UserPhotoMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(userphoto.FieldMediumURL) {
+		fields = append(fields, userphoto.FieldMediumURL)
+	}
+	if m.FieldCleared(userphoto.FieldMediumPublicID) {
+		fields = append(fields, userphoto.FieldMediumPublicID)
+	}
+	if m.FieldCleared(userphoto.FieldThumbnailURL) {
+		fields = append(fields, userphoto.FieldThumbnailURL)
+	}
+	if m.FieldCleared(userphoto.FieldThumbnailPublicID) {
+		fields = append(fields, userphoto.FieldThumbnailPublicID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -6153,6 +13100,20 @@ func (m *UserPhotoMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *UserPhotoMutation) ClearField(name string) error {
+	switch name {
+	case userphoto.FieldMediumURL:
+		m.ClearMediumURL()
+		return nil
+	case userphoto.FieldMediumPublicID:
+		m.ClearMediumPublicID()
+		return nil
+	case userphoto.FieldThumbnailURL:
+		m.ClearThumbnailURL()
+		return nil
+	case userphoto.FieldThumbnailPublicID:
+		m.ClearThumbnailPublicID()
+		return nil
+	}
 	return fmt.Errorf("unknown UserPhoto nullable field %s", name)
 }
 
@@ -6166,6 +13127,18 @@ func (m *UserPhotoMutation) ResetField(name string) error {
 	case userphoto.FieldPublicID:
 		m.ResetPublicID()
 		return nil
+	case userphoto.FieldMediumURL:
+		m.ResetMediumURL()
+		return nil
+	case userphoto.FieldMediumPublicID:
+		m.ResetMediumPublicID()
+		return nil
+	case userphoto.FieldThumbnailURL:
+		m.ResetThumbnailURL()
+		return nil
+	case userphoto.FieldThumbnailPublicID:
+		m.ResetThumbnailPublicID()
+		return nil
 	case userphoto.FieldOrder:
 		m.ResetOrder()
 		return nil
@@ -6,15 +6,39 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BrokerPayload is the predicate function for brokerpayload builders.
+type BrokerPayload func(*sql.Selector)
+
 // Connection is the predicate function for connection builders.
 type Connection func(*sql.Selector)
 
 // ConnectionRequest is the predicate function for connectionrequest builders.
 type ConnectionRequest func(*sql.Selector)
 
+// Event is the predicate function for event builders.
+type Event func(*sql.Selector)
+
+// EventParticipant is the predicate function for eventparticipant builders.
+type EventParticipant func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// MessageEdit is the predicate function for messageedit builders.
+type MessageEdit func(*sql.Selector)
+
+// MessageReaction is the predicate function for messagereaction builders.
+type MessageReaction func(*sql.Selector)
+
+// PresenceLease is the predicate function for presencelease builders.
+type PresenceLease func(*sql.Selector)
+
+// ReadMarker is the predicate function for readmarker builders.
+type ReadMarker func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
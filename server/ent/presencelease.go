@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"match-me/ent/presencelease"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PresenceLease is the model entity for the PresenceLease schema.
+type PresenceLease struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ID of the server node the user is connected to
+	NodeID string `json:"node_id,omitempty"`
+	// ID of the connected user
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Time after which the lease no longer counts unless renewed
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PresenceLease) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case presencelease.FieldNodeID:
+			values[i] = new(sql.NullString)
+		case presencelease.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case presencelease.FieldID, presencelease.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PresenceLease fields.
+func (_m *PresenceLease) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case presencelease.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case presencelease.FieldNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				_m.NodeID = value.String
+			}
+		case presencelease.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case presencelease.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PresenceLease.
+// This includes values selected through modifiers, order, etc.
+func (_m *PresenceLease) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PresenceLease.
+// Note that you need to call PresenceLease.Unwrap() before calling this method if this PresenceLease
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PresenceLease) Update() *PresenceLeaseUpdateOne {
+	return NewPresenceLeaseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PresenceLease entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PresenceLease) Unwrap() *PresenceLease {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PresenceLease is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PresenceLease) String() string {
+	var builder strings.Builder
+	builder.WriteString("PresenceLease(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("node_id=")
+	builder.WriteString(_m.NodeID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PresenceLeases is a parsable slice of PresenceLease.
+type PresenceLeases []*PresenceLease
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"match-me/ent/presencelease"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PresenceLeaseCreate is the builder for creating a PresenceLease entity.
+type PresenceLeaseCreate struct {
+	config
+	mutation *PresenceLeaseMutation
+	hooks    []Hook
+}
+
+// SetNodeID sets the "node_id" field.
+func (_c *PresenceLeaseCreate) SetNodeID(v string) *PresenceLeaseCreate {
+	_c.mutation.SetNodeID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PresenceLeaseCreate) SetUserID(v uuid.UUID) *PresenceLeaseCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PresenceLeaseCreate) SetExpiresAt(v time.Time) *PresenceLeaseCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PresenceLeaseCreate) SetID(v uuid.UUID) *PresenceLeaseCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PresenceLeaseCreate) SetNillableID(v *uuid.UUID) *PresenceLeaseCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PresenceLeaseMutation object of the builder.
+func (_c *PresenceLeaseCreate) Mutation() *PresenceLeaseMutation {
+	return _c.mutation
+}
+
+// Save creates the PresenceLease in the database.
+func (_c *PresenceLeaseCreate) Save(ctx context.Context) (*PresenceLease, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PresenceLeaseCreate) SaveX(ctx context.Context) *PresenceLease {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PresenceLeaseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PresenceLeaseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PresenceLeaseCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := presencelease.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PresenceLeaseCreate) check() error {
+	if _, ok := _c.mutation.NodeID(); !ok {
+		return &ValidationError{Name: "node_id", err: errors.New(`ent: missing required field "PresenceLease.node_id"`)}
+	}
+	if v, ok := _c.mutation.NodeID(); ok {
+		if err := presencelease.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "PresenceLease.node_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PresenceLease.user_id"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "PresenceLease.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *PresenceLeaseCreate) sqlSave(ctx context.Context) (*PresenceLease, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PresenceLeaseCreate) createSpec() (*PresenceLease, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PresenceLease{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(presencelease.Table, sqlgraph.NewFieldSpec(presencelease.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.NodeID(); ok {
+		_spec.SetField(presencelease.FieldNodeID, field.TypeString, value)
+		_node.NodeID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(presencelease.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(presencelease.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// PresenceLeaseCreateBulk is the builder for creating many PresenceLease entities in bulk.
+type PresenceLeaseCreateBulk struct {
+	config
+	err      error
+	builders []*PresenceLeaseCreate
+}
+
+// Save creates the PresenceLease entities in the database.
+func (_c *PresenceLeaseCreateBulk) Save(ctx context.Context) ([]*PresenceLease, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PresenceLease, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PresenceLeaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PresenceLeaseCreateBulk) SaveX(ctx context.Context) []*PresenceLease {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PresenceLeaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PresenceLeaseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"match-me/ent/predicate"
+	"match-me/ent/presencelease"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PresenceLeaseUpdate is the builder for updating PresenceLease entities.
+type PresenceLeaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PresenceLeaseMutation
+}
+
+// Where appends a list predicates to the PresenceLeaseUpdate builder.
+func (_u *PresenceLeaseUpdate) Where(ps ...predicate.PresenceLease) *PresenceLeaseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNodeID sets the "node_id" field.
+func (_u *PresenceLeaseUpdate) SetNodeID(v string) *PresenceLeaseUpdate {
+	_u.mutation.SetNodeID(v)
+	return _u
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (_u *PresenceLeaseUpdate) SetNillableNodeID(v *string) *PresenceLeaseUpdate {
+	if v != nil {
+		_u.SetNodeID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PresenceLeaseUpdate) SetUserID(v uuid.UUID) *PresenceLeaseUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PresenceLeaseUpdate) SetNillableUserID(v *uuid.UUID) *PresenceLeaseUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PresenceLeaseUpdate) SetExpiresAt(v time.Time) *PresenceLeaseUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PresenceLeaseUpdate) SetNillableExpiresAt(v *time.Time) *PresenceLeaseUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PresenceLeaseMutation object of the builder.
+func (_u *PresenceLeaseUpdate) Mutation() *PresenceLeaseMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PresenceLeaseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PresenceLeaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PresenceLeaseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PresenceLeaseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PresenceLeaseUpdate) check() error {
+	if v, ok := _u.mutation.NodeID(); ok {
+		if err := presencelease.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "PresenceLease.node_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PresenceLeaseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(presencelease.Table, presencelease.Columns, sqlgraph.NewFieldSpec(presencelease.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NodeID(); ok {
+		_spec.SetField(presencelease.FieldNodeID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(presencelease.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(presencelease.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{presencelease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PresenceLeaseUpdateOne is the builder for updating a single PresenceLease entity.
+type PresenceLeaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PresenceLeaseMutation
+}
+
+// SetNodeID sets the "node_id" field.
+func (_u *PresenceLeaseUpdateOne) SetNodeID(v string) *PresenceLeaseUpdateOne {
+	_u.mutation.SetNodeID(v)
+	return _u
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (_u *PresenceLeaseUpdateOne) SetNillableNodeID(v *string) *PresenceLeaseUpdateOne {
+	if v != nil {
+		_u.SetNodeID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PresenceLeaseUpdateOne) SetUserID(v uuid.UUID) *PresenceLeaseUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PresenceLeaseUpdateOne) SetNillableUserID(v *uuid.UUID) *PresenceLeaseUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PresenceLeaseUpdateOne) SetExpiresAt(v time.Time) *PresenceLeaseUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PresenceLeaseUpdateOne) SetNillableExpiresAt(v *time.Time) *PresenceLeaseUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PresenceLeaseMutation object of the builder.
+func (_u *PresenceLeaseUpdateOne) Mutation() *PresenceLeaseMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PresenceLeaseUpdate builder.
+func (_u *PresenceLeaseUpdateOne) Where(ps ...predicate.PresenceLease) *PresenceLeaseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PresenceLeaseUpdateOne) Select(field string, fields ...string) *PresenceLeaseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PresenceLease entity.
+func (_u *PresenceLeaseUpdateOne) Save(ctx context.Context) (*PresenceLease, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PresenceLeaseUpdateOne) SaveX(ctx context.Context) *PresenceLease {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PresenceLeaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PresenceLeaseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PresenceLeaseUpdateOne) check() error {
+	if v, ok := _u.mutation.NodeID(); ok {
+		if err := presencelease.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "PresenceLease.node_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PresenceLeaseUpdateOne) sqlSave(ctx context.Context) (_node *PresenceLease, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(presencelease.Table, presencelease.Columns, sqlgraph.NewFieldSpec(presencelease.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PresenceLease.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, presencelease.FieldID)
+		for _, f := range fields {
+			if !presencelease.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != presencelease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NodeID(); ok {
+		_spec.SetField(presencelease.FieldNodeID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(presencelease.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(presencelease.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &PresenceLease{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{presencelease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"match-me/ent/connection"
+	"match-me/ent/readmarker"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ReadMarker is the model entity for the ReadMarker schema.
+type ReadMarker struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ID of the connection the watermark belongs to
+	ConnectionID uuid.UUID `json:"connection_id,omitempty"`
+	// ID of the user who read the messages
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// ID of the newest message read by the user
+	LastReadMessageID uuid.UUID `json:"last_read_message_id,omitempty"`
+	// Creation time of the newest message read, used to compare positions
+	LastReadMessageAt time.Time `json:"last_read_message_at,omitempty"`
+	// Timestamp when the watermark last moved
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReadMarkerQuery when eager-loading is set.
+	Edges        ReadMarkerEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReadMarkerEdges holds the relations/edges for other nodes in the graph.
+type ReadMarkerEdges struct {
+	// Connection holds the value of the connection edge.
+	Connection *Connection `json:"connection,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ConnectionOrErr returns the Connection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReadMarkerEdges) ConnectionOrErr() (*Connection, error) {
+	if e.Connection != nil {
+		return e.Connection, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: connection.Label}
+	}
+	return nil, &NotLoadedError{edge: "connection"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReadMarker) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case readmarker.FieldLastReadMessageAt, readmarker.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case readmarker.FieldID, readmarker.FieldConnectionID, readmarker.FieldUserID, readmarker.FieldLastReadMessageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReadMarker fields.
+func (_m *ReadMarker) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case readmarker.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case readmarker.FieldConnectionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_id", values[i])
+			} else if value != nil {
+				_m.ConnectionID = *value
+			}
+		case readmarker.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case readmarker.FieldLastReadMessageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field last_read_message_id", values[i])
+			} else if value != nil {
+				_m.LastReadMessageID = *value
+			}
+		case readmarker.FieldLastReadMessageAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_read_message_at", values[i])
+			} else if value.Valid {
+				_m.LastReadMessageAt = value.Time
+			}
+		case readmarker.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReadMarker.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReadMarker) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryConnection queries the "connection" edge of the ReadMarker entity.
+func (_m *ReadMarker) QueryConnection() *ConnectionQuery {
+	return NewReadMarkerClient(_m.config).QueryConnection(_m)
+}
+
+// Update returns a builder for updating this ReadMarker.
+// Note that you need to call ReadMarker.Unwrap() before calling this method if this ReadMarker
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReadMarker) Update() *ReadMarkerUpdateOne {
+	return NewReadMarkerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReadMarker entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReadMarker) Unwrap() *ReadMarker {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReadMarker is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReadMarker) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReadMarker(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("connection_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConnectionID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("last_read_message_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastReadMessageID))
+	builder.WriteString(", ")
+	builder.WriteString("last_read_message_at=")
+	builder.WriteString(_m.LastReadMessageAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReadMarkers is a parsable slice of ReadMarker.
+type ReadMarkers []*ReadMarker
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readmarker
+
+import (
+	"match-me/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLTE(FieldID, id))
+}
+
+// ConnectionID applies equality check predicate on the "connection_id" field. It's identical to ConnectionIDEQ.
+func ConnectionID(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldUserID, v))
+}
+
+// LastReadMessageID applies equality check predicate on the "last_read_message_id" field. It's identical to LastReadMessageIDEQ.
+func LastReadMessageID(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageAt applies equality check predicate on the "last_read_message_at" field. It's identical to LastReadMessageAtEQ.
+func LastReadMessageAt(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldLastReadMessageAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ConnectionIDEQ applies the EQ predicate on the "connection_id" field.
+func ConnectionIDEQ(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDNEQ applies the NEQ predicate on the "connection_id" field.
+func ConnectionIDNEQ(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDIn applies the In predicate on the "connection_id" field.
+func ConnectionIDIn(vs ...uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDNotIn applies the NotIn predicate on the "connection_id" field.
+func ConnectionIDNotIn(vs ...uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNotIn(FieldConnectionID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLTE(FieldUserID, v))
+}
+
+// LastReadMessageIDEQ applies the EQ predicate on the "last_read_message_id" field.
+func LastReadMessageIDEQ(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDNEQ applies the NEQ predicate on the "last_read_message_id" field.
+func LastReadMessageIDNEQ(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNEQ(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDIn applies the In predicate on the "last_read_message_id" field.
+func LastReadMessageIDIn(vs ...uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldIn(FieldLastReadMessageID, vs...))
+}
+
+// LastReadMessageIDNotIn applies the NotIn predicate on the "last_read_message_id" field.
+func LastReadMessageIDNotIn(vs ...uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNotIn(FieldLastReadMessageID, vs...))
+}
+
+// LastReadMessageIDGT applies the GT predicate on the "last_read_message_id" field.
+func LastReadMessageIDGT(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGT(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDGTE applies the GTE predicate on the "last_read_message_id" field.
+func LastReadMessageIDGTE(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGTE(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDLT applies the LT predicate on the "last_read_message_id" field.
+func LastReadMessageIDLT(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLT(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDLTE applies the LTE predicate on the "last_read_message_id" field.
+func LastReadMessageIDLTE(v uuid.UUID) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLTE(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageAtEQ applies the EQ predicate on the "last_read_message_at" field.
+func LastReadMessageAtEQ(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldLastReadMessageAt, v))
+}
+
+// LastReadMessageAtNEQ applies the NEQ predicate on the "last_read_message_at" field.
+func LastReadMessageAtNEQ(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNEQ(FieldLastReadMessageAt, v))
+}
+
+// LastReadMessageAtIn applies the In predicate on the "last_read_message_at" field.
+func LastReadMessageAtIn(vs ...time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldIn(FieldLastReadMessageAt, vs...))
+}
+
+// LastReadMessageAtNotIn applies the NotIn predicate on the "last_read_message_at" field.
+func LastReadMessageAtNotIn(vs ...time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNotIn(FieldLastReadMessageAt, vs...))
+}
+
+// LastReadMessageAtGT applies the GT predicate on the "last_read_message_at" field.
+func LastReadMessageAtGT(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGT(FieldLastReadMessageAt, v))
+}
+
+// LastReadMessageAtGTE applies the GTE predicate on the "last_read_message_at" field.
+func LastReadMessageAtGTE(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGTE(FieldLastReadMessageAt, v))
+}
+
+// LastReadMessageAtLT applies the LT predicate on the "last_read_message_at" field.
+func LastReadMessageAtLT(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLT(FieldLastReadMessageAt, v))
+}
+
+// LastReadMessageAtLTE applies the LTE predicate on the "last_read_message_at" field.
+func LastReadMessageAtLTE(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLTE(FieldLastReadMessageAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasConnection applies the HasEdge predicate on the "connection" edge.
+func HasConnection() predicate.ReadMarker {
+	return predicate.ReadMarker(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConnectionTable, ConnectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConnectionWith applies the HasEdge predicate on the "connection" edge with a given conditions (other predicates).
+func HasConnectionWith(preds ...predicate.Connection) predicate.ReadMarker {
+	return predicate.ReadMarker(func(s *sql.Selector) {
+		step := newConnectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReadMarker) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReadMarker) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReadMarker) predicate.ReadMarker {
+	return predicate.ReadMarker(sql.NotPredicates(p))
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"match-me/ent/connection"
+	"match-me/ent/readmarker"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReadMarkerCreate is the builder for creating a ReadMarker entity.
+type ReadMarkerCreate struct {
+	config
+	mutation *ReadMarkerMutation
+	hooks    []Hook
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_c *ReadMarkerCreate) SetConnectionID(v uuid.UUID) *ReadMarkerCreate {
+	_c.mutation.SetConnectionID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ReadMarkerCreate) SetUserID(v uuid.UUID) *ReadMarkerCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (_c *ReadMarkerCreate) SetLastReadMessageID(v uuid.UUID) *ReadMarkerCreate {
+	_c.mutation.SetLastReadMessageID(v)
+	return _c
+}
+
+// SetLastReadMessageAt sets the "last_read_message_at" field.
+func (_c *ReadMarkerCreate) SetLastReadMessageAt(v time.Time) *ReadMarkerCreate {
+	_c.mutation.SetLastReadMessageAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReadMarkerCreate) SetUpdatedAt(v time.Time) *ReadMarkerCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReadMarkerCreate) SetNillableUpdatedAt(v *time.Time) *ReadMarkerCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReadMarkerCreate) SetID(v uuid.UUID) *ReadMarkerCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReadMarkerCreate) SetNillableID(v *uuid.UUID) *ReadMarkerCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_c *ReadMarkerCreate) SetConnection(v *Connection) *ReadMarkerCreate {
+	return _c.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ReadMarkerMutation object of the builder.
+func (_c *ReadMarkerCreate) Mutation() *ReadMarkerMutation {
+	return _c.mutation
+}
+
+// Save creates the ReadMarker in the database.
+func (_c *ReadMarkerCreate) Save(ctx context.Context) (*ReadMarker, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReadMarkerCreate) SaveX(ctx context.Context) *ReadMarker {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadMarkerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadMarkerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReadMarkerCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := readmarker.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := readmarker.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReadMarkerCreate) check() error {
+	if _, ok := _c.mutation.ConnectionID(); !ok {
+		return &ValidationError{Name: "connection_id", err: errors.New(`ent: missing required field "ReadMarker.connection_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ReadMarker.user_id"`)}
+	}
+	if _, ok := _c.mutation.LastReadMessageID(); !ok {
+		return &ValidationError{Name: "last_read_message_id", err: errors.New(`ent: missing required field "ReadMarker.last_read_message_id"`)}
+	}
+	if _, ok := _c.mutation.LastReadMessageAt(); !ok {
+		return &ValidationError{Name: "last_read_message_at", err: errors.New(`ent: missing required field "ReadMarker.last_read_message_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReadMarker.updated_at"`)}
+	}
+	if len(_c.mutation.ConnectionIDs()) == 0 {
+		return &ValidationError{Name: "connection", err: errors.New(`ent: missing required edge "ReadMarker.connection"`)}
+	}
+	return nil
+}
+
+func (_c *ReadMarkerCreate) sqlSave(ctx context.Context) (*ReadMarker, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReadMarkerCreate) createSpec() (*ReadMarker, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReadMarker{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(readmarker.Table, sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(readmarker.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.LastReadMessageID(); ok {
+		_spec.SetField(readmarker.FieldLastReadMessageID, field.TypeUUID, value)
+		_node.LastReadMessageID = value
+	}
+	if value, ok := _c.mutation.LastReadMessageAt(); ok {
+		_spec.SetField(readmarker.FieldLastReadMessageAt, field.TypeTime, value)
+		_node.LastReadMessageAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(readmarker.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readmarker.ConnectionTable,
+			Columns: []string{readmarker.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConnectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReadMarkerCreateBulk is the builder for creating many ReadMarker entities in bulk.
+type ReadMarkerCreateBulk struct {
+	config
+	err      error
+	builders []*ReadMarkerCreate
+}
+
+// Save creates the ReadMarker entities in the database.
+func (_c *ReadMarkerCreateBulk) Save(ctx context.Context) ([]*ReadMarker, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReadMarker, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReadMarkerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReadMarkerCreateBulk) SaveX(ctx context.Context) []*ReadMarker {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadMarkerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadMarkerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
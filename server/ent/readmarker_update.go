@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"match-me/ent/connection"
+	"match-me/ent/predicate"
+	"match-me/ent/readmarker"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReadMarkerUpdate is the builder for updating ReadMarker entities.
+type ReadMarkerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReadMarkerMutation
+}
+
+// Where appends a list predicates to the ReadMarkerUpdate builder.
+func (_u *ReadMarkerUpdate) Where(ps ...predicate.ReadMarker) *ReadMarkerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *ReadMarkerUpdate) SetConnectionID(v uuid.UUID) *ReadMarkerUpdate {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *ReadMarkerUpdate) SetNillableConnectionID(v *uuid.UUID) *ReadMarkerUpdate {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ReadMarkerUpdate) SetUserID(v uuid.UUID) *ReadMarkerUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ReadMarkerUpdate) SetNillableUserID(v *uuid.UUID) *ReadMarkerUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (_u *ReadMarkerUpdate) SetLastReadMessageID(v uuid.UUID) *ReadMarkerUpdate {
+	_u.mutation.SetLastReadMessageID(v)
+	return _u
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (_u *ReadMarkerUpdate) SetNillableLastReadMessageID(v *uuid.UUID) *ReadMarkerUpdate {
+	if v != nil {
+		_u.SetLastReadMessageID(*v)
+	}
+	return _u
+}
+
+// SetLastReadMessageAt sets the "last_read_message_at" field.
+func (_u *ReadMarkerUpdate) SetLastReadMessageAt(v time.Time) *ReadMarkerUpdate {
+	_u.mutation.SetLastReadMessageAt(v)
+	return _u
+}
+
+// SetNillableLastReadMessageAt sets the "last_read_message_at" field if the given value is not nil.
+func (_u *ReadMarkerUpdate) SetNillableLastReadMessageAt(v *time.Time) *ReadMarkerUpdate {
+	if v != nil {
+		_u.SetLastReadMessageAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReadMarkerUpdate) SetUpdatedAt(v time.Time) *ReadMarkerUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_u *ReadMarkerUpdate) SetConnection(v *Connection) *ReadMarkerUpdate {
+	return _u.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ReadMarkerMutation object of the builder.
+func (_u *ReadMarkerUpdate) Mutation() *ReadMarkerMutation {
+	return _u.mutation
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (_u *ReadMarkerUpdate) ClearConnection() *ReadMarkerUpdate {
+	_u.mutation.ClearConnection()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReadMarkerUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadMarkerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReadMarkerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadMarkerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReadMarkerUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := readmarker.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReadMarkerUpdate) check() error {
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadMarker.connection"`)
+	}
+	return nil
+}
+
+func (_u *ReadMarkerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readmarker.Table, readmarker.Columns, sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(readmarker.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LastReadMessageID(); ok {
+		_spec.SetField(readmarker.FieldLastReadMessageID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LastReadMessageAt(); ok {
+		_spec.SetField(readmarker.FieldLastReadMessageAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(readmarker.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readmarker.ConnectionTable,
+			Columns: []string{readmarker.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readmarker.ConnectionTable,
+			Columns: []string{readmarker.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readmarker.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReadMarkerUpdateOne is the builder for updating a single ReadMarker entity.
+type ReadMarkerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReadMarkerMutation
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *ReadMarkerUpdateOne) SetConnectionID(v uuid.UUID) *ReadMarkerUpdateOne {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *ReadMarkerUpdateOne) SetNillableConnectionID(v *uuid.UUID) *ReadMarkerUpdateOne {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ReadMarkerUpdateOne) SetUserID(v uuid.UUID) *ReadMarkerUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ReadMarkerUpdateOne) SetNillableUserID(v *uuid.UUID) *ReadMarkerUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (_u *ReadMarkerUpdateOne) SetLastReadMessageID(v uuid.UUID) *ReadMarkerUpdateOne {
+	_u.mutation.SetLastReadMessageID(v)
+	return _u
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (_u *ReadMarkerUpdateOne) SetNillableLastReadMessageID(v *uuid.UUID) *ReadMarkerUpdateOne {
+	if v != nil {
+		_u.SetLastReadMessageID(*v)
+	}
+	return _u
+}
+
+// SetLastReadMessageAt sets the "last_read_message_at" field.
+func (_u *ReadMarkerUpdateOne) SetLastReadMessageAt(v time.Time) *ReadMarkerUpdateOne {
+	_u.mutation.SetLastReadMessageAt(v)
+	return _u
+}
+
+// SetNillableLastReadMessageAt sets the "last_read_message_at" field if the given value is not nil.
+func (_u *ReadMarkerUpdateOne) SetNillableLastReadMessageAt(v *time.Time) *ReadMarkerUpdateOne {
+	if v != nil {
+		_u.SetLastReadMessageAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReadMarkerUpdateOne) SetUpdatedAt(v time.Time) *ReadMarkerUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_u *ReadMarkerUpdateOne) SetConnection(v *Connection) *ReadMarkerUpdateOne {
+	return _u.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ReadMarkerMutation object of the builder.
+func (_u *ReadMarkerUpdateOne) Mutation() *ReadMarkerMutation {
+	return _u.mutation
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (_u *ReadMarkerUpdateOne) ClearConnection() *ReadMarkerUpdateOne {
+	_u.mutation.ClearConnection()
+	return _u
+}
+
+// Where appends a list predicates to the ReadMarkerUpdate builder.
+func (_u *ReadMarkerUpdateOne) Where(ps ...predicate.ReadMarker) *ReadMarkerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReadMarkerUpdateOne) Select(field string, fields ...string) *ReadMarkerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReadMarker entity.
+func (_u *ReadMarkerUpdateOne) Save(ctx context.Context) (*ReadMarker, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadMarkerUpdateOne) SaveX(ctx context.Context) *ReadMarker {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReadMarkerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadMarkerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReadMarkerUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := readmarker.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReadMarkerUpdateOne) check() error {
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadMarker.connection"`)
+	}
+	return nil
+}
+
+func (_u *ReadMarkerUpdateOne) sqlSave(ctx context.Context) (_node *ReadMarker, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readmarker.Table, readmarker.Columns, sqlgraph.NewFieldSpec(readmarker.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReadMarker.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, readmarker.FieldID)
+		for _, f := range fields {
+			if !readmarker.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != readmarker.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(readmarker.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LastReadMessageID(); ok {
+		_spec.SetField(readmarker.FieldLastReadMessageID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LastReadMessageAt(); ok {
+		_spec.SetField(readmarker.FieldLastReadMessageAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(readmarker.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readmarker.ConnectionTable,
+			Columns: []string{readmarker.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readmarker.ConnectionTable,
+			Columns: []string{readmarker.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReadMarker{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readmarker.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,10 +3,18 @@
 package ent
 
 import (
+	"match-me/ent/brokerpayload"
 	"match-me/ent/connection"
 	"match-me/ent/connectionrequest"
+	"match-me/ent/event"
+	"match-me/ent/eventparticipant"
 	"match-me/ent/message"
+	"match-me/ent/messageedit"
+	"match-me/ent/messagereaction"
+	"match-me/ent/presencelease"
+	"match-me/ent/readmarker"
 	"match-me/ent/schema"
+	"match-me/ent/session"
 	"match-me/ent/user"
 	"match-me/ent/userinteraction"
 	"match-me/ent/userphoto"
@@ -19,6 +27,16 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	brokerpayloadFields := schema.BrokerPayload{}.Fields()
+	_ = brokerpayloadFields
+	// brokerpayloadDescCreatedAt is the schema descriptor for created_at field.
+	brokerpayloadDescCreatedAt := brokerpayloadFields[2].Descriptor()
+	// brokerpayload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	brokerpayload.DefaultCreatedAt = brokerpayloadDescCreatedAt.Default.(func() time.Time)
+	// brokerpayloadDescID is the schema descriptor for id field.
+	brokerpayloadDescID := brokerpayloadFields[0].Descriptor()
+	// brokerpayload.DefaultID holds the default value on creation for the id field.
+	brokerpayload.DefaultID = brokerpayloadDescID.Default.(func() uuid.UUID)
 	connectionFields := schema.Connection{}.Fields()
 	_ = connectionFields
 	// connectionDescConnectedAt is the schema descriptor for connected_at field.
@@ -55,30 +73,192 @@ func init() {
 	connectionrequestDescID := connectionrequestFields[0].Descriptor()
 	// connectionrequest.DefaultID holds the default value on creation for the id field.
 	connectionrequest.DefaultID = connectionrequestDescID.Default.(func() uuid.UUID)
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescTitle is the schema descriptor for title field.
+	eventDescTitle := eventFields[2].Descriptor()
+	// event.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	event.TitleValidator = func() func(string) error {
+		validators := eventDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// eventDescDescription is the schema descriptor for description field.
+	eventDescDescription := eventFields[3].Descriptor()
+	// event.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	event.DescriptionValidator = eventDescDescription.Validators[0].(func(string) error)
+	// eventDescEventType is the schema descriptor for event_type field.
+	eventDescEventType := eventFields[4].Descriptor()
+	// event.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	event.EventTypeValidator = eventDescEventType.Validators[0].(func(string) error)
+	// eventDescLocationName is the schema descriptor for location_name field.
+	eventDescLocationName := eventFields[6].Descriptor()
+	// event.LocationNameValidator is a validator for the "location_name" field. It is called by the builders before save.
+	event.LocationNameValidator = eventDescLocationName.Validators[0].(func(string) error)
+	// eventDescMaxParticipants is the schema descriptor for max_participants field.
+	eventDescMaxParticipants := eventFields[7].Descriptor()
+	// event.MaxParticipantsValidator is a validator for the "max_participants" field. It is called by the builders before save.
+	event.MaxParticipantsValidator = eventDescMaxParticipants.Validators[0].(func(int) error)
+	// eventDescCurrentParticipants is the schema descriptor for current_participants field.
+	eventDescCurrentParticipants := eventFields[8].Descriptor()
+	// event.DefaultCurrentParticipants holds the default value on creation for the current_participants field.
+	event.DefaultCurrentParticipants = eventDescCurrentParticipants.Default.(int)
+	// event.CurrentParticipantsValidator is a validator for the "current_participants" field. It is called by the builders before save.
+	event.CurrentParticipantsValidator = eventDescCurrentParticipants.Validators[0].(func(int) error)
+	// eventDescIsActive is the schema descriptor for is_active field.
+	eventDescIsActive := eventFields[11].Descriptor()
+	// event.DefaultIsActive holds the default value on creation for the is_active field.
+	event.DefaultIsActive = eventDescIsActive.Default.(bool)
+	// eventDescCreatedAt is the schema descriptor for created_at field.
+	eventDescCreatedAt := eventFields[12].Descriptor()
+	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
+	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() time.Time)
+	// eventDescUpdatedAt is the schema descriptor for updated_at field.
+	eventDescUpdatedAt := eventFields[13].Descriptor()
+	// event.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	event.DefaultUpdatedAt = eventDescUpdatedAt.Default.(func() time.Time)
+	// event.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	event.UpdateDefaultUpdatedAt = eventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// eventDescID is the schema descriptor for id field.
+	eventDescID := eventFields[0].Descriptor()
+	// event.DefaultID holds the default value on creation for the id field.
+	event.DefaultID = eventDescID.Default.(func() uuid.UUID)
+	eventparticipantFields := schema.EventParticipant{}.Fields()
+	_ = eventparticipantFields
+	// eventparticipantDescJoinedAt is the schema descriptor for joined_at field.
+	eventparticipantDescJoinedAt := eventparticipantFields[4].Descriptor()
+	// eventparticipant.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	eventparticipant.DefaultJoinedAt = eventparticipantDescJoinedAt.Default.(func() time.Time)
+	// eventparticipantDescUpdatedAt is the schema descriptor for updated_at field.
+	eventparticipantDescUpdatedAt := eventparticipantFields[5].Descriptor()
+	// eventparticipant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventparticipant.DefaultUpdatedAt = eventparticipantDescUpdatedAt.Default.(func() time.Time)
+	// eventparticipant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventparticipant.UpdateDefaultUpdatedAt = eventparticipantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// eventparticipantDescID is the schema descriptor for id field.
+	eventparticipantDescID := eventparticipantFields[0].Descriptor()
+	// eventparticipant.DefaultID holds the default value on creation for the id field.
+	eventparticipant.DefaultID = eventparticipantDescID.Default.(func() uuid.UUID)
 	messageFields := schema.Message{}.Fields()
 	_ = messageFields
+	// messageDescClientMessageID is the schema descriptor for client_message_id field.
+	messageDescClientMessageID := messageFields[4].Descriptor()
+	// message.ClientMessageIDValidator is a validator for the "client_message_id" field. It is called by the builders before save.
+	message.ClientMessageIDValidator = messageDescClientMessageID.Validators[0].(func(string) error)
 	// messageDescIsRead is the schema descriptor for is_read field.
-	messageDescIsRead := messageFields[9].Descriptor()
+	messageDescIsRead := messageFields[11].Descriptor()
 	// message.DefaultIsRead holds the default value on creation for the is_read field.
 	message.DefaultIsRead = messageDescIsRead.Default.(bool)
 	// messageDescCreatedAt is the schema descriptor for created_at field.
-	messageDescCreatedAt := messageFields[10].Descriptor()
+	messageDescCreatedAt := messageFields[12].Descriptor()
 	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
 	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
 	// messageDescUpdatedAt is the schema descriptor for updated_at field.
-	messageDescUpdatedAt := messageFields[11].Descriptor()
+	messageDescUpdatedAt := messageFields[13].Descriptor()
 	// message.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	message.DefaultUpdatedAt = messageDescUpdatedAt.Default.(func() time.Time)
 	// message.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	message.UpdateDefaultUpdatedAt = messageDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// messageDescIsDeleted is the schema descriptor for is_deleted field.
-	messageDescIsDeleted := messageFields[13].Descriptor()
+	messageDescIsDeleted := messageFields[17].Descriptor()
 	// message.DefaultIsDeleted holds the default value on creation for the is_deleted field.
 	message.DefaultIsDeleted = messageDescIsDeleted.Default.(bool)
 	// messageDescID is the schema descriptor for id field.
 	messageDescID := messageFields[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
 	message.DefaultID = messageDescID.Default.(func() uuid.UUID)
+	messageeditFields := schema.MessageEdit{}.Fields()
+	_ = messageeditFields
+	// messageeditDescEditedAt is the schema descriptor for edited_at field.
+	messageeditDescEditedAt := messageeditFields[3].Descriptor()
+	// messageedit.DefaultEditedAt holds the default value on creation for the edited_at field.
+	messageedit.DefaultEditedAt = messageeditDescEditedAt.Default.(func() time.Time)
+	// messageeditDescID is the schema descriptor for id field.
+	messageeditDescID := messageeditFields[0].Descriptor()
+	// messageedit.DefaultID holds the default value on creation for the id field.
+	messageedit.DefaultID = messageeditDescID.Default.(func() uuid.UUID)
+	messagereactionFields := schema.MessageReaction{}.Fields()
+	_ = messagereactionFields
+	// messagereactionDescEmoji is the schema descriptor for emoji field.
+	messagereactionDescEmoji := messagereactionFields[3].Descriptor()
+	// messagereaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	messagereaction.EmojiValidator = func() func(string) error {
+		validators := messagereactionDescEmoji.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(emoji string) error {
+			for _, fn := range fns {
+				if err := fn(emoji); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// messagereactionDescCreatedAt is the schema descriptor for created_at field.
+	messagereactionDescCreatedAt := messagereactionFields[4].Descriptor()
+	// messagereaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagereaction.DefaultCreatedAt = messagereactionDescCreatedAt.Default.(func() time.Time)
+	// messagereactionDescID is the schema descriptor for id field.
+	messagereactionDescID := messagereactionFields[0].Descriptor()
+	// messagereaction.DefaultID holds the default value on creation for the id field.
+	messagereaction.DefaultID = messagereactionDescID.Default.(func() uuid.UUID)
+	presenceleaseFields := schema.PresenceLease{}.Fields()
+	_ = presenceleaseFields
+	// presenceleaseDescNodeID is the schema descriptor for node_id field.
+	presenceleaseDescNodeID := presenceleaseFields[1].Descriptor()
+	// presencelease.NodeIDValidator is a validator for the "node_id" field. It is called by the builders before save.
+	presencelease.NodeIDValidator = presenceleaseDescNodeID.Validators[0].(func(string) error)
+	// presenceleaseDescID is the schema descriptor for id field.
+	presenceleaseDescID := presenceleaseFields[0].Descriptor()
+	// presencelease.DefaultID holds the default value on creation for the id field.
+	presencelease.DefaultID = presenceleaseDescID.Default.(func() uuid.UUID)
+	readmarkerFields := schema.ReadMarker{}.Fields()
+	_ = readmarkerFields
+	// readmarkerDescUpdatedAt is the schema descriptor for updated_at field.
+	readmarkerDescUpdatedAt := readmarkerFields[5].Descriptor()
+	// readmarker.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	readmarker.DefaultUpdatedAt = readmarkerDescUpdatedAt.Default.(func() time.Time)
+	// readmarker.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	readmarker.UpdateDefaultUpdatedAt = readmarkerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// readmarkerDescID is the schema descriptor for id field.
+	readmarkerDescID := readmarkerFields[0].Descriptor()
+	// readmarker.DefaultID holds the default value on creation for the id field.
+	readmarker.DefaultID = readmarkerDescID.Default.(func() uuid.UUID)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
+	sessionDescRefreshTokenHash := sessionFields[2].Descriptor()
+	// session.RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	session.RefreshTokenHashValidator = sessionDescRefreshTokenHash.Validators[0].(func(string) error)
+	// sessionDescDevice is the schema descriptor for device field.
+	sessionDescDevice := sessionFields[3].Descriptor()
+	// session.DeviceValidator is a validator for the "device" field. It is called by the builders before save.
+	session.DeviceValidator = sessionDescDevice.Validators[0].(func(string) error)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[6].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	sessionDescLastUsedAt := sessionFields[7].Descriptor()
+	// session.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	session.DefaultLastUsedAt = sessionDescLastUsedAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -152,7 +332,7 @@ func init() {
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// userDescAge is the schema descriptor for age field.
-	userDescAge := userFields[8].Descriptor()
+	userDescAge := userFields[10].Descriptor()
 	// user.AgeValidator is a validator for the "age" field. It is called by the builders before save.
 	user.AgeValidator = func() func(int) error {
 		validators := userDescAge.Validators
@@ -170,7 +350,7 @@ func init() {
 		}
 	}()
 	// userDescPreferredAgeMin is the schema descriptor for preferred_age_min field.
-	userDescPreferredAgeMin := userFields[9].Descriptor()
+	userDescPreferredAgeMin := userFields[11].Descriptor()
 	// user.PreferredAgeMinValidator is a validator for the "preferred_age_min" field. It is called by the builders before save.
 	user.PreferredAgeMinValidator = func() func(int) error {
 		validators := userDescPreferredAgeMin.Validators
@@ -188,7 +368,7 @@ func init() {
 		}
 	}()
 	// userDescPreferredAgeMax is the schema descriptor for preferred_age_max field.
-	userDescPreferredAgeMax := userFields[10].Descriptor()
+	userDescPreferredAgeMax := userFields[12].Descriptor()
 	// user.PreferredAgeMaxValidator is a validator for the "preferred_age_max" field. It is called by the builders before save.
 	user.PreferredAgeMaxValidator = func() func(int) error {
 		validators := userDescPreferredAgeMax.Validators
@@ -206,7 +386,7 @@ func init() {
 		}
 	}()
 	// userDescProfileCompletion is the schema descriptor for profile_completion field.
-	userDescProfileCompletion := userFields[11].Descriptor()
+	userDescProfileCompletion := userFields[13].Descriptor()
 	// user.ProfileCompletionValidator is a validator for the "profile_completion" field. It is called by the builders before save.
 	user.ProfileCompletionValidator = func() func(int) error {
 		validators := userDescProfileCompletion.Validators
@@ -224,7 +404,7 @@ func init() {
 		}
 	}()
 	// userDescPreferredDistance is the schema descriptor for preferred_distance field.
-	userDescPreferredDistance := userFields[15].Descriptor()
+	userDescPreferredDistance := userFields[17].Descriptor()
 	// user.PreferredDistanceValidator is a validator for the "preferred_distance" field. It is called by the builders before save.
 	user.PreferredDistanceValidator = func() func(int) error {
 		validators := userDescPreferredDistance.Validators
@@ -241,6 +421,14 @@ func init() {
 			return nil
 		}
 	}()
+	// userDescShowLastSeen is the schema descriptor for show_last_seen field.
+	userDescShowLastSeen := userFields[25].Descriptor()
+	// user.DefaultShowLastSeen holds the default value on creation for the show_last_seen field.
+	user.DefaultShowLastSeen = userDescShowLastSeen.Default.(bool)
+	// userDescStatusMessage is the schema descriptor for status_message field.
+	userDescStatusMessage := userFields[27].Descriptor()
+	// user.StatusMessageValidator is a validator for the "status_message" field. It is called by the builders before save.
+	user.StatusMessageValidator = userDescStatusMessage.Validators[0].(func(string) error)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
@@ -262,7 +450,7 @@ func init() {
 	// userphoto.PhotoURLValidator is a validator for the "photo_url" field. It is called by the builders before save.
 	userphoto.PhotoURLValidator = userphotoDescPhotoURL.Validators[0].(func(string) error)
 	// userphotoDescOrder is the schema descriptor for order field.
-	userphotoDescOrder := userphotoFields[3].Descriptor()
+	userphotoDescOrder := userphotoFields[7].Descriptor()
 	// userphoto.OrderValidator is a validator for the "order" field. It is called by the builders before save.
 	userphoto.OrderValidator = userphotoDescOrder.Validators[0].(func(int) error)
 	// userphotoDescID is the schema descriptor for id field.
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BrokerPayload holds the schema definition for a WebSocket event too large for a
+// Postgres NOTIFY. The notification carries only the row ID and every node loads the
+// payload from here; rows are pruned once they expire.
+type BrokerPayload struct {
+	ent.Schema
+}
+
+func (BrokerPayload) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+
+		field.Bytes("payload").
+			Immutable().
+			Comment("Published event as sent to the subscribers"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("expires_at").
+			Immutable().
+			Comment("Time after which no node needs the payload anymore"),
+	}
+}
+
+// Indexes of the BrokerPayload.
+func (BrokerPayload) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for pruning expired payloads
+		index.Fields("expires_at"),
+	}
+}
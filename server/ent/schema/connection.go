@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -60,6 +61,12 @@ func (Connection) Edges() []ent.Edge {
 			Required().
 			Field("user_b_id").
 			Comment("Reference to the second user in the connection"),
+
+		edge.To("read_markers", ReadMarker.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			).
+			Comment("Read watermarks of the users in the connection"),
 	}
 }
 
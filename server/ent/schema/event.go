@@ -0,0 +1,121 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Event holds the schema definition for a local event users can discover nearby and RSVP to.
+type Event struct {
+	ent.Schema
+}
+
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+
+		field.UUID("creator_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the user who created the event"),
+
+		field.String("title").
+			NotEmpty().
+			MaxLen(120).
+			Comment("Title of the event"),
+
+		field.Text("description").
+			Optional().
+			MaxLen(2000).
+			Comment("What the event is about"),
+
+		field.String("event_type").
+			Optional().
+			MaxLen(50).
+			Comment("Kind of event, such as hike or dinner"),
+
+		field.Other("location", &Point{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "geography(POINT, 4326)",
+			}).
+			Comment("Where the event takes place"),
+
+		field.String("location_name").
+			Optional().
+			MaxLen(200).
+			Comment("Human readable name of the place"),
+
+		field.Int("max_participants").
+			Optional().
+			NonNegative().
+			Comment("Most confirmed participants the event takes; 0 means no limit"),
+
+		field.Int("current_participants").
+			Default(0).
+			NonNegative().
+			Comment("Number of confirmed participants, kept in step with RSVPs"),
+
+		field.Time("start_time").
+			Comment("When the event starts"),
+
+		field.Time("end_time").
+			Optional().
+			Comment("When the event ends (if known)"),
+
+		field.Bool("is_active").
+			Default(true).
+			Comment("False once the creator cancelled the event"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the event was created"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the event was last updated"),
+	}
+}
+
+// Edges of the Event.
+func (Event) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("creator", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("creator_id").
+			Comment("Reference to the user who created the event"),
+
+		edge.To("participants", EventParticipant.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			).
+			Comment("RSVPs of the users taking part"),
+	}
+}
+
+// Indexes of the Event.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		// Spatial index for nearby discovery
+		index.Fields("location").
+			Annotations(entsql.IndexType("GIST")),
+
+		// Index for finding events by creator
+		index.Fields("creator_id"),
+
+		// Index for listing upcoming events
+		index.Fields("is_active", "start_time"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EventParticipant holds the schema definition for a user's RSVP to an event.
+// Only confirmed participants count towards the event's capacity.
+type EventParticipant struct {
+	ent.Schema
+}
+
+func (EventParticipant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+
+		field.UUID("event_id", uuid.UUID{}).
+			Comment("ID of the event"),
+
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("ID of the user who responded"),
+
+		field.Enum("status").
+			Values("interested", "confirmed", "declined").
+			Comment("RSVP of the user"),
+
+		field.Time("joined_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the user first responded"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the RSVP last changed"),
+	}
+}
+
+// Edges of the EventParticipant.
+func (EventParticipant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", Event.Type).
+			Ref("participants").
+			Field("event_id").
+			Required().
+			Unique(),
+
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id").
+			Comment("Reference to the participating user"),
+	}
+}
+
+// Indexes of the EventParticipant.
+func (EventParticipant) Indexes() []ent.Index {
+	return []ent.Index{
+		// One RSVP per user and event
+		index.Fields("event_id", "user_id").
+			Unique(),
+
+		// Index for finding the events of a user
+		index.Fields("user_id", "status"),
+	}
+}
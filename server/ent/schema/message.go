@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -30,6 +31,19 @@ func (Message) Fields() []ent.Field {
 		field.UUID("receiver_id", uuid.UUID{}).
 			Comment("ID of the user who received the message"),
 
+		field.String("client_message_id").
+			Optional().
+			Nillable().
+			Immutable().
+			MaxLen(64).
+			Comment("Idempotency key chosen by the sending client to deduplicate resends"),
+
+		field.UUID("reply_to_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the earlier message this message replies to"),
+
 		field.Enum("type").
 			Values("text", "media", "mixed").
 			Default("text").
@@ -69,6 +83,14 @@ func (Message) Fields() []ent.Field {
 			Optional().
 			Comment("Timestamp when the message was read by the receiver"),
 
+		field.Time("delivered_at").
+			Optional().
+			Comment("Timestamp when the message reached one of the receiver's clients"),
+
+		field.Time("edited_at").
+			Optional().
+			Comment("Timestamp when the message content was last edited"),
+
 		field.Bool("is_deleted").
 			Default(false).
 			Comment("Soft delete flag for the message"),
@@ -99,6 +121,28 @@ func (Message) Edges() []ent.Edge {
 			Required().
 			Field("receiver_id").
 			Comment("Reference to the user who received the message"),
+
+		edge.To("edits", MessageEdit.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			).
+			Comment("Previous versions of the message content"),
+
+		edge.To("reactions", MessageReaction.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			).
+			Comment("Emoji reactions to the message"),
+
+		edge.To("replies", Message.Type).
+			From("reply_to").
+			Unique().
+			Field("reply_to_id").
+			Immutable().
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+			).
+			Comment("The message this message replies to and the replies it received"),
 	}
 }
 
@@ -117,6 +161,16 @@ func (Message) Indexes() []ent.Index {
 		// Index for finding unread messages
 		index.Fields("receiver_id", "is_read"),
 
+		// Index for finding undelivered messages when the receiver reconnects
+		index.Fields("receiver_id", "delivered_at"),
+
+		// Each client idempotency key is used once per sender
+		index.Fields("sender_id", "client_message_id").
+			Unique(),
+
+		// Index for finding the replies to a message
+		index.Fields("reply_to_id", "created_at"),
+
 		// Index for finding messages by connection and timestamp (for pagination)
 		index.Fields("connection_id", "created_at"),
 
@@ -140,3 +194,23 @@ func (Message) Indexes() []ent.Index {
 		index.Fields("type", "media_type"),
 	}
 }
+
+// MessageSearchConfig is the text search configuration of the message search vector.
+// Search queries must use the same configuration for the index to apply.
+const MessageSearchConfig = "simple"
+
+// MessageSearchVector represents the generated tsvector column and GIN index backing
+// message search. Ent cannot declare generated columns, so it is applied after migration.
+type MessageSearchVector struct{}
+
+// Name returns the migration name.
+func (MessageSearchVector) Name() string {
+	return "create_message_search_vector"
+}
+
+// SQL returns the SQL statements creating the search column and its index.
+func (MessageSearchVector) SQL() string {
+	return `ALTER TABLE messages ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (to_tsvector('` + MessageSearchConfig + `', coalesce(content, ''))) STORED;
+		CREATE INDEX IF NOT EXISTS message_search_vector ON messages USING GIN (search_vector)`
+}
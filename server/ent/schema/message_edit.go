@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MessageEdit holds the schema definition for a message's edit history.
+// Every edit stores the content the message had before it was replaced.
+type MessageEdit struct {
+	ent.Schema
+}
+
+func (MessageEdit) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+
+		field.UUID("message_id", uuid.UUID{}).
+			Comment("ID of the edited message"),
+
+		field.Text("previous_content").
+			Optional().
+			Immutable().
+			Comment("Content of the message before this edit"),
+
+		field.Time("edited_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the edit was made"),
+	}
+}
+
+// Edges of the MessageEdit.
+func (MessageEdit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).
+			Ref("edits").
+			Field("message_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the MessageEdit.
+func (MessageEdit) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for listing a message's history in order
+		index.Fields("message_id", "edited_at"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MessageReaction holds the schema definition for an emoji reaction to a message.
+// A user can react to a message with several emojis, but with each emoji only once.
+type MessageReaction struct {
+	ent.Schema
+}
+
+func (MessageReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+
+		field.UUID("message_id", uuid.UUID{}).
+			Comment("ID of the message reacted to"),
+
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("ID of the user who reacted"),
+
+		field.String("emoji").
+			NotEmpty().
+			MaxLen(32).
+			Comment("The reaction emoji"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the reaction was added"),
+	}
+}
+
+// Edges of the MessageReaction.
+func (MessageReaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).
+			Ref("reactions").
+			Field("message_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the MessageReaction.
+func (MessageReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		// Each user can use each emoji once per message
+		index.Fields("message_id", "user_id", "emoji").
+			Unique(),
+	}
+}
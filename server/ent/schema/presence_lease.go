@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PresenceLease holds the schema definition for a user's presence on one server node.
+// Every node renews the leases of its connected users on a heartbeat; a user is online
+// while any node holds an unexpired lease for them.
+type PresenceLease struct {
+	ent.Schema
+}
+
+func (PresenceLease) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+
+		field.String("node_id").
+			NotEmpty().
+			Comment("ID of the server node the user is connected to"),
+
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("ID of the connected user"),
+
+		field.Time("expires_at").
+			Comment("Time after which the lease no longer counts unless renewed"),
+	}
+}
+
+// Indexes of the PresenceLease.
+func (PresenceLease) Indexes() []ent.Index {
+	return []ent.Index{
+		// One lease per user per node, renewed in place
+		index.Fields("node_id", "user_id").
+			Unique(),
+
+		// Index for finding online users and pruning expired leases
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReadMarker holds the schema definition for a user's read watermark in a connection.
+// Every message up to and including the marked message has been read by the user;
+// the watermark only ever moves forward.
+type ReadMarker struct {
+	ent.Schema
+}
+
+func (ReadMarker) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+
+		field.UUID("connection_id", uuid.UUID{}).
+			Comment("ID of the connection the watermark belongs to"),
+
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("ID of the user who read the messages"),
+
+		field.UUID("last_read_message_id", uuid.UUID{}).
+			Comment("ID of the newest message read by the user"),
+
+		field.Time("last_read_message_at").
+			Comment("Creation time of the newest message read, used to compare positions"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the watermark last moved"),
+	}
+}
+
+// Edges of the ReadMarker.
+func (ReadMarker) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("connection", Connection.Type).
+			Ref("read_markers").
+			Field("connection_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the ReadMarker.
+func (ReadMarker) Indexes() []ent.Index {
+	return []ent.Index{
+		// One watermark per user and connection
+		index.Fields("connection_id", "user_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Session holds the schema definition for a refresh-token session.
+// Each login creates one session (a token family); the refresh token stored
+// on it is rotated on every use and only the latest hash is accepted.
+type Session struct {
+	ent.Schema
+}
+
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("ID of the user owning the session"),
+
+		field.String("refresh_token_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("SHA-256 hash of the currently valid refresh token"),
+
+		field.String("device").
+			Optional().
+			MaxLen(100).
+			Comment("Client supplied device name"),
+
+		field.String("ip_address").
+			Optional().
+			Comment("IP address the session was last used from"),
+
+		field.String("user_agent").
+			Optional().
+			Comment("User agent the session was last used from"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the session was created (login time)"),
+
+		field.Time("last_used_at").
+			Default(time.Now).
+			Comment("Timestamp when the refresh token was last rotated"),
+
+		field.Time("expires_at").
+			Comment("Timestamp when the current refresh token expires"),
+
+		field.Time("revoked_at").
+			Optional().
+			Comment("Timestamp when the session was revoked (logout, reuse detection)"),
+
+		field.String("revoke_reason").
+			Optional().
+			Comment("Why the session was revoked"),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sessions").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for listing a user's sessions
+		index.Fields("user_id"),
+
+		// Index for finding active sessions by user
+		index.Fields("user_id", "revoked_at"),
+
+		// Index for cleanup of expired sessions
+		index.Fields("expires_at"),
+	}
+}
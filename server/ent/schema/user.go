@@ -61,6 +61,14 @@ func (User) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now),
 
+		field.Time("email_verified_at").
+			Optional().
+			Comment("Timestamp when the user verified their email address; unverified users are not matchable"),
+
+		field.Time("verification_sent_at").
+			Optional().
+			Comment("Timestamp when the last verification email was sent, used to throttle resends"),
+
 		field.Int("age").
 			Min(18).
 			Max(100),
@@ -128,6 +136,36 @@ func (User) Fields() []ent.Field {
 
 		field.JSON("prompts", []Prompt{}).
 			Optional(),
+
+		field.Time("last_seen_at").
+			Optional().
+			Comment("Timestamp when the user was last connected, refreshed while they are online"),
+
+		field.Bool("show_last_seen").
+			Default(true).
+			Comment("Whether connections may see when the user was last online"),
+
+		field.Enum("status_mode").
+			Values(
+				"available",
+				"busy",
+				"invisible",
+			).
+			Default("available").
+			Comment("Availability chosen by the user; invisible users appear offline"),
+
+		field.String("status_message").
+			Optional().
+			MaxLen(80).
+			Comment("Short custom status shown to connections"),
+
+		field.Enum("role").
+			Values(
+				"user",
+				"admin",
+			).
+			Default("user").
+			Comment("Admins may use the operational endpoints, such as the global online users list"),
 	}
 }
 
@@ -138,6 +176,10 @@ func (User) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 			),
+		edge.To("sessions", Session.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
 	}
 }
 
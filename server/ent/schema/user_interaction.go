@@ -28,7 +28,7 @@ func (UserInteraction) Fields() []ent.Field {
 			Comment("ID of the user being acted upon"),
 
 		field.Enum("interaction_type").
-			Values("declined_request", "skipped_profile", "deleted_connection").
+			Values("declined_request", "skipped_profile", "deleted_connection", "liked", "super_liked").
 			Comment("Type of interaction performed"),
 
 		field.Time("created_at").
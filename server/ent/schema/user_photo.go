@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -22,8 +23,19 @@ func (UserPhoto) Fields() []ent.Field {
 		field.String("photo_url").
 			NotEmpty(),
 		field.String("public_id"),
+		field.String("medium_url").
+			Optional().
+			Comment("Medium variant used by profile views"),
+		field.String("medium_public_id").
+			Optional(),
+		field.String("thumbnail_url").
+			Optional().
+			Comment("Square thumbnail variant used by lists"),
+		field.String("thumbnail_public_id").
+			Optional(),
 		field.Int("order").
-			Min(1),
+			Min(1).
+			Comment("Position in the user's gallery, 1 is the primary photo"),
 		field.UUID("user_id", uuid.UUID{}),
 	}
 }
@@ -38,3 +50,12 @@ func (UserPhoto) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Indexes of the UserPhoto.
+func (UserPhoto) Indexes() []ent.Index {
+	return []ent.Index{
+		// Each position in a user's gallery holds exactly one photo
+		index.Fields("user_id", "order").
+			Unique(),
+	}
+}
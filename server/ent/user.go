@@ -34,6 +34,10 @@ type User struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Timestamp when the user verified their email address; unverified users are not matchable
+	EmailVerifiedAt time.Time `json:"email_verified_at,omitempty"`
+	// Timestamp when the last verification email was sent, used to throttle resends
+	VerificationSentAt time.Time `json:"verification_sent_at,omitempty"`
 	// Age holds the value of the "age" field.
 	Age int `json:"age,omitempty"`
 	// PreferredAgeMin holds the value of the "preferred_age_min" field.
@@ -62,6 +66,16 @@ type User struct {
 	CommunicationStyle string `json:"communication_style,omitempty"`
 	// Prompts holds the value of the "prompts" field.
 	Prompts []schema.Prompt `json:"prompts,omitempty"`
+	// Timestamp when the user was last connected, refreshed while they are online
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// Whether connections may see when the user was last online
+	ShowLastSeen bool `json:"show_last_seen,omitempty"`
+	// Availability chosen by the user; invisible users appear offline
+	StatusMode user.StatusMode `json:"status_mode,omitempty"`
+	// Short custom status shown to connections
+	StatusMessage string `json:"status_message,omitempty"`
+	// Admins may use the operational endpoints, such as the global online users list
+	Role user.Role `json:"role,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -72,9 +86,11 @@ type User struct {
 type UserEdges struct {
 	// Photos holds the value of the photos edge.
 	Photos []*UserPhoto `json:"photos,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // PhotosOrErr returns the Photos value or an error if the edge
@@ -86,6 +102,15 @@ func (e UserEdges) PhotosOrErr() ([]*UserPhoto, error) {
 	return nil, &NotLoadedError{edge: "photos"}
 }
 
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[1] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -95,11 +120,13 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case user.FieldCoordinates:
 			values[i] = new(schema.Point)
+		case user.FieldShowLastSeen:
+			values[i] = new(sql.NullBool)
 		case user.FieldAge, user.FieldPreferredAgeMin, user.FieldPreferredAgeMax, user.FieldProfileCompletion, user.FieldPreferredDistance:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldPasswordHash, user.FieldFirstName, user.FieldLastName, user.FieldAboutMe, user.FieldGender, user.FieldPreferredGender, user.FieldCommunicationStyle:
+		case user.FieldEmail, user.FieldPasswordHash, user.FieldFirstName, user.FieldLastName, user.FieldAboutMe, user.FieldGender, user.FieldPreferredGender, user.FieldCommunicationStyle, user.FieldStatusMode, user.FieldStatusMessage, user.FieldRole:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldEmailVerifiedAt, user.FieldVerificationSentAt, user.FieldLastSeenAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -166,6 +193,18 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				_m.EmailVerifiedAt = value.Time
+			}
+		case user.FieldVerificationSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_sent_at", values[i])
+			} else if value.Valid {
+				_m.VerificationSentAt = value.Time
+			}
 		case user.FieldAge:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field age", values[i])
@@ -260,6 +299,36 @@ func (_m *User) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field prompts: %w", err)
 				}
 			}
+		case user.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		case user.FieldShowLastSeen:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field show_last_seen", values[i])
+			} else if value.Valid {
+				_m.ShowLastSeen = value.Bool
+			}
+		case user.FieldStatusMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_mode", values[i])
+			} else if value.Valid {
+				_m.StatusMode = user.StatusMode(value.String)
+			}
+		case user.FieldStatusMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_message", values[i])
+			} else if value.Valid {
+				_m.StatusMessage = value.String
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = user.Role(value.String)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -278,6 +347,11 @@ func (_m *User) QueryPhotos() *UserPhotoQuery {
 	return NewUserClient(_m.config).QueryPhotos(_m)
 }
 
+// QuerySessions queries the "sessions" edge of the User entity.
+func (_m *User) QuerySessions() *SessionQuery {
+	return NewUserClient(_m.config).QuerySessions(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -321,6 +395,12 @@ func (_m *User) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("email_verified_at=")
+	builder.WriteString(_m.EmailVerifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("verification_sent_at=")
+	builder.WriteString(_m.VerificationSentAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("age=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Age))
 	builder.WriteString(", ")
@@ -362,6 +442,21 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("prompts=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Prompts))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("show_last_seen=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShowLastSeen))
+	builder.WriteString(", ")
+	builder.WriteString("status_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StatusMode))
+	builder.WriteString(", ")
+	builder.WriteString("status_message=")
+	builder.WriteString(_m.StatusMessage)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
 	builder.WriteByte(')')
 	return builder.String()
 }
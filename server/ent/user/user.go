@@ -30,6 +30,10 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldEmailVerifiedAt holds the string denoting the email_verified_at field in the database.
+	FieldEmailVerifiedAt = "email_verified_at"
+	// FieldVerificationSentAt holds the string denoting the verification_sent_at field in the database.
+	FieldVerificationSentAt = "verification_sent_at"
 	// FieldAge holds the string denoting the age field in the database.
 	FieldAge = "age"
 	// FieldPreferredAgeMin holds the string denoting the preferred_age_min field in the database.
@@ -58,8 +62,20 @@ const (
 	FieldCommunicationStyle = "communication_style"
 	// FieldPrompts holds the string denoting the prompts field in the database.
 	FieldPrompts = "prompts"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldShowLastSeen holds the string denoting the show_last_seen field in the database.
+	FieldShowLastSeen = "show_last_seen"
+	// FieldStatusMode holds the string denoting the status_mode field in the database.
+	FieldStatusMode = "status_mode"
+	// FieldStatusMessage holds the string denoting the status_message field in the database.
+	FieldStatusMessage = "status_message"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
 	// EdgePhotos holds the string denoting the photos edge name in mutations.
 	EdgePhotos = "photos"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// PhotosTable is the table that holds the photos relation/edge.
@@ -69,6 +85,13 @@ const (
 	PhotosInverseTable = "user_photos"
 	// PhotosColumn is the table column denoting the photos relation/edge.
 	PhotosColumn = "user_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -81,6 +104,8 @@ var Columns = []string{
 	FieldAboutMe,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldEmailVerifiedAt,
+	FieldVerificationSentAt,
 	FieldAge,
 	FieldPreferredAgeMin,
 	FieldPreferredAgeMax,
@@ -95,6 +120,11 @@ var Columns = []string{
 	FieldFoodPreferences,
 	FieldCommunicationStyle,
 	FieldPrompts,
+	FieldLastSeenAt,
+	FieldShowLastSeen,
+	FieldStatusMode,
+	FieldStatusMessage,
+	FieldRole,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -132,6 +162,10 @@ var (
 	ProfileCompletionValidator func(int) error
 	// PreferredDistanceValidator is a validator for the "preferred_distance" field. It is called by the builders before save.
 	PreferredDistanceValidator func(int) error
+	// DefaultShowLastSeen holds the default value on creation for the "show_last_seen" field.
+	DefaultShowLastSeen bool
+	// StatusMessageValidator is a validator for the "status_message" field. It is called by the builders before save.
+	StatusMessageValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -189,6 +223,59 @@ func PreferredGenderValidator(pg PreferredGender) error {
 	}
 }
 
+// StatusMode defines the type for the "status_mode" enum field.
+type StatusMode string
+
+// StatusModeAvailable is the default value of the StatusMode enum.
+const DefaultStatusMode = StatusModeAvailable
+
+// StatusMode values.
+const (
+	StatusModeAvailable StatusMode = "available"
+	StatusModeBusy      StatusMode = "busy"
+	StatusModeInvisible StatusMode = "invisible"
+)
+
+func (sm StatusMode) String() string {
+	return string(sm)
+}
+
+// StatusModeValidator is a validator for the "status_mode" field enum values. It is called by the builders before save.
+func StatusModeValidator(sm StatusMode) error {
+	switch sm {
+	case StatusModeAvailable, StatusModeBusy, StatusModeInvisible:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for status_mode field: %q", sm)
+	}
+}
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -232,6 +319,16 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByEmailVerifiedAt orders the results by the email_verified_at field.
+func ByEmailVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerifiedAt, opts...).ToFunc()
+}
+
+// ByVerificationSentAt orders the results by the verification_sent_at field.
+func ByVerificationSentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationSentAt, opts...).ToFunc()
+}
+
 // ByAge orders the results by the age field.
 func ByAge(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAge, opts...).ToFunc()
@@ -277,6 +374,31 @@ func ByCommunicationStyle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCommunicationStyle, opts...).ToFunc()
 }
 
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByShowLastSeen orders the results by the show_last_seen field.
+func ByShowLastSeen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShowLastSeen, opts...).ToFunc()
+}
+
+// ByStatusMode orders the results by the status_mode field.
+func ByStatusMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusMode, opts...).ToFunc()
+}
+
+// ByStatusMessage orders the results by the status_message field.
+func ByStatusMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusMessage, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
 // ByPhotosCount orders the results by photos count.
 func ByPhotosCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -290,6 +412,20 @@ func ByPhotos(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPhotosStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPhotosStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -297,3 +433,10 @@ func newPhotosStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PhotosTable, PhotosColumn),
 	)
 }
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
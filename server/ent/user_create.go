@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"match-me/ent/schema"
+	"match-me/ent/session"
 	"match-me/ent/user"
 	"match-me/ent/userphoto"
 	"time"
@@ -89,6 +90,34 @@ func (_c *UserCreate) SetNillableUpdatedAt(v *time.Time) *UserCreate {
 	return _c
 }
 
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (_c *UserCreate) SetEmailVerifiedAt(v time.Time) *UserCreate {
+	_c.mutation.SetEmailVerifiedAt(v)
+	return _c
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEmailVerifiedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetEmailVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (_c *UserCreate) SetVerificationSentAt(v time.Time) *UserCreate {
+	_c.mutation.SetVerificationSentAt(v)
+	return _c
+}
+
+// SetNillableVerificationSentAt sets the "verification_sent_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableVerificationSentAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetVerificationSentAt(*v)
+	}
+	return _c
+}
+
 // SetAge sets the "age" field.
 func (_c *UserCreate) SetAge(v int) *UserCreate {
 	_c.mutation.SetAge(v)
@@ -221,6 +250,76 @@ func (_c *UserCreate) SetPrompts(v []schema.Prompt) *UserCreate {
 	return _c
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *UserCreate) SetLastSeenAt(v time.Time) *UserCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLastSeenAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
+// SetShowLastSeen sets the "show_last_seen" field.
+func (_c *UserCreate) SetShowLastSeen(v bool) *UserCreate {
+	_c.mutation.SetShowLastSeen(v)
+	return _c
+}
+
+// SetNillableShowLastSeen sets the "show_last_seen" field if the given value is not nil.
+func (_c *UserCreate) SetNillableShowLastSeen(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetShowLastSeen(*v)
+	}
+	return _c
+}
+
+// SetStatusMode sets the "status_mode" field.
+func (_c *UserCreate) SetStatusMode(v user.StatusMode) *UserCreate {
+	_c.mutation.SetStatusMode(v)
+	return _c
+}
+
+// SetNillableStatusMode sets the "status_mode" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStatusMode(v *user.StatusMode) *UserCreate {
+	if v != nil {
+		_c.SetStatusMode(*v)
+	}
+	return _c
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_c *UserCreate) SetStatusMessage(v string) *UserCreate {
+	_c.mutation.SetStatusMessage(v)
+	return _c
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStatusMessage(v *string) *UserCreate {
+	if v != nil {
+		_c.SetStatusMessage(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *UserCreate) SetRole(v user.Role) *UserCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRole(v *user.Role) *UserCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserCreate) SetID(v uuid.UUID) *UserCreate {
 	_c.mutation.SetID(v)
@@ -250,6 +349,21 @@ func (_c *UserCreate) AddPhotos(v ...*UserPhoto) *UserCreate {
 	return _c.AddPhotoIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_c *UserCreate) AddSessionIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_c *UserCreate) AddSessions(v ...*Session) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -297,6 +411,18 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultPreferredGender
 		_c.mutation.SetPreferredGender(v)
 	}
+	if _, ok := _c.mutation.ShowLastSeen(); !ok {
+		v := user.DefaultShowLastSeen
+		_c.mutation.SetShowLastSeen(v)
+	}
+	if _, ok := _c.mutation.StatusMode(); !ok {
+		v := user.DefaultStatusMode
+		_c.mutation.SetStatusMode(v)
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		v := user.DefaultRole
+		_c.mutation.SetRole(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := user.DefaultID()
 		_c.mutation.SetID(v)
@@ -387,6 +513,30 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "preferred_distance", err: fmt.Errorf(`ent: validator failed for field "User.preferred_distance": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ShowLastSeen(); !ok {
+		return &ValidationError{Name: "show_last_seen", err: errors.New(`ent: missing required field "User.show_last_seen"`)}
+	}
+	if _, ok := _c.mutation.StatusMode(); !ok {
+		return &ValidationError{Name: "status_mode", err: errors.New(`ent: missing required field "User.status_mode"`)}
+	}
+	if v, ok := _c.mutation.StatusMode(); ok {
+		if err := user.StatusModeValidator(v); err != nil {
+			return &ValidationError{Name: "status_mode", err: fmt.Errorf(`ent: validator failed for field "User.status_mode": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.StatusMessage(); ok {
+		if err := user.StatusMessageValidator(v); err != nil {
+			return &ValidationError{Name: "status_message", err: fmt.Errorf(`ent: validator failed for field "User.status_message": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -450,6 +600,14 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+		_node.EmailVerifiedAt = value
+	}
+	if value, ok := _c.mutation.VerificationSentAt(); ok {
+		_spec.SetField(user.FieldVerificationSentAt, field.TypeTime, value)
+		_node.VerificationSentAt = value
+	}
 	if value, ok := _c.mutation.Age(); ok {
 		_spec.SetField(user.FieldAge, field.TypeInt, value)
 		_node.Age = value
@@ -506,6 +664,26 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPrompts, field.TypeJSON, value)
 		_node.Prompts = value
 	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := _c.mutation.ShowLastSeen(); ok {
+		_spec.SetField(user.FieldShowLastSeen, field.TypeBool, value)
+		_node.ShowLastSeen = value
+	}
+	if value, ok := _c.mutation.StatusMode(); ok {
+		_spec.SetField(user.FieldStatusMode, field.TypeEnum, value)
+		_node.StatusMode = value
+	}
+	if value, ok := _c.mutation.StatusMessage(); ok {
+		_spec.SetField(user.FieldStatusMessage, field.TypeString, value)
+		_node.StatusMessage = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
 	if nodes := _c.mutation.PhotosIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -522,6 +700,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"match-me/ent/predicate"
 	"match-me/ent/schema"
+	"match-me/ent/session"
 	"match-me/ent/user"
 	"match-me/ent/userphoto"
 	"time"
@@ -114,6 +115,46 @@ func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	return _u
 }
 
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (_u *UserUpdate) SetEmailVerifiedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetEmailVerifiedAt(v)
+	return _u
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEmailVerifiedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetEmailVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (_u *UserUpdate) ClearEmailVerifiedAt() *UserUpdate {
+	_u.mutation.ClearEmailVerifiedAt()
+	return _u
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (_u *UserUpdate) SetVerificationSentAt(v time.Time) *UserUpdate {
+	_u.mutation.SetVerificationSentAt(v)
+	return _u
+}
+
+// SetNillableVerificationSentAt sets the "verification_sent_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableVerificationSentAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetVerificationSentAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationSentAt clears the value of the "verification_sent_at" field.
+func (_u *UserUpdate) ClearVerificationSentAt() *UserUpdate {
+	_u.mutation.ClearVerificationSentAt()
+	return _u
+}
+
 // SetAge sets the "age" field.
 func (_u *UserUpdate) SetAge(v int) *UserUpdate {
 	_u.mutation.ResetAge()
@@ -393,6 +434,88 @@ func (_u *UserUpdate) ClearPrompts() *UserUpdate {
 	return _u
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *UserUpdate) SetLastSeenAt(v time.Time) *UserUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLastSeenAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *UserUpdate) ClearLastSeenAt() *UserUpdate {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetShowLastSeen sets the "show_last_seen" field.
+func (_u *UserUpdate) SetShowLastSeen(v bool) *UserUpdate {
+	_u.mutation.SetShowLastSeen(v)
+	return _u
+}
+
+// SetNillableShowLastSeen sets the "show_last_seen" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableShowLastSeen(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetShowLastSeen(*v)
+	}
+	return _u
+}
+
+// SetStatusMode sets the "status_mode" field.
+func (_u *UserUpdate) SetStatusMode(v user.StatusMode) *UserUpdate {
+	_u.mutation.SetStatusMode(v)
+	return _u
+}
+
+// SetNillableStatusMode sets the "status_mode" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusMode(v *user.StatusMode) *UserUpdate {
+	if v != nil {
+		_u.SetStatusMode(*v)
+	}
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *UserUpdate) SetStatusMessage(v string) *UserUpdate {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusMessage(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *UserUpdate) ClearStatusMessage() *UserUpdate {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *UserUpdate) SetRole(v user.Role) *UserUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableRole(v *user.Role) *UserUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
 // AddPhotoIDs adds the "photos" edge to the UserPhoto entity by IDs.
 func (_u *UserUpdate) AddPhotoIDs(ids ...uuid.UUID) *UserUpdate {
 	_u.mutation.AddPhotoIDs(ids...)
@@ -408,6 +531,21 @@ func (_u *UserUpdate) AddPhotos(v ...*UserPhoto) *UserUpdate {
 	return _u.AddPhotoIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *UserUpdate) AddSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *UserUpdate) AddSessions(v ...*Session) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -434,6 +572,27 @@ func (_u *UserUpdate) RemovePhotos(v ...*UserPhoto) *UserUpdate {
 	return _u.RemovePhotoIDs(ids...)
 }
 
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *UserUpdate) ClearSessions() *UserUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *UserUpdate) RemoveSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *UserUpdate) RemoveSessions(v ...*Session) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -527,6 +686,21 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "preferred_distance", err: fmt.Errorf(`ent: validator failed for field "User.preferred_distance": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StatusMode(); ok {
+		if err := user.StatusModeValidator(v); err != nil {
+			return &ValidationError{Name: "status_mode", err: fmt.Errorf(`ent: validator failed for field "User.status_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusMessage(); ok {
+		if err := user.StatusMessageValidator(v); err != nil {
+			return &ValidationError{Name: "status_message", err: fmt.Errorf(`ent: validator failed for field "User.status_message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -563,6 +737,18 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailVerifiedAtCleared() {
+		_spec.ClearField(user.FieldEmailVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerificationSentAt(); ok {
+		_spec.SetField(user.FieldVerificationSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationSentAtCleared() {
+		_spec.ClearField(user.FieldVerificationSentAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Age(); ok {
 		_spec.SetField(user.FieldAge, field.TypeInt, value)
 	}
@@ -678,6 +864,27 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PromptsCleared() {
 		_spec.ClearField(user.FieldPrompts, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(user.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ShowLastSeen(); ok {
+		_spec.SetField(user.FieldShowLastSeen, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StatusMode(); ok {
+		_spec.SetField(user.FieldStatusMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(user.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(user.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
 	if _u.mutation.PhotosCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -723,6 +930,51 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -825,6 +1077,46 @@ func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	return _u
 }
 
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (_u *UserUpdateOne) SetEmailVerifiedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetEmailVerifiedAt(v)
+	return _u
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerifiedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (_u *UserUpdateOne) ClearEmailVerifiedAt() *UserUpdateOne {
+	_u.mutation.ClearEmailVerifiedAt()
+	return _u
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (_u *UserUpdateOne) SetVerificationSentAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetVerificationSentAt(v)
+	return _u
+}
+
+// SetNillableVerificationSentAt sets the "verification_sent_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableVerificationSentAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetVerificationSentAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationSentAt clears the value of the "verification_sent_at" field.
+func (_u *UserUpdateOne) ClearVerificationSentAt() *UserUpdateOne {
+	_u.mutation.ClearVerificationSentAt()
+	return _u
+}
+
 // SetAge sets the "age" field.
 func (_u *UserUpdateOne) SetAge(v int) *UserUpdateOne {
 	_u.mutation.ResetAge()
@@ -1104,6 +1396,88 @@ func (_u *UserUpdateOne) ClearPrompts() *UserUpdateOne {
 	return _u
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *UserUpdateOne) SetLastSeenAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLastSeenAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *UserUpdateOne) ClearLastSeenAt() *UserUpdateOne {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetShowLastSeen sets the "show_last_seen" field.
+func (_u *UserUpdateOne) SetShowLastSeen(v bool) *UserUpdateOne {
+	_u.mutation.SetShowLastSeen(v)
+	return _u
+}
+
+// SetNillableShowLastSeen sets the "show_last_seen" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableShowLastSeen(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetShowLastSeen(*v)
+	}
+	return _u
+}
+
+// SetStatusMode sets the "status_mode" field.
+func (_u *UserUpdateOne) SetStatusMode(v user.StatusMode) *UserUpdateOne {
+	_u.mutation.SetStatusMode(v)
+	return _u
+}
+
+// SetNillableStatusMode sets the "status_mode" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusMode(v *user.StatusMode) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusMode(*v)
+	}
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *UserUpdateOne) SetStatusMessage(v string) *UserUpdateOne {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusMessage(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *UserUpdateOne) ClearStatusMessage() *UserUpdateOne {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *UserUpdateOne) SetRole(v user.Role) *UserUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRole(v *user.Role) *UserUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
 // AddPhotoIDs adds the "photos" edge to the UserPhoto entity by IDs.
 func (_u *UserUpdateOne) AddPhotoIDs(ids ...uuid.UUID) *UserUpdateOne {
 	_u.mutation.AddPhotoIDs(ids...)
@@ -1119,6 +1493,21 @@ func (_u *UserUpdateOne) AddPhotos(v ...*UserPhoto) *UserUpdateOne {
 	return _u.AddPhotoIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *UserUpdateOne) AddSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *UserUpdateOne) AddSessions(v ...*Session) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -1145,6 +1534,27 @@ func (_u *UserUpdateOne) RemovePhotos(v ...*UserPhoto) *UserUpdateOne {
 	return _u.RemovePhotoIDs(ids...)
 }
 
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *UserUpdateOne) RemoveSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *UserUpdateOne) RemoveSessions(v ...*Session) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1251,6 +1661,21 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "preferred_distance", err: fmt.Errorf(`ent: validator failed for field "User.preferred_distance": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StatusMode(); ok {
+		if err := user.StatusModeValidator(v); err != nil {
+			return &ValidationError{Name: "status_mode", err: fmt.Errorf(`ent: validator failed for field "User.status_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusMessage(); ok {
+		if err := user.StatusMessageValidator(v); err != nil {
+			return &ValidationError{Name: "status_message", err: fmt.Errorf(`ent: validator failed for field "User.status_message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1304,6 +1729,18 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailVerifiedAtCleared() {
+		_spec.ClearField(user.FieldEmailVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerificationSentAt(); ok {
+		_spec.SetField(user.FieldVerificationSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationSentAtCleared() {
+		_spec.ClearField(user.FieldVerificationSentAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Age(); ok {
 		_spec.SetField(user.FieldAge, field.TypeInt, value)
 	}
@@ -1419,6 +1856,27 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.PromptsCleared() {
 		_spec.ClearField(user.FieldPrompts, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(user.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ShowLastSeen(); ok {
+		_spec.SetField(user.FieldShowLastSeen, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StatusMode(); ok {
+		_spec.SetField(user.FieldStatusMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(user.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(user.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
 	if _u.mutation.PhotosCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1464,6 +1922,51 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -86,6 +86,8 @@ const (
 	InteractionTypeDeclinedRequest   InteractionType = "declined_request"
 	InteractionTypeSkippedProfile    InteractionType = "skipped_profile"
 	InteractionTypeDeletedConnection InteractionType = "deleted_connection"
+	InteractionTypeLiked             InteractionType = "liked"
+	InteractionTypeSuperLiked        InteractionType = "super_liked"
 )
 
 func (it InteractionType) String() string {
@@ -95,7 +97,7 @@ func (it InteractionType) String() string {
 // InteractionTypeValidator is a validator for the "interaction_type" field enum values. It is called by the builders before save.
 func InteractionTypeValidator(it InteractionType) error {
 	switch it {
-	case InteractionTypeDeclinedRequest, InteractionTypeSkippedProfile, InteractionTypeDeletedConnection:
+	case InteractionTypeDeclinedRequest, InteractionTypeSkippedProfile, InteractionTypeDeletedConnection, InteractionTypeLiked, InteractionTypeSuperLiked:
 		return nil
 	default:
 		return fmt.Errorf("userinteraction: invalid enum value for interaction_type field: %q", it)
@@ -22,7 +22,15 @@ type UserPhoto struct {
 	PhotoURL string `json:"photo_url,omitempty"`
 	// PublicID holds the value of the "public_id" field.
 	PublicID string `json:"public_id,omitempty"`
-	// Order holds the value of the "order" field.
+	// Medium variant used by profile views
+	MediumURL string `json:"medium_url,omitempty"`
+	// MediumPublicID holds the value of the "medium_public_id" field.
+	MediumPublicID string `json:"medium_public_id,omitempty"`
+	// Square thumbnail variant used by lists
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// ThumbnailPublicID holds the value of the "thumbnail_public_id" field.
+	ThumbnailPublicID string `json:"thumbnail_public_id,omitempty"`
+	// Position in the user's gallery, 1 is the primary photo
 	Order int `json:"order,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID uuid.UUID `json:"user_id,omitempty"`
@@ -59,7 +67,7 @@ func (*UserPhoto) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case userphoto.FieldOrder:
 			values[i] = new(sql.NullInt64)
-		case userphoto.FieldPhotoURL, userphoto.FieldPublicID:
+		case userphoto.FieldPhotoURL, userphoto.FieldPublicID, userphoto.FieldMediumURL, userphoto.FieldMediumPublicID, userphoto.FieldThumbnailURL, userphoto.FieldThumbnailPublicID:
 			values[i] = new(sql.NullString)
 		case userphoto.FieldID, userphoto.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -96,6 +104,30 @@ func (_m *UserPhoto) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PublicID = value.String
 			}
+		case userphoto.FieldMediumURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field medium_url", values[i])
+			} else if value.Valid {
+				_m.MediumURL = value.String
+			}
+		case userphoto.FieldMediumPublicID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field medium_public_id", values[i])
+			} else if value.Valid {
+				_m.MediumPublicID = value.String
+			}
+		case userphoto.FieldThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+			} else if value.Valid {
+				_m.ThumbnailURL = value.String
+			}
+		case userphoto.FieldThumbnailPublicID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_public_id", values[i])
+			} else if value.Valid {
+				_m.ThumbnailPublicID = value.String
+			}
 		case userphoto.FieldOrder:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field order", values[i])
@@ -155,6 +187,18 @@ func (_m *UserPhoto) String() string {
 	builder.WriteString("public_id=")
 	builder.WriteString(_m.PublicID)
 	builder.WriteString(", ")
+	builder.WriteString("medium_url=")
+	builder.WriteString(_m.MediumURL)
+	builder.WriteString(", ")
+	builder.WriteString("medium_public_id=")
+	builder.WriteString(_m.MediumPublicID)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_url=")
+	builder.WriteString(_m.ThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_public_id=")
+	builder.WriteString(_m.ThumbnailPublicID)
+	builder.WriteString(", ")
 	builder.WriteString("order=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Order))
 	builder.WriteString(", ")
@@ -17,6 +17,14 @@ const (
 	FieldPhotoURL = "photo_url"
 	// FieldPublicID holds the string denoting the public_id field in the database.
 	FieldPublicID = "public_id"
+	// FieldMediumURL holds the string denoting the medium_url field in the database.
+	FieldMediumURL = "medium_url"
+	// FieldMediumPublicID holds the string denoting the medium_public_id field in the database.
+	FieldMediumPublicID = "medium_public_id"
+	// FieldThumbnailURL holds the string denoting the thumbnail_url field in the database.
+	FieldThumbnailURL = "thumbnail_url"
+	// FieldThumbnailPublicID holds the string denoting the thumbnail_public_id field in the database.
+	FieldThumbnailPublicID = "thumbnail_public_id"
 	// FieldOrder holds the string denoting the order field in the database.
 	FieldOrder = "order"
 	// FieldUserID holds the string denoting the user_id field in the database.
@@ -39,6 +47,10 @@ var Columns = []string{
 	FieldID,
 	FieldPhotoURL,
 	FieldPublicID,
+	FieldMediumURL,
+	FieldMediumPublicID,
+	FieldThumbnailURL,
+	FieldThumbnailPublicID,
 	FieldOrder,
 	FieldUserID,
 }
@@ -80,6 +92,26 @@ func ByPublicID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublicID, opts...).ToFunc()
 }
 
+// ByMediumURL orders the results by the medium_url field.
+func ByMediumURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMediumURL, opts...).ToFunc()
+}
+
+// ByMediumPublicID orders the results by the medium_public_id field.
+func ByMediumPublicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMediumPublicID, opts...).ToFunc()
+}
+
+// ByThumbnailURL orders the results by the thumbnail_url field.
+func ByThumbnailURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThumbnailURL, opts...).ToFunc()
+}
+
+// ByThumbnailPublicID orders the results by the thumbnail_public_id field.
+func ByThumbnailPublicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThumbnailPublicID, opts...).ToFunc()
+}
+
 // ByOrder orders the results by the order field.
 func ByOrder(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrder, opts...).ToFunc()
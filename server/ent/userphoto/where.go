@@ -65,6 +65,26 @@ func PublicID(v string) predicate.UserPhoto {
 	return predicate.UserPhoto(sql.FieldEQ(FieldPublicID, v))
 }
 
+// MediumURL applies equality check predicate on the "medium_url" field. It's identical to MediumURLEQ.
+func MediumURL(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEQ(FieldMediumURL, v))
+}
+
+// MediumPublicID applies equality check predicate on the "medium_public_id" field. It's identical to MediumPublicIDEQ.
+func MediumPublicID(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEQ(FieldMediumPublicID, v))
+}
+
+// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
+func ThumbnailURL(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailPublicID applies equality check predicate on the "thumbnail_public_id" field. It's identical to ThumbnailPublicIDEQ.
+func ThumbnailPublicID(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEQ(FieldThumbnailPublicID, v))
+}
+
 // Order applies equality check predicate on the "order" field. It's identical to OrderEQ.
 func Order(v int) predicate.UserPhoto {
 	return predicate.UserPhoto(sql.FieldEQ(FieldOrder, v))
@@ -205,6 +225,306 @@ func PublicIDContainsFold(v string) predicate.UserPhoto {
 	return predicate.UserPhoto(sql.FieldContainsFold(FieldPublicID, v))
 }
 
+// MediumURLEQ applies the EQ predicate on the "medium_url" field.
+func MediumURLEQ(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEQ(FieldMediumURL, v))
+}
+
+// MediumURLNEQ applies the NEQ predicate on the "medium_url" field.
+func MediumURLNEQ(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNEQ(FieldMediumURL, v))
+}
+
+// MediumURLIn applies the In predicate on the "medium_url" field.
+func MediumURLIn(vs ...string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldIn(FieldMediumURL, vs...))
+}
+
+// MediumURLNotIn applies the NotIn predicate on the "medium_url" field.
+func MediumURLNotIn(vs ...string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNotIn(FieldMediumURL, vs...))
+}
+
+// MediumURLGT applies the GT predicate on the "medium_url" field.
+func MediumURLGT(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldGT(FieldMediumURL, v))
+}
+
+// MediumURLGTE applies the GTE predicate on the "medium_url" field.
+func MediumURLGTE(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldGTE(FieldMediumURL, v))
+}
+
+// MediumURLLT applies the LT predicate on the "medium_url" field.
+func MediumURLLT(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldLT(FieldMediumURL, v))
+}
+
+// MediumURLLTE applies the LTE predicate on the "medium_url" field.
+func MediumURLLTE(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldLTE(FieldMediumURL, v))
+}
+
+// MediumURLContains applies the Contains predicate on the "medium_url" field.
+func MediumURLContains(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldContains(FieldMediumURL, v))
+}
+
+// MediumURLHasPrefix applies the HasPrefix predicate on the "medium_url" field.
+func MediumURLHasPrefix(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldHasPrefix(FieldMediumURL, v))
+}
+
+// MediumURLHasSuffix applies the HasSuffix predicate on the "medium_url" field.
+func MediumURLHasSuffix(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldHasSuffix(FieldMediumURL, v))
+}
+
+// MediumURLIsNil applies the IsNil predicate on the "medium_url" field.
+func MediumURLIsNil() predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldIsNull(FieldMediumURL))
+}
+
+// MediumURLNotNil applies the NotNil predicate on the "medium_url" field.
+func MediumURLNotNil() predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNotNull(FieldMediumURL))
+}
+
+// MediumURLEqualFold applies the EqualFold predicate on the "medium_url" field.
+func MediumURLEqualFold(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEqualFold(FieldMediumURL, v))
+}
+
+// MediumURLContainsFold applies the ContainsFold predicate on the "medium_url" field.
+func MediumURLContainsFold(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldContainsFold(FieldMediumURL, v))
+}
+
+// MediumPublicIDEQ applies the EQ predicate on the "medium_public_id" field.
+func MediumPublicIDEQ(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEQ(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDNEQ applies the NEQ predicate on the "medium_public_id" field.
+func MediumPublicIDNEQ(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNEQ(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDIn applies the In predicate on the "medium_public_id" field.
+func MediumPublicIDIn(vs ...string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldIn(FieldMediumPublicID, vs...))
+}
+
+// MediumPublicIDNotIn applies the NotIn predicate on the "medium_public_id" field.
+func MediumPublicIDNotIn(vs ...string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNotIn(FieldMediumPublicID, vs...))
+}
+
+// MediumPublicIDGT applies the GT predicate on the "medium_public_id" field.
+func MediumPublicIDGT(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldGT(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDGTE applies the GTE predicate on the "medium_public_id" field.
+func MediumPublicIDGTE(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldGTE(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDLT applies the LT predicate on the "medium_public_id" field.
+func MediumPublicIDLT(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldLT(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDLTE applies the LTE predicate on the "medium_public_id" field.
+func MediumPublicIDLTE(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldLTE(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDContains applies the Contains predicate on the "medium_public_id" field.
+func MediumPublicIDContains(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldContains(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDHasPrefix applies the HasPrefix predicate on the "medium_public_id" field.
+func MediumPublicIDHasPrefix(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldHasPrefix(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDHasSuffix applies the HasSuffix predicate on the "medium_public_id" field.
+func MediumPublicIDHasSuffix(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldHasSuffix(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDIsNil applies the IsNil predicate on the "medium_public_id" field.
+func MediumPublicIDIsNil() predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldIsNull(FieldMediumPublicID))
+}
+
+// MediumPublicIDNotNil applies the NotNil predicate on the "medium_public_id" field.
+func MediumPublicIDNotNil() predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNotNull(FieldMediumPublicID))
+}
+
+// MediumPublicIDEqualFold applies the EqualFold predicate on the "medium_public_id" field.
+func MediumPublicIDEqualFold(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEqualFold(FieldMediumPublicID, v))
+}
+
+// MediumPublicIDContainsFold applies the ContainsFold predicate on the "medium_public_id" field.
+func MediumPublicIDContainsFold(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldContainsFold(FieldMediumPublicID, v))
+}
+
+// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
+func ThumbnailURLEQ(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
+func ThumbnailURLNEQ(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
+func ThumbnailURLIn(vs ...string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
+func ThumbnailURLNotIn(vs ...string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNotIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
+func ThumbnailURLGT(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldGT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
+func ThumbnailURLGTE(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldGTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
+func ThumbnailURLLT(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldLT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
+func ThumbnailURLLTE(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldLTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
+func ThumbnailURLContains(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldContains(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasPrefix(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldHasPrefix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasSuffix(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldHasSuffix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIsNil applies the IsNil predicate on the "thumbnail_url" field.
+func ThumbnailURLIsNil() predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldIsNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLNotNil applies the NotNil predicate on the "thumbnail_url" field.
+func ThumbnailURLNotNil() predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNotNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
+func ThumbnailURLEqualFold(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEqualFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
+func ThumbnailURLContainsFold(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldContainsFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailPublicIDEQ applies the EQ predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDEQ(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEQ(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDNEQ applies the NEQ predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDNEQ(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNEQ(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDIn applies the In predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDIn(vs ...string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldIn(FieldThumbnailPublicID, vs...))
+}
+
+// ThumbnailPublicIDNotIn applies the NotIn predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDNotIn(vs ...string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNotIn(FieldThumbnailPublicID, vs...))
+}
+
+// ThumbnailPublicIDGT applies the GT predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDGT(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldGT(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDGTE applies the GTE predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDGTE(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldGTE(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDLT applies the LT predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDLT(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldLT(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDLTE applies the LTE predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDLTE(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldLTE(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDContains applies the Contains predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDContains(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldContains(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDHasPrefix applies the HasPrefix predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDHasPrefix(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldHasPrefix(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDHasSuffix applies the HasSuffix predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDHasSuffix(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldHasSuffix(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDIsNil applies the IsNil predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDIsNil() predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldIsNull(FieldThumbnailPublicID))
+}
+
+// ThumbnailPublicIDNotNil applies the NotNil predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDNotNil() predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldNotNull(FieldThumbnailPublicID))
+}
+
+// ThumbnailPublicIDEqualFold applies the EqualFold predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDEqualFold(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldEqualFold(FieldThumbnailPublicID, v))
+}
+
+// ThumbnailPublicIDContainsFold applies the ContainsFold predicate on the "thumbnail_public_id" field.
+func ThumbnailPublicIDContainsFold(v string) predicate.UserPhoto {
+	return predicate.UserPhoto(sql.FieldContainsFold(FieldThumbnailPublicID, v))
+}
+
 // OrderEQ applies the EQ predicate on the "order" field.
 func OrderEQ(v int) predicate.UserPhoto {
 	return predicate.UserPhoto(sql.FieldEQ(FieldOrder, v))
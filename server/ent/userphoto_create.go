@@ -33,6 +33,62 @@ func (_c *UserPhotoCreate) SetPublicID(v string) *UserPhotoCreate {
 	return _c
 }
 
+// SetMediumURL sets the "medium_url" field.
+func (_c *UserPhotoCreate) SetMediumURL(v string) *UserPhotoCreate {
+	_c.mutation.SetMediumURL(v)
+	return _c
+}
+
+// SetNillableMediumURL sets the "medium_url" field if the given value is not nil.
+func (_c *UserPhotoCreate) SetNillableMediumURL(v *string) *UserPhotoCreate {
+	if v != nil {
+		_c.SetMediumURL(*v)
+	}
+	return _c
+}
+
+// SetMediumPublicID sets the "medium_public_id" field.
+func (_c *UserPhotoCreate) SetMediumPublicID(v string) *UserPhotoCreate {
+	_c.mutation.SetMediumPublicID(v)
+	return _c
+}
+
+// SetNillableMediumPublicID sets the "medium_public_id" field if the given value is not nil.
+func (_c *UserPhotoCreate) SetNillableMediumPublicID(v *string) *UserPhotoCreate {
+	if v != nil {
+		_c.SetMediumPublicID(*v)
+	}
+	return _c
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_c *UserPhotoCreate) SetThumbnailURL(v string) *UserPhotoCreate {
+	_c.mutation.SetThumbnailURL(v)
+	return _c
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_c *UserPhotoCreate) SetNillableThumbnailURL(v *string) *UserPhotoCreate {
+	if v != nil {
+		_c.SetThumbnailURL(*v)
+	}
+	return _c
+}
+
+// SetThumbnailPublicID sets the "thumbnail_public_id" field.
+func (_c *UserPhotoCreate) SetThumbnailPublicID(v string) *UserPhotoCreate {
+	_c.mutation.SetThumbnailPublicID(v)
+	return _c
+}
+
+// SetNillableThumbnailPublicID sets the "thumbnail_public_id" field if the given value is not nil.
+func (_c *UserPhotoCreate) SetNillableThumbnailPublicID(v *string) *UserPhotoCreate {
+	if v != nil {
+		_c.SetThumbnailPublicID(*v)
+	}
+	return _c
+}
+
 // SetOrder sets the "order" field.
 func (_c *UserPhotoCreate) SetOrder(v int) *UserPhotoCreate {
 	_c.mutation.SetOrder(v)
@@ -175,6 +231,22 @@ func (_c *UserPhotoCreate) createSpec() (*UserPhoto, *sqlgraph.CreateSpec) {
 		_spec.SetField(userphoto.FieldPublicID, field.TypeString, value)
 		_node.PublicID = value
 	}
+	if value, ok := _c.mutation.MediumURL(); ok {
+		_spec.SetField(userphoto.FieldMediumURL, field.TypeString, value)
+		_node.MediumURL = value
+	}
+	if value, ok := _c.mutation.MediumPublicID(); ok {
+		_spec.SetField(userphoto.FieldMediumPublicID, field.TypeString, value)
+		_node.MediumPublicID = value
+	}
+	if value, ok := _c.mutation.ThumbnailURL(); ok {
+		_spec.SetField(userphoto.FieldThumbnailURL, field.TypeString, value)
+		_node.ThumbnailURL = value
+	}
+	if value, ok := _c.mutation.ThumbnailPublicID(); ok {
+		_spec.SetField(userphoto.FieldThumbnailPublicID, field.TypeString, value)
+		_node.ThumbnailPublicID = value
+	}
 	if value, ok := _c.mutation.Order(); ok {
 		_spec.SetField(userphoto.FieldOrder, field.TypeInt, value)
 		_node.Order = value
@@ -57,6 +57,86 @@ func (_u *UserPhotoUpdate) SetNillablePublicID(v *string) *UserPhotoUpdate {
 	return _u
 }
 
+// SetMediumURL sets the "medium_url" field.
+func (_u *UserPhotoUpdate) SetMediumURL(v string) *UserPhotoUpdate {
+	_u.mutation.SetMediumURL(v)
+	return _u
+}
+
+// SetNillableMediumURL sets the "medium_url" field if the given value is not nil.
+func (_u *UserPhotoUpdate) SetNillableMediumURL(v *string) *UserPhotoUpdate {
+	if v != nil {
+		_u.SetMediumURL(*v)
+	}
+	return _u
+}
+
+// ClearMediumURL clears the value of the "medium_url" field.
+func (_u *UserPhotoUpdate) ClearMediumURL() *UserPhotoUpdate {
+	_u.mutation.ClearMediumURL()
+	return _u
+}
+
+// SetMediumPublicID sets the "medium_public_id" field.
+func (_u *UserPhotoUpdate) SetMediumPublicID(v string) *UserPhotoUpdate {
+	_u.mutation.SetMediumPublicID(v)
+	return _u
+}
+
+// SetNillableMediumPublicID sets the "medium_public_id" field if the given value is not nil.
+func (_u *UserPhotoUpdate) SetNillableMediumPublicID(v *string) *UserPhotoUpdate {
+	if v != nil {
+		_u.SetMediumPublicID(*v)
+	}
+	return _u
+}
+
+// ClearMediumPublicID clears the value of the "medium_public_id" field.
+func (_u *UserPhotoUpdate) ClearMediumPublicID() *UserPhotoUpdate {
+	_u.mutation.ClearMediumPublicID()
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *UserPhotoUpdate) SetThumbnailURL(v string) *UserPhotoUpdate {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *UserPhotoUpdate) SetNillableThumbnailURL(v *string) *UserPhotoUpdate {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *UserPhotoUpdate) ClearThumbnailURL() *UserPhotoUpdate {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetThumbnailPublicID sets the "thumbnail_public_id" field.
+func (_u *UserPhotoUpdate) SetThumbnailPublicID(v string) *UserPhotoUpdate {
+	_u.mutation.SetThumbnailPublicID(v)
+	return _u
+}
+
+// SetNillableThumbnailPublicID sets the "thumbnail_public_id" field if the given value is not nil.
+func (_u *UserPhotoUpdate) SetNillableThumbnailPublicID(v *string) *UserPhotoUpdate {
+	if v != nil {
+		_u.SetThumbnailPublicID(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailPublicID clears the value of the "thumbnail_public_id" field.
+func (_u *UserPhotoUpdate) ClearThumbnailPublicID() *UserPhotoUpdate {
+	_u.mutation.ClearThumbnailPublicID()
+	return _u
+}
+
 // SetOrder sets the "order" field.
 func (_u *UserPhotoUpdate) SetOrder(v int) *UserPhotoUpdate {
 	_u.mutation.ResetOrder()
@@ -171,6 +251,30 @@ func (_u *UserPhotoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.PublicID(); ok {
 		_spec.SetField(userphoto.FieldPublicID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MediumURL(); ok {
+		_spec.SetField(userphoto.FieldMediumURL, field.TypeString, value)
+	}
+	if _u.mutation.MediumURLCleared() {
+		_spec.ClearField(userphoto.FieldMediumURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.MediumPublicID(); ok {
+		_spec.SetField(userphoto.FieldMediumPublicID, field.TypeString, value)
+	}
+	if _u.mutation.MediumPublicIDCleared() {
+		_spec.ClearField(userphoto.FieldMediumPublicID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(userphoto.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(userphoto.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThumbnailPublicID(); ok {
+		_spec.SetField(userphoto.FieldThumbnailPublicID, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailPublicIDCleared() {
+		_spec.ClearField(userphoto.FieldThumbnailPublicID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Order(); ok {
 		_spec.SetField(userphoto.FieldOrder, field.TypeInt, value)
 	}
@@ -254,6 +358,86 @@ func (_u *UserPhotoUpdateOne) SetNillablePublicID(v *string) *UserPhotoUpdateOne
 	return _u
 }
 
+// SetMediumURL sets the "medium_url" field.
+func (_u *UserPhotoUpdateOne) SetMediumURL(v string) *UserPhotoUpdateOne {
+	_u.mutation.SetMediumURL(v)
+	return _u
+}
+
+// SetNillableMediumURL sets the "medium_url" field if the given value is not nil.
+func (_u *UserPhotoUpdateOne) SetNillableMediumURL(v *string) *UserPhotoUpdateOne {
+	if v != nil {
+		_u.SetMediumURL(*v)
+	}
+	return _u
+}
+
+// ClearMediumURL clears the value of the "medium_url" field.
+func (_u *UserPhotoUpdateOne) ClearMediumURL() *UserPhotoUpdateOne {
+	_u.mutation.ClearMediumURL()
+	return _u
+}
+
+// SetMediumPublicID sets the "medium_public_id" field.
+func (_u *UserPhotoUpdateOne) SetMediumPublicID(v string) *UserPhotoUpdateOne {
+	_u.mutation.SetMediumPublicID(v)
+	return _u
+}
+
+// SetNillableMediumPublicID sets the "medium_public_id" field if the given value is not nil.
+func (_u *UserPhotoUpdateOne) SetNillableMediumPublicID(v *string) *UserPhotoUpdateOne {
+	if v != nil {
+		_u.SetMediumPublicID(*v)
+	}
+	return _u
+}
+
+// ClearMediumPublicID clears the value of the "medium_public_id" field.
+func (_u *UserPhotoUpdateOne) ClearMediumPublicID() *UserPhotoUpdateOne {
+	_u.mutation.ClearMediumPublicID()
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *UserPhotoUpdateOne) SetThumbnailURL(v string) *UserPhotoUpdateOne {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *UserPhotoUpdateOne) SetNillableThumbnailURL(v *string) *UserPhotoUpdateOne {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *UserPhotoUpdateOne) ClearThumbnailURL() *UserPhotoUpdateOne {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetThumbnailPublicID sets the "thumbnail_public_id" field.
+func (_u *UserPhotoUpdateOne) SetThumbnailPublicID(v string) *UserPhotoUpdateOne {
+	_u.mutation.SetThumbnailPublicID(v)
+	return _u
+}
+
+// SetNillableThumbnailPublicID sets the "thumbnail_public_id" field if the given value is not nil.
+func (_u *UserPhotoUpdateOne) SetNillableThumbnailPublicID(v *string) *UserPhotoUpdateOne {
+	if v != nil {
+		_u.SetThumbnailPublicID(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailPublicID clears the value of the "thumbnail_public_id" field.
+func (_u *UserPhotoUpdateOne) ClearThumbnailPublicID() *UserPhotoUpdateOne {
+	_u.mutation.ClearThumbnailPublicID()
+	return _u
+}
+
 // SetOrder sets the "order" field.
 func (_u *UserPhotoUpdateOne) SetOrder(v int) *UserPhotoUpdateOne {
 	_u.mutation.ResetOrder()
@@ -398,6 +582,30 @@ func (_u *UserPhotoUpdateOne) sqlSave(ctx context.Context) (_node *UserPhoto, er
 	if value, ok := _u.mutation.PublicID(); ok {
 		_spec.SetField(userphoto.FieldPublicID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MediumURL(); ok {
+		_spec.SetField(userphoto.FieldMediumURL, field.TypeString, value)
+	}
+	if _u.mutation.MediumURLCleared() {
+		_spec.ClearField(userphoto.FieldMediumURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.MediumPublicID(); ok {
+		_spec.SetField(userphoto.FieldMediumPublicID, field.TypeString, value)
+	}
+	if _u.mutation.MediumPublicIDCleared() {
+		_spec.ClearField(userphoto.FieldMediumPublicID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(userphoto.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(userphoto.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThumbnailPublicID(); ok {
+		_spec.SetField(userphoto.FieldThumbnailPublicID, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailPublicIDCleared() {
+		_spec.ClearField(userphoto.FieldThumbnailPublicID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Order(); ok {
 		_spec.SetField(userphoto.FieldOrder, field.TypeInt, value)
 	}
@@ -5,8 +5,9 @@ import (
 	"match-me/api/middleware"
 	"match-me/config"
 	"match-me/ent"
-	"match-me/internal/pkg/cloudinary"
+	"match-me/internal/pkg/storage"
 	"match-me/internal/repositories/connections"
+	userRepo "match-me/internal/repositories/user"
 	"match-me/internal/requests"
 	connectionUsecases "match-me/internal/usecases/connections"
 	"match-me/internal/usecases/interactions"
@@ -30,7 +31,7 @@ func NewConnectionHandler(
 	client *ent.Client,
 	cfg *config.Config,
 	validationService *requests.ValidationService,
-	cld cloudinary.Cloudinary,
+	media storage.MediaStore,
 	wsService *websocket.WebSocketService,
 	userUsecase userUsecase.UserUsecase,
 	interactionUC interactions.UserInteractionUsecase,
@@ -40,11 +41,12 @@ func NewConnectionHandler(
 	connectionRepo := connections.NewConnectionRepository(client)
 	requestRepo := connections.NewConnectionRequestRepository(client)
 	messageRepo := connections.NewMessageRepository(client)
+	userRepository := userRepo.NewUserRepository(client)
 
 	// Create usecases
-	connectionUsecase := connectionUsecases.NewConnectionUsecase(messageRepo, connectionRepo, interactionUC, cld)
-	connectionRequestUsecase := connectionUsecases.NewConnectionRequestUsecase(requestRepo, connectionRepo, interactionUC, wsService)
-	messageUsecase := connectionUsecases.NewMessageUsecase(messageRepo, connectionRepo, cld, wsService)
+	connectionUsecase := connectionUsecases.NewConnectionUsecase(messageRepo, connectionRepo, interactionUC, media, wsService)
+	connectionRequestUsecase := connectionUsecases.NewConnectionRequestUsecase(requestRepo, connectionRepo, interactionUC, wsService, userRepository, cfg)
+	messageUsecase := connectionUsecases.NewMessageUsecase(messageRepo, connectionRepo, media, wsService, cfg)
 
 	return &ConnectionHandler{
 		ConnectionUsecase:        connectionUsecase,
@@ -73,9 +75,16 @@ func (h *ConnectionHandler) RegisterRoutes(r *gin.Engine) *gin.Engine {
 		requestGroup.GET("/", h.GetPendingRequests)
 		requestGroup.PUT("/:requestId/accept", h.AcceptRequest)
 		requestGroup.PUT("/:requestId/decline", h.DeclineRequest)
+		requestGroup.DELETE("/:requestId", h.WithdrawRequest)
 		requestGroup.POST("/skip", h.SkipConnection)
 	}
 
+	// Swipe routes
+	swipeGroup := r.Group("/swipes", middleware.VerifyUser(h.UserUsecase, h.cfg.JWTSecret))
+	{
+		swipeGroup.POST("/", h.Swipe)
+	}
+
 	// Message routes
 	messageGroup := r.Group("/messages", middleware.VerifyUser(h.UserUsecase, h.cfg.JWTSecret))
 	{
@@ -83,8 +92,16 @@ func (h *ConnectionHandler) RegisterRoutes(r *gin.Engine) *gin.Engine {
 		messageGroup.POST("/media", h.SendMediaMessage)
 		messageGroup.GET("/connection/:connectionId", h.GetConnectionMessages)
 		messageGroup.PUT("/connection/:connectionId/read", h.MarkMessagesAsRead)
+		messageGroup.GET("/connection/:connectionId/receipts", h.GetReadMarkers)
 		messageGroup.GET("/unread-count", h.GetUnreadCount)
 		messageGroup.GET("/chat-list", h.GetChatList)
+		messageGroup.GET("/search", h.SearchMessages)
+		messageGroup.PUT("/:messageId", h.EditMessage)
+		messageGroup.DELETE("/:messageId", h.DeleteMessage)
+		messageGroup.GET("/:messageId/edits", h.GetMessageEdits)
+		messageGroup.GET("/:messageId/replies", h.GetMessageReplies)
+		messageGroup.POST("/:messageId/reactions", h.AddReaction)
+		messageGroup.DELETE("/:messageId/reactions/:emoji", h.RemoveReaction)
 	}
 
 	log.Println("💫 All connection routes registered")
@@ -0,0 +1,159 @@
+package connection
+
+import (
+	"net/http"
+
+	"match-me/api/middleware"
+	"match-me/internal/requests"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EditMessage handles PUT /messages/:messageId
+func (h *ConnectionHandler) EditMessage(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	messageID, ok := parseMessageID(c)
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req requests.EditMessageBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request data",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	message, err := h.MessageUsecase.EditMessage(c.Request.Context(), user.ID, messageID, req.Content)
+	if err != nil {
+		switch err.Error() {
+		case "message content cannot be empty", "message content is too long", "media messages cannot be edited":
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request",
+				"details": err.Error(),
+			})
+		case "edit window has expired":
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Edit window expired",
+				"details": "This message can no longer be edited",
+			})
+		default:
+			respondMessageError(c, err, "Failed to edit message")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Message edited successfully",
+		"data":    message,
+	})
+}
+
+// DeleteMessage handles DELETE /messages/:messageId
+func (h *ConnectionHandler) DeleteMessage(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	messageID, ok := parseMessageID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.MessageUsecase.DeleteMessage(c.Request.Context(), user.ID, messageID); err != nil {
+		respondMessageError(c, err, "Failed to delete message")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Message unsent successfully",
+	})
+}
+
+// GetMessageEdits handles GET /messages/:messageId/edits
+func (h *ConnectionHandler) GetMessageEdits(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	messageID, ok := parseMessageID(c)
+	if !ok {
+		return
+	}
+
+	edits, err := h.MessageUsecase.GetMessageEdits(c.Request.Context(), user.ID, messageID)
+	if err != nil {
+		respondMessageError(c, err, "Failed to get message edits")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"edits": edits,
+		"count": len(edits),
+	})
+}
+
+// parseMessageID reads the messageId path parameter, responding with 400 when it is invalid
+func parseMessageID(c *gin.Context) (uuid.UUID, bool) {
+	messageID, err := uuid.Parse(c.Param("messageId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid message ID",
+			"details": "Message ID must be a valid UUID",
+		})
+		return uuid.Nil, false
+	}
+	return messageID, true
+}
+
+// respondMessageError maps the errors shared by the single message endpoints
+func respondMessageError(c *gin.Context, err error, fallback string) {
+	switch err.Error() {
+	case "message not found", "connection not found":
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Message not found",
+			"details": "The specified message does not exist",
+		})
+	case "unauthorized: user is not the sender of this message":
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":   "Access denied",
+			"details": "You can only change messages you sent",
+		})
+	case "unauthorized: user is not part of this connection":
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":   "Access denied",
+			"details": "You are not authorized to view this message",
+		})
+	case "connection is not active":
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":   "Connection inactive",
+			"details": "Cannot change messages in an inactive connection",
+		})
+	case "message has been deleted":
+		c.JSON(http.StatusGone, gin.H{
+			"error":   "Message deleted",
+			"details": "This message has been unsent",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   fallback,
+			"details": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package connection
+
+import (
+	"net/http"
+
+	"match-me/api/middleware"
+	"match-me/internal/requests"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AddReaction handles POST /messages/:messageId/reactions
+func (h *ConnectionHandler) AddReaction(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	messageID, ok := parseMessageID(c)
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req requests.ReactionBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request data",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	reactions, err := h.MessageUsecase.AddReaction(c.Request.Context(), user.ID, messageID, req.Emoji)
+	if err != nil {
+		respondReactionError(c, err, "Failed to add reaction")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   "Reaction added successfully",
+		"reactions": reactions,
+	})
+}
+
+// RemoveReaction handles DELETE /messages/:messageId/reactions/:emoji
+func (h *ConnectionHandler) RemoveReaction(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	messageID, ok := parseMessageID(c)
+	if !ok {
+		return
+	}
+
+	reactions, err := h.MessageUsecase.RemoveReaction(c.Request.Context(), user.ID, messageID, c.Param("emoji"))
+	if err != nil {
+		respondReactionError(c, err, "Failed to remove reaction")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   "Reaction removed successfully",
+		"reactions": reactions,
+	})
+}
+
+// respondReactionError maps reaction errors, falling back to the shared message errors
+func respondReactionError(c *gin.Context, err error, fallback string) {
+	switch err.Error() {
+	case "invalid emoji":
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid emoji",
+			"details": "Reactions must be a single emoji",
+		})
+	case "reaction not found":
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Reaction not found",
+			"details": "You have not reacted to this message with this emoji",
+		})
+	default:
+		respondMessageError(c, err, fallback)
+	}
+}
@@ -0,0 +1,133 @@
+package connection
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"match-me/api/middleware"
+	"match-me/internal/requests"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MarkMessagesAsRead handles PUT /messages/connection/:connectionId/read
+func (h *ConnectionHandler) MarkMessagesAsRead(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	// Parse connection ID
+	connectionID, err := uuid.Parse(c.Param("connectionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid connection ID",
+			"details": "Connection ID must be a valid UUID",
+		})
+		return
+	}
+
+	// The body is optional; without it everything is marked as read
+	var req requests.MarkReadBody
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request data",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Mark messages as read
+	messageIDs, err := h.MessageUsecase.MarkMessagesAsRead(c.Request.Context(), user.ID, connectionID, req.UpToMessageID)
+	if err != nil {
+		switch err.Error() {
+		case "connection not found":
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Connection not found",
+				"details": "The specified connection does not exist",
+			})
+		case "message not found":
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Message not found",
+				"details": "The specified message does not exist in this connection",
+			})
+		case "connection is not active":
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Connection inactive",
+				"details": "Cannot mark messages as read for an inactive connection",
+			})
+		case "unauthorized: user is not part of this connection":
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Access denied",
+				"details": "You are not authorized to mark messages as read in this connection",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to mark messages as read",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "Messages marked as read successfully",
+		"message_ids": messageIDs,
+		"count":       len(messageIDs),
+	})
+}
+
+// GetReadMarkers handles GET /messages/connection/:connectionId/receipts
+func (h *ConnectionHandler) GetReadMarkers(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	// Parse connection ID
+	connectionID, err := uuid.Parse(c.Param("connectionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid connection ID",
+			"details": "Connection ID must be a valid UUID",
+		})
+		return
+	}
+
+	markers, err := h.MessageUsecase.GetReadMarkers(c.Request.Context(), user.ID, connectionID)
+	if err != nil {
+		switch err.Error() {
+		case "connection not found":
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Connection not found",
+				"details": "The specified connection does not exist",
+			})
+		case "connection is not active":
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Connection inactive",
+				"details": "Cannot get read receipts for an inactive connection",
+			})
+		case "unauthorized: user is not part of this connection":
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Access denied",
+				"details": "You are not authorized to view read receipts in this connection",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to get read receipts",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"read_markers": markers,
+	})
+}
@@ -0,0 +1,59 @@
+package connection
+
+import (
+	"net/http"
+
+	"match-me/api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetMessageReplies handles GET /messages/:messageId/replies
+func (h *ConnectionHandler) GetMessageReplies(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	messageID, ok := parseMessageID(c)
+	if !ok {
+		return
+	}
+
+	replies, err := h.MessageUsecase.GetMessageReplies(c.Request.Context(), user.ID, messageID)
+	if err != nil {
+		respondMessageError(c, err, "Failed to get message replies")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"replies": replies,
+		"count":   len(replies),
+	})
+}
+
+// respondReplyError writes the response for an invalid reply target and reports whether it did
+func respondReplyError(c *gin.Context, err error) bool {
+	switch err.Error() {
+	case "reply target not found":
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Reply target not found",
+			"details": "The message being replied to does not exist",
+		})
+	case "reply target is not in this connection":
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid reply target",
+			"details": "You can only reply to messages in the same conversation",
+		})
+	case "cannot reply to a deleted message":
+		c.JSON(http.StatusGone, gin.H{
+			"error":   "Reply target deleted",
+			"details": "The message being replied to has been unsent",
+		})
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package connection
+
+import (
+	"net/http"
+	"strconv"
+
+	"match-me/api/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SearchMessages handles GET /messages/search
+func (h *ConnectionHandler) SearchMessages(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	// Optionally restrict the search to one connection
+	var connectionID *uuid.UUID
+	if connectionIDStr := c.Query("connection_id"); connectionIDStr != "" {
+		parsed, err := uuid.Parse(connectionIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid connection ID",
+				"details": "Connection ID must be a valid UUID",
+			})
+			return
+		}
+		connectionID = &parsed
+	}
+
+	// Parse optional page size
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid limit",
+				"details": "Limit must be a positive integer",
+			})
+			return
+		}
+		limit = parsed
+	}
+
+	page, err := h.MessageUsecase.SearchMessages(c.Request.Context(), user.ID, connectionID, c.Query("q"), c.Query("cursor"), limit)
+	if err != nil {
+		switch err.Error() {
+		case "search query cannot be empty":
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid query",
+				"details": "The q parameter is required",
+			})
+		case "invalid cursor":
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid cursor",
+				"details": err.Error(),
+			})
+		case "connection not found":
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Connection not found",
+				"details": "The specified connection does not exist",
+			})
+		case "unauthorized: user is not part of this connection":
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Access denied",
+				"details": "You are not authorized to search messages in this connection",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to search messages",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"results":     page.Results,
+		"count":       len(page.Results),
+		"next_cursor": page.NextCursor,
+		"has_more":    page.HasMore,
+	})
+}
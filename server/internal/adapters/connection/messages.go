@@ -3,7 +3,6 @@ package connection
 import (
 	"log"
 	"net/http"
-	"strconv"
 
 	"match-me/api/middleware"
 	"match-me/internal/requests"
@@ -37,8 +36,11 @@ func (h *ConnectionHandler) SendTextMessage(c *gin.Context) {
 	}
 
 	// Send text message
-	message, err := h.MessageUsecase.SendTextMessage(c.Request.Context(), user.ID, req.ConnectionID, req.Content)
+	message, err := h.MessageUsecase.SendTextMessage(c.Request.Context(), user.ID, req.ConnectionID, req.Content, req.ReplyToID, req.ClientMessageID)
 	if err != nil {
+		if respondReplyError(c, err) {
+			return
+		}
 		if err.Error() == "connection not found" {
 			c.JSON(http.StatusNotFound, gin.H{
 				"error":   "Connection not found",
@@ -67,6 +69,27 @@ func (h *ConnectionHandler) SendTextMessage(c *gin.Context) {
 			})
 			return
 		}
+		if err.Error() == "message content is too long" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid message",
+				"details": "Message content must be at most 4000 characters",
+			})
+			return
+		}
+		if err.Error() == "client message ID is too long" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid client message ID",
+				"details": "Client message ID must be at most 64 characters",
+			})
+			return
+		}
+		if err.Error() == "client message ID already used" {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Duplicate client message ID",
+				"details": "This client message ID was already used in another conversation",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to send message",
 			"details": err.Error(),
@@ -120,9 +143,36 @@ func (h *ConnectionHandler) SendMediaMessage(c *gin.Context) {
 
 	caption := c.PostForm("text")
 
+	// Parse the optional message being replied to
+	var replyToID *uuid.UUID
+	if replyToIDStr := c.PostForm("reply_to_id"); replyToIDStr != "" {
+		parsed, err := uuid.Parse(replyToIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid reply_to_id",
+				"details": "Reply target must be a valid UUID",
+			})
+			return
+		}
+		replyToID = &parsed
+	}
+
+	mediaFile, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to open media file",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer mediaFile.Close()
+
 	// Send media message
-	message, err := h.MessageUsecase.SendMediaMessage(c.Request.Context(), user.ID, connectionID, file, caption)
+	message, err := h.MessageUsecase.SendMediaMessage(c.Request.Context(), user.ID, connectionID, mediaFile, caption, replyToID)
 	if err != nil {
+		if respondReplyError(c, err) {
+			return
+		}
 		if err.Error() == "connection not found" {
 			c.JSON(http.StatusNotFound, gin.H{
 				"error":   "Connection not found",
@@ -178,89 +228,32 @@ func (h *ConnectionHandler) GetConnectionMessages(c *gin.Context) {
 	}
 
 	// Parse pagination parameters
-	limitStr := c.DefaultQuery("limit", "50")
-	offsetStr := c.DefaultQuery("offset", "0")
-
-	limit, err := strconv.Atoi(limitStr)
-	if err != nil || limit < 0 || limit > 100 {
+	var query requests.MessageHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid limit",
-			"details": "Limit must be a number between 0 and 100",
-		})
-		return
-	}
-
-	offset, err := strconv.Atoi(offsetStr)
-	if err != nil || offset < 0 {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid offset",
-			"details": "Offset must be a non-negative number",
+			"error":   "Invalid pagination parameters",
+			"details": "Limit must be a number between 1 and 100",
 		})
 		return
 	}
 
 	// Get connection messages
-	messages, err := h.MessageUsecase.GetConnectionMessages(c.Request.Context(), user.ID, connectionID, limit, offset)
+	page, err := h.MessageUsecase.GetConnectionMessages(c.Request.Context(), user.ID, connectionID, query)
 	if err != nil {
-		if err.Error() == "connection not found" {
-			c.JSON(http.StatusNotFound, gin.H{
-				"error":   "Connection not found",
-				"details": "The specified connection does not exist",
-			})
-			return
-		}
-		if err.Error() == "connection is not active" {
-			c.JSON(http.StatusForbidden, gin.H{
-				"error":   "Connection inactive",
-				"details": "Cannot access messages from an inactive connection",
+		switch err.Error() {
+		case "only one of before, after or around can be set", "invalid cursor", "invalid message ID":
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid pagination parameters",
+				"details": err.Error(),
 			})
 			return
-		}
-		if err.Error() == "unauthorized: user is not part of this connection" {
-			c.JSON(http.StatusForbidden, gin.H{
-				"error":   "Access denied",
-				"details": "You are not authorized to access messages from this connection",
+		case "message not found":
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Message not found",
+				"details": "The requested message does not exist in this connection",
 			})
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to get messages",
-			"details": err.Error(),
-		})
-		return
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"messages": messages,
-		"count":    len(messages),
-		"limit":    limit,
-		"offset":   offset,
-	})
-}
-
-// MarkMessagesAsRead handles PUT /messages/connection/:connectionId/read
-func (h *ConnectionHandler) MarkMessagesAsRead(c *gin.Context) {
-	// Get authenticated user
-	user, exists := middleware.GetUserFromGinContext(c)
-	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
-		return
-	}
-
-	// Parse connection ID
-	connectionIDStr := c.Param("connectionId")
-	connectionID, err := uuid.Parse(connectionIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid connection ID",
-			"details": "Connection ID must be a valid UUID",
-		})
-		return
-	}
-
-	// Mark messages as read
-	err = h.MessageUsecase.MarkMessagesAsRead(c.Request.Context(), user.ID, connectionID)
-	if err != nil {
 		if err.Error() == "connection not found" {
 			c.JSON(http.StatusNotFound, gin.H{
 				"error":   "Connection not found",
@@ -271,26 +264,31 @@ func (h *ConnectionHandler) MarkMessagesAsRead(c *gin.Context) {
 		if err.Error() == "connection is not active" {
 			c.JSON(http.StatusForbidden, gin.H{
 				"error":   "Connection inactive",
-				"details": "Cannot mark messages as read for an inactive connection",
+				"details": "Cannot access messages from an inactive connection",
 			})
 			return
 		}
 		if err.Error() == "unauthorized: user is not part of this connection" {
 			c.JSON(http.StatusForbidden, gin.H{
 				"error":   "Access denied",
-				"details": "You are not authorized to mark messages as read in this connection",
+				"details": "You are not authorized to access messages from this connection",
 			})
 			return
 		}
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to mark messages as read",
+			"error":   "Failed to get messages",
 			"details": err.Error(),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Messages marked as read successfully",
+		"messages":      page.Messages,
+		"count":         len(page.Messages),
+		"before_cursor": page.BeforeCursor,
+		"after_cursor":  page.AfterCursor,
+		"has_older":     page.HasOlder,
+		"has_newer":     page.HasNewer,
 	})
 }
 
@@ -2,6 +2,7 @@ package connection
 
 import (
 	"net/http"
+	"time"
 
 	"match-me/api/middleware"
 
@@ -11,8 +12,9 @@ import (
 
 // SendConnectionRequestBody represents the request body for sending a connection request
 type SendConnectionRequestBody struct {
-	ReceiverID uuid.UUID `json:"receiver_id" binding:"required"`
-	Message    string    `json:"message,omitempty"`
+	ReceiverID uuid.UUID  `json:"receiver_id" binding:"required"`
+	Message    string     `json:"message,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
 }
 
 // SendConnectionRequest handles POST /connection-requests
@@ -35,7 +37,7 @@ func (h *ConnectionHandler) SendConnectionRequest(c *gin.Context) {
 	}
 
 	// Send connection request
-	request, err := h.ConnectionRequestUsecase.SendRequest(c.Request.Context(), user.ID, req.ReceiverID, req.Message)
+	request, err := h.ConnectionRequestUsecase.SendRequest(c.Request.Context(), user.ID, req.ReceiverID, req.Message, req.ExpiresAt)
 	if err != nil {
 		if err.Error() == "cannot send connection request to yourself" {
 			c.JSON(http.StatusBadRequest, gin.H{
@@ -44,6 +46,27 @@ func (h *ConnectionHandler) SendConnectionRequest(c *gin.Context) {
 			})
 			return
 		}
+		if err.Error() == "invalid expiry time" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request",
+				"details": "Expiry time must be in the future and within the allowed request lifetime",
+			})
+			return
+		}
+		if err.Error() == "email address not verified" {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Email not verified",
+				"details": "Please verify your email address before sending connection requests",
+			})
+			return
+		}
+		if err.Error() == "receiver not found" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "User not found",
+				"details": "The user you are trying to connect with does not exist",
+			})
+			return
+		}
 		if err.Error() == "connection already exists between users" {
 			c.JSON(http.StatusConflict, gin.H{
 				"error":   "Connection exists",
@@ -140,6 +163,13 @@ func (h *ConnectionHandler) AcceptRequest(c *gin.Context) {
 			})
 			return
 		}
+		if err.Error() == "request has expired" {
+			c.JSON(http.StatusGone, gin.H{
+				"error":   "Request expired",
+				"details": "This connection request has expired",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to accept request",
 			"details": err.Error(),
@@ -197,6 +227,13 @@ func (h *ConnectionHandler) DeclineRequest(c *gin.Context) {
 			})
 			return
 		}
+		if err.Error() == "request has expired" {
+			c.JSON(http.StatusGone, gin.H{
+				"error":   "Request expired",
+				"details": "This connection request has expired",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to decline request",
 			"details": err.Error(),
@@ -208,3 +245,59 @@ func (h *ConnectionHandler) DeclineRequest(c *gin.Context) {
 		"message": "Connection request declined successfully",
 	})
 }
+
+// WithdrawRequest handles DELETE /connection-requests/:requestId
+func (h *ConnectionHandler) WithdrawRequest(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	// Parse request ID
+	requestIDStr := c.Param("requestId")
+	requestID, err := uuid.Parse(requestIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request ID",
+			"details": "Request ID must be a valid UUID",
+		})
+		return
+	}
+
+	// Withdraw request
+	err = h.ConnectionRequestUsecase.WithdrawRequest(c.Request.Context(), user.ID, requestID)
+	if err != nil {
+		if err.Error() == "connection request not found" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Request not found",
+				"details": "The specified connection request does not exist",
+			})
+			return
+		}
+		if err.Error() == "unauthorized: user is not the sender of this request" {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Access denied",
+				"details": "You are not authorized to withdraw this request",
+			})
+			return
+		}
+		if err.Error() == "request is no longer pending" {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Request not pending",
+				"details": "This request has already been responded to",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to withdraw request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Connection request withdrawn successfully",
+	})
+}
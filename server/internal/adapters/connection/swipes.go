@@ -0,0 +1,83 @@
+package connection
+
+import (
+	"net/http"
+
+	"match-me/api/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SwipeBody represents the request body for swiping on a profile
+type SwipeBody struct {
+	TargetUserID uuid.UUID `json:"target_user_id" binding:"required"`
+	Action       string    `json:"action" binding:"required,oneof=like super_like pass"`
+}
+
+// Swipe handles POST /swipes
+func (h *ConnectionHandler) Swipe(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	// Parse request body
+	var req SwipeBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request data",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	result, err := h.ConnectionRequestUsecase.Swipe(c.Request.Context(), user.ID, req.TargetUserID, req.Action)
+	if err != nil {
+		switch err.Error() {
+		case "cannot swipe on yourself", "invalid swipe action":
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request",
+				"details": err.Error(),
+			})
+		case "email address not verified":
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Email not verified",
+				"details": "Please verify your email address before liking profiles",
+			})
+		case "target user not found":
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "User not found",
+				"details": "The user you are trying to swipe on does not exist",
+			})
+		case "connection already exists between users":
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Connection exists",
+				"details": "You are already connected to this user",
+			})
+		case "user already liked":
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Already liked",
+				"details": "You have already liked this user",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to record swipe",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	message := "Swipe recorded successfully"
+	if result.Matched {
+		message = "It's a match"
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": message,
+		"result":  result,
+	})
+}
@@ -0,0 +1,360 @@
+package event
+
+import (
+	"net/http"
+	"strings"
+
+	"match-me/api/middleware"
+	"match-me/internal/requests"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CreateEvent handles POST /events
+func (h *EventHandler) CreateEvent(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	// Parse request body
+	var req requests.CreateEventBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request data",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Create event
+	event, err := h.EventUsecase.CreateEvent(c.Request.Context(), user.ID, req)
+	if err != nil {
+		if err.Error() == "start time must be in the future" || err.Error() == "end time must be after start time" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid event time",
+				"details": err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to create event",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Event created successfully",
+		"event":   event,
+	})
+}
+
+// GetUserEvents handles GET /events
+// Returns the upcoming events the user created or responded to
+func (h *EventHandler) GetUserEvents(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	events, err := h.EventUsecase.GetUserEvents(c.Request.Context(), user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get events",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"events": events,
+		"count":  len(events),
+	})
+}
+
+// GetNearbyEvents handles GET /events/nearby
+// Query parameters: lat, lng, radius_km, limit
+// Without lat and lng the user's own location and preferred distance are used
+func (h *EventHandler) GetNearbyEvents(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	// Parse query parameters
+	var query requests.NearbyEventsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid query parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	events, err := h.EventUsecase.GetNearbyEvents(c.Request.Context(), user.ID, query)
+	if err != nil {
+		if err.Error() == "location is required" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Location required",
+				"details": "Provide lat and lng or set your location in your profile",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get nearby events",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"events": events,
+		"count":  len(events),
+	})
+}
+
+// GetEvent handles GET /events/:eventId
+func (h *EventHandler) GetEvent(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	event, err := h.EventUsecase.GetEvent(c.Request.Context(), user.ID, eventID)
+	if err != nil {
+		if err.Error() == "event not found" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Event not found",
+				"details": "The specified event does not exist",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get event",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"event": event,
+	})
+}
+
+// UpdateEvent handles PUT /events/:eventId
+func (h *EventHandler) UpdateEvent(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req requests.UpdateEventBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request data",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	event, err := h.EventUsecase.UpdateEvent(c.Request.Context(), user.ID, eventID, req)
+	if err != nil {
+		if err.Error() == "event not found" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Event not found",
+				"details": "The specified event does not exist",
+			})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "unauthorized:") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Access denied",
+				"details": "Only the event creator can change this event",
+			})
+			return
+		}
+		if err.Error() == "start time must be in the future" || err.Error() == "end time must be after start time" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid event time",
+				"details": err.Error(),
+			})
+			return
+		}
+		if err.Error() == "event has been cancelled" {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Event cancelled",
+				"details": "A cancelled event cannot be changed",
+			})
+			return
+		}
+		if err.Error() == "max participants is below the number of confirmed participants" {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Capacity too low",
+				"details": "More participants have already confirmed than the new capacity allows",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to update event",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Event updated successfully",
+		"event":   event,
+	})
+}
+
+// CancelEvent handles DELETE /events/:eventId
+func (h *EventHandler) CancelEvent(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	err := h.EventUsecase.CancelEvent(c.Request.Context(), user.ID, eventID)
+	if err != nil {
+		if err.Error() == "event not found" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Event not found",
+				"details": "The specified event does not exist",
+			})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "unauthorized:") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Access denied",
+				"details": "Only the event creator can cancel this event",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to cancel event",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Event cancelled successfully",
+	})
+}
+
+// RSVP handles PUT /events/:eventId/rsvp
+func (h *EventHandler) RSVP(c *gin.Context) {
+	// Get authenticated user
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req requests.RSVPBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request data",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	event, err := h.EventUsecase.RSVP(c.Request.Context(), user.ID, eventID, req.Status)
+	if err != nil {
+		if err.Error() == "event not found" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Event not found",
+				"details": "The specified event does not exist",
+			})
+			return
+		}
+		if err.Error() == "event creator cannot change their RSVP" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request",
+				"details": "The event creator always takes part in their event",
+			})
+			return
+		}
+		if err.Error() == "event has been cancelled" {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Event cancelled",
+				"details": "This event has been cancelled",
+			})
+			return
+		}
+		if err.Error() == "event has already ended" {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Event ended",
+				"details": "This event has already ended",
+			})
+			return
+		}
+		if err.Error() == "event is full" {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Event full",
+				"details": "This event has reached its maximum number of participants",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to save RSVP",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "RSVP saved successfully",
+		"event":   event,
+	})
+}
+
+// parseEventID reads the event ID from the path, responding with an error when it is invalid
+func parseEventID(c *gin.Context) (uuid.UUID, bool) {
+	eventID, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid event ID",
+			"details": "Event ID must be a valid UUID",
+		})
+		return uuid.Nil, false
+	}
+	return eventID, true
+}
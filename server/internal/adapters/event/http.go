@@ -0,0 +1,59 @@
+package event
+
+import (
+	"log"
+	"match-me/api/middleware"
+	"match-me/config"
+	"match-me/ent"
+	"match-me/internal/repositories/events"
+	userRepo "match-me/internal/repositories/user"
+	eventUsecases "match-me/internal/usecases/events"
+	userUsecase "match-me/internal/usecases/user"
+	"match-me/internal/websocket"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EventHandler struct {
+	EventUsecase eventUsecases.EventUsecase
+	UserUsecase  userUsecase.UserUsecase
+	cfg          *config.Config
+}
+
+func NewEventHandler(
+	client *ent.Client,
+	cfg *config.Config,
+	wsService *websocket.WebSocketService,
+	userUsecase userUsecase.UserUsecase,
+) *EventHandler {
+
+	// Create repositories
+	eventRepo := events.NewEventRepository(client)
+	userRepository := userRepo.NewUserRepository(client)
+
+	// Create usecases
+	eventUsecase := eventUsecases.NewEventUsecase(eventRepo, userRepository, wsService)
+
+	return &EventHandler{
+		EventUsecase: eventUsecase,
+		UserUsecase:  userUsecase,
+		cfg:          cfg,
+	}
+}
+
+func (h *EventHandler) RegisterRoutes(r *gin.Engine) *gin.Engine {
+	// All event routes require authentication
+	eventGroup := r.Group("/events", middleware.VerifyUser(h.UserUsecase, h.cfg.JWTSecret))
+	{
+		eventGroup.GET("/", h.GetUserEvents)
+		eventGroup.POST("/", h.CreateEvent)
+		eventGroup.GET("/nearby", h.GetNearbyEvents)
+		eventGroup.GET("/:eventId", h.GetEvent)
+		eventGroup.PUT("/:eventId", h.UpdateEvent)
+		eventGroup.DELETE("/:eventId", h.CancelEvent)
+		eventGroup.PUT("/:eventId/rsvp", h.RSVP)
+	}
+
+	log.Println("💫 All event routes registered")
+	return r
+}
@@ -0,0 +1,54 @@
+package media
+
+import (
+	"fmt"
+	"log"
+	"match-me/internal/pkg/storage"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MediaHandler struct {
+	store *storage.LocalStore
+}
+
+func NewMediaHandler(store *storage.LocalStore) *MediaHandler {
+	return &MediaHandler{
+		store: store,
+	}
+}
+
+func (h *MediaHandler) RegisterRoutes(r *gin.Engine) *gin.Engine {
+	// Public route, access is granted by the URL signature
+	r.GET("/media/*key", h.ServeMedia)
+
+	log.Println("🖼️ Media routes registered")
+	return r
+}
+
+// ServeMedia handles GET /media/*key?expires=...&sig=...
+func (h *MediaHandler) ServeMedia(c *gin.Context) {
+	path, expiresAt, err := h.store.Open(c.Param("key"), c.Query("expires"), c.Query("sig"))
+	if err != nil {
+		switch err.Error() {
+		case "media not found":
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Media not found",
+				"details": err.Error(),
+			})
+		default:
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Access denied",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	// Every upload gets its own key and is never overwritten, so it can be cached until the URL expires
+	maxAge := int(time.Until(expiresAt).Seconds())
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d, immutable", maxAge))
+	c.File(path)
+}
@@ -4,6 +4,7 @@ import (
 	"match-me/api/middleware"
 	"match-me/internal/models"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -88,8 +89,60 @@ func (h *UserHandler) GetRecommendations(c *gin.Context) {
 		return
 	}
 
+	// Keep the plain ID list for existing clients, with the scored breakdown alongside
+	userIDs := make([]string, len(recommendations))
+	for i, recommendation := range recommendations {
+		userIDs[i] = recommendation.UserID.String()
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":         "Recommendations retrieved successfully",
+		"recommendations": userIDs,
+		"results":         recommendations,
+	})
+}
+
+func (h *UserHandler) GetRecommendationFeed(c *gin.Context) {
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found in context"})
+		return
+	}
+
+	// Parse optional page size
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid limit",
+				"details": "Limit must be a positive integer",
+			})
+			return
+		}
+		limit = parsed
+	}
+
+	page, err := h.UserUsecase.GetRecommendationFeed(c.Request.Context(), user.ID, c.Query("cursor"), limit)
+	if err != nil {
+		if err.Error() == "invalid cursor" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid cursor",
+				"details": err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get recommendations",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"message":         "Recommendations retrieved successfully",
-		"recommendations": recommendations,
+		"recommendations": page.Recommendations,
+		"next_cursor":     page.NextCursor,
+		"has_more":        page.HasMore,
 	})
 }
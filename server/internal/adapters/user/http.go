@@ -5,12 +5,16 @@ import (
 	"match-me/api/middleware"
 	"match-me/config"
 	"match-me/ent"
-	"match-me/internal/pkg/cloudinary"
+	"match-me/internal/pkg/mailer"
+	"match-me/internal/pkg/matching"
+	"match-me/internal/pkg/storage"
 	"match-me/internal/repositories/connections"
+	sessionRepo "match-me/internal/repositories/session"
 	userRepo "match-me/internal/repositories/user"
 	"match-me/internal/requests"
 	"match-me/internal/usecases/interactions"
 	userUsecase "match-me/internal/usecases/user"
+	"match-me/internal/websocket"
 
 	"github.com/gin-gonic/gin"
 )
@@ -29,10 +33,14 @@ func NewUserHandler(client *ent.Client, cfg *config.Config,
 	connReqRepo connections.ConnectionRequestRepository,
 	interactionUC interactions.UserInteractionUsecase,
 	validationService *requests.ValidationService,
-	cld cloudinary.Cloudinary) *UserHandler {
+	media storage.MediaStore,
+	mail mailer.Mailer,
+	feedCache *matching.FeedCache,
+	wsService *websocket.WebSocketService) *UserHandler {
 
 	userRepo := userRepo.NewUserRepository(client)
-	userUsecase := userUsecase.NewUserUsecase(userRepo, connRepo, connReqRepo, interactionUC, cfg.JWTSecret, cld)
+	sessionRepo := sessionRepo.NewSessionRepository(client)
+	userUsecase := userUsecase.NewUserUsecase(userRepo, sessionRepo, connRepo, connReqRepo, interactionUC, cfg, media, mail, feedCache, wsService)
 	return &UserHandler{
 		UserUsecase:       userUsecase,
 		validationService: validationService,
@@ -47,6 +55,11 @@ func (h *UserHandler) RegisterRoutes(r *gin.Engine) *gin.Engine {
 	{
 		authGroup.POST("/register", h.Register)
 		authGroup.POST("/login", h.Login)
+		authGroup.POST("/refresh", h.RefreshToken)
+		authGroup.POST("/logout", h.Logout)
+		authGroup.POST("/forgot-password", h.ForgotPassword)
+		authGroup.POST("/reset-password", h.ResetPassword)
+		authGroup.POST("/verify-email", h.VerifyEmail)
 	}
 
 	// Protected routes (authentication required)
@@ -65,9 +78,18 @@ func (h *UserHandler) RegisterRoutes(r *gin.Engine) *gin.Engine {
 		userMeGroup.PUT("/me", h.UpdateUser)
 		userMeGroup.DELETE("/me", h.DeleteCurrentUser)
 		userMeGroup.PUT("/password", h.UpdatePassword)
+		userMeGroup.GET("/me/status", h.GetStatus)
+		userMeGroup.PUT("/me/status", h.UpdateStatus)
 		userMeGroup.POST("/me/photos", h.UploadUserPhotos)
+		userMeGroup.PUT("/me/photos/order", h.ReorderUserPhotos)
+		userMeGroup.PUT("/me/photos/:photoId", h.ReplaceUserPhoto)
+		userMeGroup.PUT("/me/photos/:photoId/primary", h.SetPrimaryPhoto)
 		userMeGroup.DELETE("/me/photos/:photoId", h.DeleteUserPhoto)
 		userMeGroup.GET("/me/recommendations", h.GetRecommendations)
+		userMeGroup.GET("/me/recommendations/feed", h.GetRecommendationFeed)
+		userMeGroup.POST("/me/verify-email/resend", h.ResendVerification)
+		userMeGroup.GET("/me/sessions", h.GetSessions)
+		userMeGroup.DELETE("/me/sessions/:sessionId", h.RevokeSession)
 	}
 
 	log.Println("💫 All user routes registered")
@@ -29,7 +29,7 @@ func (h *UserHandler) Login(c *gin.Context) {
 	}
 
 	// Login user
-	user, token, err := h.UserUsecase.Login(c.Request.Context(), req.Email, req.Password)
+	user, tokens, err := h.UserUsecase.Login(c.Request.Context(), req.Email, req.Password, sessionMeta(c, req.Device))
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   "Login failed",
@@ -40,8 +40,11 @@ func (h *UserHandler) Login(c *gin.Context) {
 
 	// Return success response
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Login successful",
-		"user":    user,
-		"token":   token,
+		"message":            "Login successful",
+		"user":               user,
+		"token":              tokens.AccessToken,
+		"expires_at":         tokens.AccessExpiresAt,
+		"refresh_token":      tokens.RefreshToken,
+		"refresh_expires_at": tokens.RefreshExpiresAt,
 	})
 }
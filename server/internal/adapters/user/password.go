@@ -0,0 +1,83 @@
+package user
+
+import (
+	"log"
+	"match-me/internal/requests"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *UserHandler) ForgotPassword(c *gin.Context) {
+	var req requests.ForgotPasswordRequest
+
+	// Bind JSON request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Validate request
+	if err := h.validationService.Validate(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Validation failed",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Send reset link; failures are only logged so the response never reveals whether the email exists
+	if err := h.UserUsecase.ForgotPassword(c.Request.Context(), req.Email); err != nil {
+		log.Printf("forgot password failed: %v", err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "If an account with that email exists, a password reset link has been sent",
+	})
+}
+
+func (h *UserHandler) ResetPassword(c *gin.Context) {
+	var req requests.ResetPasswordRequest
+
+	// Bind JSON request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Validate request
+	if err := h.validationService.Validate(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Validation failed",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Reset password
+	if err := h.UserUsecase.ResetPassword(c.Request.Context(), req.Token, req.NewPassword); err != nil {
+		switch err.Error() {
+		case "invalid or expired reset token", "reset token has already been used":
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Password reset failed",
+				"details": err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Password reset failed",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Password has been reset. Please login with your new password.",
+	})
+}
@@ -0,0 +1,167 @@
+package user
+
+import (
+	"errors"
+	"match-me/api/middleware"
+	"match-me/internal/pkg/imaging"
+	"match-me/internal/requests"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func (h *UserHandler) ReplaceUserPhoto(c *gin.Context) {
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(401, gin.H{"error": "User not found in context"})
+		return
+	}
+
+	// Parse photo ID
+	photoID, err := uuid.Parse(c.Param("photoId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid photo ID",
+			"details": "Photo ID must be a valid UUID",
+		})
+		return
+	}
+
+	// Get the replacement file from form
+	fileHeader, err := c.FormFile("photo")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "No photo provided",
+			"details": "A photo file is required",
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to open uploaded file",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	// Replace photo via usecase
+	photo, err := h.UserUsecase.ReplaceUserPhoto(c.Request.Context(), user.ID, photoID, file)
+	if err != nil {
+		if errors.Is(err, imaging.ErrUnsupportedFormat) || errors.Is(err, imaging.ErrTooLarge) || errors.Is(err, imaging.ErrDimensions) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid image",
+				"details": err.Error(),
+			})
+			return
+		}
+		if err.Error() == "photo not found for user" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Photo not found",
+				"details": err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to replace photo",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Photo replaced successfully",
+		"photo":   photo,
+	})
+}
+
+func (h *UserHandler) ReorderUserPhotos(c *gin.Context) {
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(401, gin.H{"error": "User not found in context"})
+		return
+	}
+
+	var req requests.ReorderPhotosRequest
+
+	// Bind JSON request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Validate request
+	if err := h.validationService.Validate(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Validation failed",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	photos, err := h.UserUsecase.ReorderUserPhotos(c.Request.Context(), user.ID, req.PhotoIDs)
+	if err != nil {
+		if err.Error() == "photo order must list each photo exactly once" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid photo order",
+				"details": err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to reorder photos",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Photos reordered successfully",
+		"photos":  photos,
+	})
+}
+
+func (h *UserHandler) SetPrimaryPhoto(c *gin.Context) {
+	user, exists := middleware.GetUserFromGinContext(c)
+	if !exists {
+		c.JSON(401, gin.H{"error": "User not found in context"})
+		return
+	}
+
+	// Parse photo ID
+	photoID, err := uuid.Parse(c.Param("photoId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid photo ID",
+			"details": "Photo ID must be a valid UUID",
+		})
+		return
+	}
+
+	photos, err := h.UserUsecase.SetPrimaryPhoto(c.Request.Context(), user.ID, photoID)
+	if err != nil {
+		if err.Error() == "photo not found for user" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "Photo not found",
+				"details": err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to set primary photo",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Primary photo updated successfully",
+		"photos":  photos,
+	})
+}
@@ -30,7 +30,7 @@ func (h *UserHandler) Register(c *gin.Context) {
 	}
 
 	// Register user
-	user, token, err := h.UserUsecase.Register(c.Request.Context(), req)
+	user, tokens, err := h.UserUsecase.Register(c.Request.Context(), req, sessionMeta(c, req.Device))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Registration failed",
@@ -41,8 +41,11 @@ func (h *UserHandler) Register(c *gin.Context) {
 
 	// Return success response
 	c.JSON(http.StatusCreated, gin.H{
-		"message": "User registered successfully",
-		"user":    user,
-		"token":   token,
+		"message":            "User registered successfully",
+		"user":               user,
+		"token":              tokens.AccessToken,
+		"expires_at":         tokens.AccessExpiresAt,
+		"refresh_token":      tokens.RefreshToken,
+		"refresh_expires_at": tokens.RefreshExpiresAt,
 	})
 }
@@ -82,8 +82,15 @@ func (h *UserHandler) UpdatePassword(c *gin.Context) {
 	}
 
 	// Update password
-	err := h.UserUsecase.UpdatePassword(c.Request.Context(), user.ID, req.NewPassword)
+	err := h.UserUsecase.UpdatePassword(c.Request.Context(), user.ID, req.CurrentPassword, req.NewPassword)
 	if err != nil {
+		if err.Error() == "current password is incorrect" {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":   "Password update failed",
+				"details": err.Error(),
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Password update failed",
 			"details": err.Error(),
@@ -1,11 +1,12 @@
 package user
 
 import (
+	"errors"
+	"io"
 	"match-me/api/middleware"
+	"match-me/internal/pkg/imaging"
 	"mime/multipart"
 	"net/http"
-	"path/filepath"
-	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -38,20 +39,11 @@ func (h *UserHandler) UploadUserPhotos(c *gin.Context) {
 		return
 	}
 
-	// Convert multipart files to interface{} slice for usecase
-	var fileInterfaces []interface{}
+	// Convert multipart files to readers for usecase
+	var fileReaders []io.Reader
 	var openFiles []multipart.File
 
 	for _, fileHeader := range files {
-		// Validate file type (basic image validation)
-		if !isValidImageFile(fileHeader.Filename) {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error":   "Invalid file type",
-				"details": "Only image files (jpg, jpeg, png, gif, webp) are allowed",
-			})
-			return
-		}
-
 		// Open the file
 		file, err := fileHeader.Open()
 		if err != nil {
@@ -67,7 +59,7 @@ func (h *UserHandler) UploadUserPhotos(c *gin.Context) {
 		}
 
 		openFiles = append(openFiles, file)
-		fileInterfaces = append(fileInterfaces, file)
+		fileReaders = append(fileReaders, file)
 	}
 
 	// Ensure all files are closed after processing
@@ -78,8 +70,31 @@ func (h *UserHandler) UploadUserPhotos(c *gin.Context) {
 	}()
 
 	// Upload photos via usecase
-	photos, err := h.UserUsecase.UploadUserPhotos(c.Request.Context(), user.ID, fileInterfaces)
+	photos, err := h.UserUsecase.UploadUserPhotos(c.Request.Context(), user.ID, fileReaders)
 	if err != nil {
+		// Content checks are done on the decoded image, not the file name
+		if errors.Is(err, imaging.ErrUnsupportedFormat) || errors.Is(err, imaging.ErrTooLarge) || errors.Is(err, imaging.ErrDimensions) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid image",
+				"details": err.Error(),
+			})
+			return
+		}
+		if err.Error() == "photo limit reached" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":      "Photo limit reached",
+				"details":    "Delete a photo before uploading more",
+				"max_photos": h.cfg.MaxPhotosPerUser,
+			})
+			return
+		}
+		if err.Error() == "photos were modified concurrently, please retry" {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Failed to upload photos",
+				"details": err.Error(),
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to upload photos",
 			"details": err.Error(),
@@ -94,16 +109,3 @@ func (h *UserHandler) UploadUserPhotos(c *gin.Context) {
 		"count":   len(photos),
 	})
 }
-
-// isValidImageFile checks if the file has a valid image extension
-func isValidImageFile(filename string) bool {
-	ext := strings.ToLower(filepath.Ext(filename))
-	validExtensions := []string{".jpg", ".jpeg", ".png", ".gif", ".webp"}
-
-	for _, validExt := range validExtensions {
-		if ext == validExt {
-			return true
-		}
-	}
-	return false
-}
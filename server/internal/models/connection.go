@@ -2,6 +2,7 @@ package models
 
 import (
 	"match-me/ent"
+	entconnection "match-me/ent/connection"
 
 	"github.com/google/uuid"
 )
@@ -27,12 +28,20 @@ type ConnectionRequest struct {
 	Status     string    `json:"status"`
 	Message    *string   `json:"message,omitempty"`
 	CreatedAt  string    `json:"created_at"`
+	ExpiresAt  *string   `json:"expires_at,omitempty"`
 
 	// User details (when loaded with edges)
 	Sender   *User `json:"sender,omitempty"`
 	Receiver *User `json:"receiver,omitempty"`
 }
 
+// SwipeResult is the outcome of a swipe, with the new connection when it was a match
+type SwipeResult struct {
+	Action     string      `json:"action"`
+	Matched    bool        `json:"matched"`
+	Connection *Connection `json:"connection,omitempty"`
+}
+
 // Message represents a message between connected users
 type Message struct {
 	ID           uuid.UUID `json:"id"`
@@ -45,7 +54,18 @@ type Message struct {
 	MediaType    *string   `json:"media_type,omitempty"`
 	IsRead       bool      `json:"is_read"`
 	CreatedAt    string    `json:"created_at"`
+	DeliveredAt  *string   `json:"delivered_at,omitempty"`
 	ReadAt       *string   `json:"read_at,omitempty"`
+	EditedAt     *string   `json:"edited_at,omitempty"`
+	IsDeleted    bool      `json:"is_deleted"`
+	DeletedAt    *string   `json:"deleted_at,omitempty"`
+
+	// The message this one replies to; the preview is included when loaded with edges
+	ReplyToID *uuid.UUID      `json:"reply_to_id,omitempty"`
+	ReplyTo   *MessagePreview `json:"reply_to,omitempty"`
+
+	// Reactions grouped by emoji (when loaded with edges)
+	Reactions []*ReactionSummary `json:"reactions,omitempty"`
 
 	// User and connection details (when loaded with edges)
 	Sender     *User       `json:"sender,omitempty"`
@@ -67,13 +87,19 @@ func ToConnection(entConnection *ent.Connection) *Connection {
 		ConnectedAt: entConnection.ConnectedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
-	// Include user details if loaded
+	// Include user details if loaded; last seen is only shared while connected
 	if entConnection.Edges.UserA != nil {
 		connection.UserA = ToUser(entConnection.Edges.UserA, AccessLevelBasic)
+		if entConnection.Status == entconnection.StatusConnected {
+			WithLastSeen(connection.UserA, entConnection.Edges.UserA)
+		}
 	}
 
 	if entConnection.Edges.UserB != nil {
 		connection.UserB = ToUser(entConnection.Edges.UserB, AccessLevelBasic)
+		if entConnection.Status == entconnection.StatusConnected {
+			WithLastSeen(connection.UserB, entConnection.Edges.UserB)
+		}
 	}
 
 	return connection
@@ -97,6 +123,11 @@ func ToConnectionRequest(entRequest *ent.ConnectionRequest) *ConnectionRequest {
 		request.Message = &entRequest.Message
 	}
 
+	if !entRequest.ExpiresAt.IsZero() {
+		expiresAt := entRequest.ExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+		request.ExpiresAt = &expiresAt
+	}
+
 	// Include user details if loaded
 	if entRequest.Edges.Sender != nil {
 		request.Sender = ToUser(entRequest.Edges.Sender, AccessLevelBasic)
@@ -150,6 +181,7 @@ func ToMessage(entMessage *ent.Message) *Message {
 		ReceiverID:   entMessage.ReceiverID,
 		Type:         string(entMessage.Type),
 		IsRead:       entMessage.IsRead,
+		IsDeleted:    entMessage.IsDeleted,
 		CreatedAt:    entMessage.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
@@ -165,11 +197,26 @@ func ToMessage(entMessage *ent.Message) *Message {
 		message.MediaType = &entMessage.MediaType
 	}
 
+	if !entMessage.DeliveredAt.IsZero() {
+		deliveredAtStr := entMessage.DeliveredAt.Format("2006-01-02T15:04:05Z07:00")
+		message.DeliveredAt = &deliveredAtStr
+	}
+
 	if !entMessage.ReadAt.IsZero() {
 		readAtStr := entMessage.ReadAt.Format("2006-01-02T15:04:05Z07:00")
 		message.ReadAt = &readAtStr
 	}
 
+	if !entMessage.EditedAt.IsZero() {
+		editedAtStr := entMessage.EditedAt.Format("2006-01-02T15:04:05Z07:00")
+		message.EditedAt = &editedAtStr
+	}
+
+	if !entMessage.DeletedAt.IsZero() {
+		deletedAtStr := entMessage.DeletedAt.Format("2006-01-02T15:04:05Z07:00")
+		message.DeletedAt = &deletedAtStr
+	}
+
 	// Include user details if loaded
 	if entMessage.Edges.Sender != nil {
 		message.Sender = ToUser(entMessage.Edges.Sender, AccessLevelBasic)
@@ -184,6 +231,18 @@ func ToMessage(entMessage *ent.Message) *Message {
 		message.Connection = ToConnection(entMessage.Edges.Connection)
 	}
 
+	message.ReplyToID = entMessage.ReplyToID
+
+	// Include the quoted message if loaded
+	if entMessage.Edges.ReplyTo != nil {
+		message.ReplyTo = ToMessagePreview(entMessage.Edges.ReplyTo)
+	}
+
+	// Include reactions if loaded
+	if entMessage.Edges.Reactions != nil {
+		message.Reactions = ToReactionSummaries(entMessage.Edges.Reactions)
+	}
+
 	return message
 }
 
@@ -201,6 +260,152 @@ func ToMessages(entMessages []*ent.Message) []*Message {
 	return messages
 }
 
+// MessagePage is a page of a conversation, newest message first.
+// The cursors load the messages before the oldest and after the newest message of the page.
+type MessagePage struct {
+	Messages     []*Message `json:"messages"`
+	BeforeCursor string     `json:"before_cursor,omitempty"`
+	AfterCursor  string     `json:"after_cursor,omitempty"`
+	HasOlder     bool       `json:"has_older"`
+	HasNewer     bool       `json:"has_newer"`
+}
+
+// MessageEdit represents a previous version of an edited message
+type MessageEdit struct {
+	ID              uuid.UUID `json:"id"`
+	MessageID       uuid.UUID `json:"message_id"`
+	PreviousContent string    `json:"previous_content"`
+	EditedAt        string    `json:"edited_at"`
+}
+
+// ToMessageEdits converts a slice of ent.MessageEdit to models.MessageEdit
+func ToMessageEdits(entEdits []*ent.MessageEdit) []*MessageEdit {
+	edits := make([]*MessageEdit, len(entEdits))
+	for i, entEdit := range entEdits {
+		edits[i] = &MessageEdit{
+			ID:              entEdit.ID,
+			MessageID:       entEdit.MessageID,
+			PreviousContent: entEdit.PreviousContent,
+			EditedAt:        entEdit.EditedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	return edits
+}
+
+// MessageSearchResult is a message matching a search, with matched terms marked in the snippet
+type MessageSearchResult struct {
+	Message *Message `json:"message"`
+	Rank    float32  `json:"rank"`
+	Snippet string   `json:"snippet"`
+}
+
+// previewLength is the number of characters of content kept in a message preview
+const previewLength = 100
+
+// MessagePreview is a compact version of a quoted message
+type MessagePreview struct {
+	ID        uuid.UUID `json:"id"`
+	SenderID  uuid.UUID `json:"sender_id"`
+	Type      string    `json:"type"`
+	Content   *string   `json:"content,omitempty"`
+	MediaType *string   `json:"media_type,omitempty"`
+	IsDeleted bool      `json:"is_deleted"`
+	CreatedAt string    `json:"created_at"`
+}
+
+// ToMessagePreview converts an ent.Message to a models.MessagePreview.
+// Unsent messages keep only their identity so the quote shows that it was removed.
+func ToMessagePreview(entMessage *ent.Message) *MessagePreview {
+	if entMessage == nil {
+		return nil
+	}
+
+	preview := &MessagePreview{
+		ID:        entMessage.ID,
+		SenderID:  entMessage.SenderID,
+		Type:      string(entMessage.Type),
+		IsDeleted: entMessage.IsDeleted,
+		CreatedAt: entMessage.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if entMessage.IsDeleted {
+		return preview
+	}
+
+	if entMessage.Content != "" {
+		content := entMessage.Content
+		if runes := []rune(content); len(runes) > previewLength {
+			content = string(runes[:previewLength]) + "…"
+		}
+		preview.Content = &content
+	}
+
+	if entMessage.MediaType != "" {
+		preview.MediaType = &entMessage.MediaType
+	}
+
+	return preview
+}
+
+// ReactionSummary groups the reactions to a message that use the same emoji
+type ReactionSummary struct {
+	Emoji   string      `json:"emoji"`
+	Count   int         `json:"count"`
+	UserIDs []uuid.UUID `json:"user_ids"`
+}
+
+// ToReactionSummaries groups reactions by emoji, keeping the order in which each emoji was first used
+func ToReactionSummaries(entReactions []*ent.MessageReaction) []*ReactionSummary {
+	summaries := make([]*ReactionSummary, 0)
+	byEmoji := make(map[string]*ReactionSummary)
+
+	for _, entReaction := range entReactions {
+		summary, ok := byEmoji[entReaction.Emoji]
+		if !ok {
+			summary = &ReactionSummary{Emoji: entReaction.Emoji, UserIDs: []uuid.UUID{}}
+			byEmoji[entReaction.Emoji] = summary
+			summaries = append(summaries, summary)
+		}
+		summary.Count++
+		summary.UserIDs = append(summary.UserIDs, entReaction.UserID)
+	}
+
+	return summaries
+}
+
+// ReadMarker is how far a user has read in a connection
+type ReadMarker struct {
+	ConnectionID      uuid.UUID `json:"connection_id"`
+	UserID            uuid.UUID `json:"user_id"`
+	LastReadMessageID uuid.UUID `json:"last_read_message_id"`
+	LastReadMessageAt string    `json:"last_read_message_at"`
+	UpdatedAt         string    `json:"updated_at"`
+}
+
+// ToReadMarkers converts a slice of ent.ReadMarker to models.ReadMarker
+func ToReadMarkers(entMarkers []*ent.ReadMarker) []*ReadMarker {
+	markers := make([]*ReadMarker, len(entMarkers))
+	for i, entMarker := range entMarkers {
+		markers[i] = &ReadMarker{
+			ConnectionID:      entMarker.ConnectionID,
+			UserID:            entMarker.UserID,
+			LastReadMessageID: entMarker.LastReadMessageID,
+			LastReadMessageAt: entMarker.LastReadMessageAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:         entMarker.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	return markers
+}
+
+// MessageSearchPage is one page of message search results
+type MessageSearchPage struct {
+	Results    []*MessageSearchResult `json:"results"`
+	NextCursor string                 `json:"next_cursor,omitempty"`
+	HasMore    bool                   `json:"has_more"`
+}
+
 // ChatListItem represents a chat item in the user's chat list
 type ChatListItem struct {
 	ConnectionID     uuid.UUID `json:"connection_id"`
@@ -0,0 +1,148 @@
+package models
+
+import (
+	"match-me/ent"
+	"match-me/ent/schema"
+
+	"github.com/google/uuid"
+)
+
+// Event represents a local event users can discover nearby and RSVP to
+type Event struct {
+	ID                  uuid.UUID     `json:"id"`
+	CreatorID           uuid.UUID     `json:"creator_id"`
+	Title               string        `json:"title"`
+	Description         *string       `json:"description,omitempty"`
+	EventType           *string       `json:"event_type,omitempty"`
+	Location            *schema.Point `json:"location,omitempty"`
+	LocationName        *string       `json:"location_name,omitempty"`
+	MaxParticipants     *int          `json:"max_participants,omitempty"`
+	CurrentParticipants int           `json:"current_participants"`
+	StartTime           string        `json:"start_time"`
+	EndTime             *string       `json:"end_time,omitempty"`
+	IsActive            bool          `json:"is_active"`
+	CreatedAt           string        `json:"created_at"`
+	UpdatedAt           string        `json:"updated_at"`
+
+	// Distance from the searched location in kilometers (nearby discovery only)
+	Distance *float64 `json:"distance,omitempty"`
+
+	// RSVP of the requesting user, if they responded
+	MyRSVP *string `json:"my_rsvp,omitempty"`
+
+	// Creator and participant details (when loaded with edges)
+	Creator      *User               `json:"creator,omitempty"`
+	Participants []*EventParticipant `json:"participants,omitempty"`
+}
+
+// EventParticipant represents a user's RSVP to an event
+type EventParticipant struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Status   string    `json:"status"`
+	JoinedAt string    `json:"joined_at"`
+
+	// User details (when loaded with edges)
+	User *User `json:"user,omitempty"`
+}
+
+// ToEvent converts an ent.Event to a models.Event, filling in the RSVP of the
+// requesting user from the loaded participants
+func ToEvent(entEvent *ent.Event, viewerID uuid.UUID) *Event {
+	if entEvent == nil {
+		return nil
+	}
+
+	event := &Event{
+		ID:                  entEvent.ID,
+		CreatorID:           entEvent.CreatorID,
+		Title:               entEvent.Title,
+		Location:            entEvent.Location,
+		CurrentParticipants: entEvent.CurrentParticipants,
+		StartTime:           entEvent.StartTime.Format("2006-01-02T15:04:05Z07:00"),
+		IsActive:            entEvent.IsActive,
+		CreatedAt:           entEvent.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           entEvent.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if entEvent.Description != "" {
+		event.Description = &entEvent.Description
+	}
+
+	if entEvent.EventType != "" {
+		event.EventType = &entEvent.EventType
+	}
+
+	if entEvent.LocationName != "" {
+		event.LocationName = &entEvent.LocationName
+	}
+
+	if entEvent.MaxParticipants > 0 {
+		event.MaxParticipants = &entEvent.MaxParticipants
+	}
+
+	if !entEvent.EndTime.IsZero() {
+		endTime := entEvent.EndTime.Format("2006-01-02T15:04:05Z07:00")
+		event.EndTime = &endTime
+	}
+
+	for _, participant := range entEvent.Edges.Participants {
+		if participant.UserID == viewerID {
+			status := string(participant.Status)
+			event.MyRSVP = &status
+		}
+	}
+
+	// Include creator details if loaded
+	if entEvent.Edges.Creator != nil {
+		event.Creator = ToUser(entEvent.Edges.Creator, AccessLevelBasic)
+	}
+
+	return event
+}
+
+// ToEventDetail converts an ent.Event to a models.Event including its loaded participants
+func ToEventDetail(entEvent *ent.Event, viewerID uuid.UUID) *Event {
+	event := ToEvent(entEvent, viewerID)
+	if event == nil {
+		return nil
+	}
+
+	event.Participants = make([]*EventParticipant, len(entEvent.Edges.Participants))
+	for i, participant := range entEvent.Edges.Participants {
+		event.Participants[i] = ToEventParticipant(participant)
+	}
+
+	return event
+}
+
+// ToEventParticipant converts an ent.EventParticipant to a models.EventParticipant
+func ToEventParticipant(entParticipant *ent.EventParticipant) *EventParticipant {
+	if entParticipant == nil {
+		return nil
+	}
+
+	participant := &EventParticipant{
+		UserID:   entParticipant.UserID,
+		Status:   string(entParticipant.Status),
+		JoinedAt: entParticipant.JoinedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if entParticipant.Edges.User != nil {
+		participant.User = ToUser(entParticipant.Edges.User, AccessLevelBasic)
+	}
+
+	return participant
+}
+
+// ToEvents converts a slice of ent.Event to models.Event
+func ToEvents(entEvents []*ent.Event, viewerID uuid.UUID) []*Event {
+	if entEvents == nil {
+		return nil
+	}
+
+	events := make([]*Event, len(entEvents))
+	for i, entEvent := range entEvents {
+		events[i] = ToEvent(entEvent, viewerID)
+	}
+	return events
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"match-me/internal/pkg/matching"
+
+	"github.com/google/uuid"
+)
+
+// Recommendation is a suggested user with the reasons they were suggested
+type Recommendation struct {
+	UserID  uuid.UUID         `json:"user_id"`
+	Score   float64           `json:"score"`
+	Reasons []string          `json:"reasons"`
+	Signals []matching.Signal `json:"signals"`
+}
+
+// ToRecommendation converts a matching.Result to a models.Recommendation
+func ToRecommendation(result matching.Result) *Recommendation {
+	return &Recommendation{
+		UserID:  result.UserID,
+		Score:   result.Score,
+		Reasons: result.Reasons(),
+		Signals: result.Signals,
+	}
+}
+
+// RecommendationPage is one page of the recommendations feed
+type RecommendationPage struct {
+	Recommendations []*Recommendation `json:"recommendations"`
+	NextCursor      string            `json:"next_cursor,omitempty"`
+	HasMore         bool              `json:"has_more"`
+}
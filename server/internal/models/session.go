@@ -0,0 +1,64 @@
+package models
+
+import (
+	"match-me/ent"
+
+	"github.com/google/uuid"
+)
+
+// Session represents a logged in device of a user
+type Session struct {
+	ID         uuid.UUID `json:"id"`
+	Device     *string   `json:"device,omitempty"`
+	IPAddress  *string   `json:"ip_address,omitempty"`
+	UserAgent  *string   `json:"user_agent,omitempty"`
+	CreatedAt  string    `json:"created_at"`
+	LastUsedAt string    `json:"last_used_at"`
+	ExpiresAt  string    `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+// AuthTokens is the access/refresh token pair issued on login and refresh
+type AuthTokens struct {
+	SessionID        uuid.UUID `json:"session_id"`
+	AccessToken      string    `json:"token"`
+	AccessExpiresAt  string    `json:"expires_at"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt string    `json:"refresh_expires_at"`
+}
+
+// ToSession converts an ent.Session to a models.Session
+func ToSession(entSession *ent.Session, currentSessionID uuid.UUID) *Session {
+	if entSession == nil {
+		return nil
+	}
+
+	s := &Session{
+		ID:         entSession.ID,
+		CreatedAt:  entSession.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		LastUsedAt: entSession.LastUsedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ExpiresAt:  entSession.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		Current:    entSession.ID == currentSessionID,
+	}
+
+	if entSession.Device != "" {
+		s.Device = &entSession.Device
+	}
+	if entSession.IPAddress != "" {
+		s.IPAddress = &entSession.IPAddress
+	}
+	if entSession.UserAgent != "" {
+		s.UserAgent = &entSession.UserAgent
+	}
+
+	return s
+}
+
+// ToSessions converts a slice of ent.Session to models.Session
+func ToSessions(entSessions []*ent.Session, currentSessionID uuid.UUID) []*Session {
+	sessions := make([]*Session, len(entSessions))
+	for i, s := range entSessions {
+		sessions[i] = ToSession(s, currentSessionID)
+	}
+	return sessions
+}
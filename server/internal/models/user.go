@@ -17,6 +17,19 @@ const (
 	AccessLevelFull                       // For internal/owner use - all data
 )
 
+// User roles
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// Status modes a user can choose
+const (
+	StatusModeAvailable = "available"
+	StatusModeBusy      = "busy"
+	StatusModeInvisible = "invisible"
+)
+
 type User struct {
 	ID                 uuid.UUID       `json:"id"`
 	Email              string          `json:"email,omitempty"`
@@ -41,12 +54,46 @@ type User struct {
 	Prompts            []schema.Prompt `json:"prompts,omitempty"`
 	Photos             []UserPhoto     `json:"photos,omitempty"`
 	ProfilePhoto       *string         `json:"profile_photo,omitempty"`
+	ProfileThumbnail   *string         `json:"profile_thumbnail,omitempty"`
+	EmailVerified      *bool           `json:"email_verified,omitempty"`
+	LastSeenAt         *string         `json:"last_seen_at,omitempty"`
+	ShowLastSeen       *bool           `json:"show_last_seen,omitempty"`
+	Role               string          `json:"role,omitempty"`
+	StatusMode         string          `json:"status_mode,omitempty"`
+	StatusMessage      *string         `json:"status_message,omitempty"`
 }
 
 type UserPhoto struct {
-	ID       uuid.UUID `json:"id"`
-	PhotoURL string    `json:"photo_url"`
-	Order    int       `json:"order"`
+	ID           uuid.UUID `json:"id"`
+	PhotoURL     string    `json:"photo_url"`
+	MediumURL    string    `json:"medium_url"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	Order        int       `json:"order"`
+}
+
+// ToUserPhoto converts an ent.UserPhoto to a models.UserPhoto.
+// Photos stored before variants existed fall back to the full size URL.
+func ToUserPhoto(entPhoto *ent.UserPhoto) *UserPhoto {
+	if entPhoto == nil {
+		return nil
+	}
+
+	photo := &UserPhoto{
+		ID:           entPhoto.ID,
+		PhotoURL:     entPhoto.PhotoURL,
+		MediumURL:    entPhoto.MediumURL,
+		ThumbnailURL: entPhoto.ThumbnailURL,
+		Order:        entPhoto.Order,
+	}
+
+	if photo.MediumURL == "" {
+		photo.MediumURL = photo.PhotoURL
+	}
+	if photo.ThumbnailURL == "" {
+		photo.ThumbnailURL = photo.MediumURL
+	}
+
+	return photo
 }
 
 func ToUser(entUser *ent.User, accessLevel AccessLevel) *User {
@@ -62,11 +109,13 @@ func ToUser(entUser *ent.User, accessLevel AccessLevel) *User {
 
 	switch accessLevel {
 	case AccessLevelBasic:
-		// Only return basic info: ID, name, and profile picture link
+		// Only return basic info: ID, name, and profile picture links (medium and thumbnail variants)
 		if entUser.Edges.Photos != nil {
 			for _, photo := range entUser.Edges.Photos {
 				if photo.Order == 1 {
-					user.ProfilePhoto = &photo.PhotoURL
+					profilePhoto := ToUserPhoto(photo)
+					user.ProfilePhoto = &profilePhoto.MediumURL
+					user.ProfileThumbnail = &profilePhoto.ThumbnailURL
 					break
 				}
 			}
@@ -84,11 +133,7 @@ func ToUser(entUser *ent.User, accessLevel AccessLevel) *User {
 		if entUser.Edges.Photos != nil {
 			photos := make([]UserPhoto, len(entUser.Edges.Photos))
 			for i, photo := range entUser.Edges.Photos {
-				photos[i] = UserPhoto{
-					ID:       photo.ID,
-					PhotoURL: photo.PhotoURL,
-					Order:    photo.Order,
-				}
+				photos[i] = *ToUserPhoto(photo)
 			}
 			user.Photos = photos
 		}
@@ -148,21 +193,29 @@ func ToUser(entUser *ent.User, accessLevel AccessLevel) *User {
 		if entUser.Edges.Photos != nil {
 			photos := make([]UserPhoto, len(entUser.Edges.Photos))
 			for i, photo := range entUser.Edges.Photos {
-				photos[i] = UserPhoto{
-					ID:       photo.ID,
-					PhotoURL: photo.PhotoURL,
-					Order:    photo.Order,
-				}
+				photos[i] = *ToUserPhoto(photo)
 			}
 			user.Photos = photos
 		}
 	case AccessLevelFull:
 		// Return all data (your original implementation)
 		user.Email = entUser.Email
+		emailVerified := !entUser.EmailVerifiedAt.IsZero()
+		user.EmailVerified = &emailVerified
 		createdAtStr := entUser.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
 		user.CreatedAt = &createdAtStr
 		updatedAtStr := entUser.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
 		user.UpdatedAt = &updatedAtStr
+		if !entUser.LastSeenAt.IsZero() {
+			lastSeenAtStr := entUser.LastSeenAt.Format("2006-01-02T15:04:05Z07:00")
+			user.LastSeenAt = &lastSeenAtStr
+		}
+		user.ShowLastSeen = &entUser.ShowLastSeen
+		user.Role = string(entUser.Role)
+		user.StatusMode = string(entUser.StatusMode)
+		if entUser.StatusMessage != "" {
+			user.StatusMessage = &entUser.StatusMessage
+		}
 		user.Age = entUser.Age
 		user.ProfileCompletion = entUser.ProfileCompletion
 		user.Gender = string(entUser.Gender)
@@ -207,11 +260,7 @@ func ToUser(entUser *ent.User, accessLevel AccessLevel) *User {
 		if entUser.Edges.Photos != nil {
 			photos := make([]UserPhoto, len(entUser.Edges.Photos))
 			for i, photo := range entUser.Edges.Photos {
-				photos[i] = UserPhoto{
-					ID:       photo.ID,
-					PhotoURL: photo.PhotoURL,
-					Order:    photo.Order,
-				}
+				photos[i] = *ToUserPhoto(photo)
 			}
 			user.Photos = photos
 		}
@@ -220,10 +269,55 @@ func ToUser(entUser *ent.User, accessLevel AccessLevel) *User {
 	return user
 }
 
+// WithLastSeen adds when a connected user was last seen, unless they hide it
+func WithLastSeen(user *User, entUser *ent.User) *User {
+	if user == nil || entUser == nil {
+		return user
+	}
+
+	if entUser.ShowLastSeen && !entUser.LastSeenAt.IsZero() {
+		lastSeenAtStr := entUser.LastSeenAt.Format("2006-01-02T15:04:05Z07:00")
+		user.LastSeenAt = &lastSeenAtStr
+	}
+	return user
+}
+
+// UserStatus is the availability a user chose, with what decides how it is shown to others
+type UserStatus struct {
+	UserID        uuid.UUID `json:"user_id"`
+	Mode          string    `json:"mode"`
+	StatusMessage *string   `json:"status_message,omitempty"`
+	ShowLastSeen  bool      `json:"show_last_seen"`
+	LastSeenAt    *string   `json:"last_seen_at,omitempty"`
+}
+
+// ToUserStatus converts an ent.User to its status
+func ToUserStatus(entUser *ent.User) *UserStatus {
+	if entUser == nil {
+		return nil
+	}
+
+	status := &UserStatus{
+		UserID:       entUser.ID,
+		Mode:         string(entUser.StatusMode),
+		ShowLastSeen: entUser.ShowLastSeen,
+	}
+	if entUser.StatusMessage != "" {
+		status.StatusMessage = &entUser.StatusMessage
+	}
+	if !entUser.LastSeenAt.IsZero() {
+		lastSeenAtStr := entUser.LastSeenAt.Format("2006-01-02T15:04:05Z07:00")
+		status.LastSeenAt = &lastSeenAtStr
+	}
+	return status
+}
+
 // UserInteractionStats represents statistics about user interactions
 type UserInteractionStats struct {
 	DeclinedRequests   int `json:"declined_requests"`
 	SkippedProfiles    int `json:"skipped_profiles"`
 	DeletedConnections int `json:"deleted_connections"`
+	Likes              int `json:"likes"`
+	SuperLikes         int `json:"super_likes"`
 	TotalInteractions  int `json:"total_interactions"`
 }
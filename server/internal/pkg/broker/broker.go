@@ -0,0 +1,46 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"match-me/config"
+	"match-me/ent"
+
+	"github.com/google/uuid"
+)
+
+const (
+	BackendMemory   string = "memory"
+	BackendPostgres string = "postgres"
+)
+
+// Broker fans WebSocket events out to every server node and tracks which users are
+// connected to which node
+type Broker interface {
+	// Publish sends a payload to the subscribers of every node, including this one
+	Publish(ctx context.Context, payload []byte) error
+	// Subscribe registers a handler called with every published payload
+	Subscribe(handler func(payload []byte))
+
+	// Heartbeat replaces the presence leases held by a node with leases for the given users
+	Heartbeat(ctx context.Context, nodeID string, userIDs []uuid.UUID, ttl time.Duration) error
+	// OnlineUsers returns the users holding an unexpired lease on any node except excludeNodeID
+	OnlineUsers(ctx context.Context, excludeNodeID string) ([]uuid.UUID, error)
+
+	// Close stops delivering payloads
+	Close() error
+}
+
+// NewBroker returns the Broker selected by WS_BROKER
+func NewBroker(cfg *config.Config, client *ent.Client) (Broker, error) {
+	switch cfg.BrokerBackend {
+	case BackendMemory:
+		return NewMemoryBroker(), nil
+	case BackendPostgres:
+		return NewPostgresBroker(client, cfg.DbURL)
+	default:
+		return nil, fmt.Errorf("unknown broker backend: %s", cfg.BrokerBackend)
+	}
+}
@@ -0,0 +1,106 @@
+package broker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemoryBroker delivers payloads within the process. It is enough for a single node
+// and lets several services in one process behave like separate nodes.
+type MemoryBroker struct {
+	mu       sync.RWMutex
+	handlers []func(payload []byte)
+	leases   map[string]map[uuid.UUID]time.Time
+	messages chan []byte
+	done     chan struct{}
+	once     sync.Once
+}
+
+// NewMemoryBroker creates a MemoryBroker and starts delivering payloads
+func NewMemoryBroker() *MemoryBroker {
+	b := &MemoryBroker{
+		leases:   make(map[string]map[uuid.UUID]time.Time),
+		messages: make(chan []byte, 256),
+		done:     make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// run delivers payloads in the order they were published
+func (b *MemoryBroker) run() {
+	for {
+		select {
+		case payload := <-b.messages:
+			b.mu.RLock()
+			handlers := b.handlers
+			b.mu.RUnlock()
+
+			for _, handler := range handlers {
+				handler(payload)
+			}
+		case <-b.done:
+			return
+		}
+	}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, payload []byte) error {
+	select {
+	case b.messages <- payload:
+		return nil
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *MemoryBroker) Subscribe(handler func(payload []byte)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+func (b *MemoryBroker) Heartbeat(ctx context.Context, nodeID string, userIDs []uuid.UUID, ttl time.Duration) error {
+	expiresAt := time.Now().Add(ttl)
+	leases := make(map[uuid.UUID]time.Time, len(userIDs))
+	for _, userID := range userIDs {
+		leases[userID] = expiresAt
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.leases[nodeID] = leases
+	return nil
+}
+
+func (b *MemoryBroker) OnlineUsers(ctx context.Context, excludeNodeID string) ([]uuid.UUID, error) {
+	now := time.Now()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	seen := make(map[uuid.UUID]bool)
+	userIDs := make([]uuid.UUID, 0)
+	for nodeID, leases := range b.leases {
+		if nodeID == excludeNodeID {
+			continue
+		}
+		for userID, expiresAt := range leases {
+			if expiresAt.After(now) && !seen[userID] {
+				seen[userID] = true
+				userIDs = append(userIDs, userID)
+			}
+		}
+	}
+	return userIDs, nil
+}
+
+func (b *MemoryBroker) Close() error {
+	b.once.Do(func() { close(b.done) })
+	return nil
+}
@@ -0,0 +1,246 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"match-me/ent"
+	"match-me/ent/brokerpayload"
+	"match-me/ent/presencelease"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	// notifyChannel is the Postgres channel every node listens on
+	notifyChannel = "ws_fanout"
+
+	// maxNotifyPayload is the largest payload Postgres accepts for NOTIFY, in bytes
+	maxNotifyPayload = 7999
+
+	// payloadRefPrefix marks a notification that carries the ID of a stored payload
+	// instead of the payload itself. Inline payloads are JSON and never start with it.
+	payloadRefPrefix = "ref:"
+
+	// payloadTTL is how long a stored payload is kept for the other nodes to load
+	payloadTTL = time.Minute
+
+	// loadTimeout bounds how long delivering a stored payload waits on the database
+	loadTimeout = 5 * time.Second
+)
+
+// payloadStore keeps payloads too large for NOTIFY until every node has loaded them
+type payloadStore interface {
+	save(ctx context.Context, payload []byte) (uuid.UUID, error)
+	load(ctx context.Context, id uuid.UUID) ([]byte, error)
+}
+
+// entPayloadStore keeps payloads in the broker_payloads table
+type entPayloadStore struct {
+	client *ent.Client
+}
+
+func (s entPayloadStore) save(ctx context.Context, payload []byte) (uuid.UUID, error) {
+	// Large events are rare, so expired payloads are pruned as new ones arrive
+	if _, err := s.client.BrokerPayload.Delete().
+		Where(brokerpayload.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to prune broker payloads: %w", err)
+	}
+
+	stored, err := s.client.BrokerPayload.Create().
+		SetPayload(payload).
+		SetExpiresAt(time.Now().Add(payloadTTL)).
+		Save(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to store broker payload: %w", err)
+	}
+	return stored.ID, nil
+}
+
+func (s entPayloadStore) load(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	stored, err := s.client.BrokerPayload.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("broker payload not found")
+		}
+		return nil, fmt.Errorf("failed to load broker payload: %w", err)
+	}
+	return stored.Payload, nil
+}
+
+// PostgresBroker fans payloads out through Postgres LISTEN/NOTIFY and keeps presence
+// leases in the presence_leases table. Payloads too large for a notification are stored
+// in the broker_payloads table and only their ID is sent.
+type PostgresBroker struct {
+	client   *ent.Client
+	payloads payloadStore
+	listener *pq.Listener
+	mu       sync.RWMutex
+	handlers []func(payload []byte)
+	done     chan struct{}
+	once     sync.Once
+}
+
+// NewPostgresBroker connects a listener to the database and starts delivering notifications
+func NewPostgresBroker(client *ent.Client, dbURL string) (*PostgresBroker, error) {
+	listener := pq.NewListener(dbURL, 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Broker listener event %d: %v", event, err)
+		}
+	})
+
+	if err := listener.Listen(notifyChannel); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("failed to listen on %s: %w", notifyChannel, err)
+	}
+
+	b := &PostgresBroker{
+		client:   client,
+		payloads: entPayloadStore{client: client},
+		listener: listener,
+		done:     make(chan struct{}),
+	}
+	go b.run()
+	return b, nil
+}
+
+// run delivers notifications to the handlers until the broker is closed
+func (b *PostgresBroker) run() {
+	for {
+		select {
+		case notification := <-b.listener.Notify:
+			// A nil notification means the connection was re-established;
+			// anything sent in between is lost, as with a dropped socket
+			if notification == nil {
+				continue
+			}
+
+			payload, err := b.decode(notification.Extra)
+			if err != nil {
+				log.Printf("Failed to load broker payload: %v", err)
+				continue
+			}
+
+			b.mu.RLock()
+			handlers := b.handlers
+			b.mu.RUnlock()
+
+			for _, handler := range handlers {
+				handler(payload)
+			}
+		case <-b.done:
+			return
+		}
+	}
+}
+
+func (b *PostgresBroker) Publish(ctx context.Context, payload []byte) error {
+	extra, err := b.encode(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	if _, err := b.client.ExecContext(ctx, `SELECT pg_notify($1, $2)`, notifyChannel, extra); err != nil {
+		return fmt.Errorf("failed to publish: %w", err)
+	}
+	return nil
+}
+
+// encode returns the notification text for a payload, storing the payload first when
+// it does not fit in a notification
+func (b *PostgresBroker) encode(ctx context.Context, payload []byte) (string, error) {
+	if len(payload) <= maxNotifyPayload && !strings.HasPrefix(string(payload), payloadRefPrefix) {
+		return string(payload), nil
+	}
+
+	id, err := b.payloads.save(ctx, payload)
+	if err != nil {
+		return "", err
+	}
+	return payloadRefPrefix + id.String(), nil
+}
+
+// decode returns the payload of a notification, loading it when the notification
+// only carries its ID
+func (b *PostgresBroker) decode(extra string) ([]byte, error) {
+	ref, ok := strings.CutPrefix(extra, payloadRefPrefix)
+	if !ok {
+		return []byte(extra), nil
+	}
+
+	id, err := uuid.Parse(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid broker payload ID: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), loadTimeout)
+	defer cancel()
+	return b.payloads.load(ctx, id)
+}
+
+func (b *PostgresBroker) Subscribe(handler func(payload []byte)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+func (b *PostgresBroker) Heartbeat(ctx context.Context, nodeID string, userIDs []uuid.UUID, ttl time.Duration) error {
+	ids := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		ids[i] = userID.String()
+	}
+	expiresAt := time.Now().Add(ttl)
+
+	// Renew the node's leases and drop the ones of users who disconnected
+	_, err := b.client.ExecContext(ctx, `
+		INSERT INTO presence_leases (id, node_id, user_id, expires_at)
+		SELECT gen_random_uuid(), $1, u, $3 FROM unnest($2::uuid[]) AS u
+		ON CONFLICT (node_id, user_id) DO UPDATE SET expires_at = EXCLUDED.expires_at`,
+		nodeID, pq.Array(ids), expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to renew presence leases: %w", err)
+	}
+
+	_, err = b.client.ExecContext(ctx, `
+		DELETE FROM presence_leases
+		WHERE (node_id = $1 AND NOT (user_id = ANY($2::uuid[]))) OR expires_at < now()`,
+		nodeID, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to prune presence leases: %w", err)
+	}
+	return nil
+}
+
+func (b *PostgresBroker) OnlineUsers(ctx context.Context, excludeNodeID string) ([]uuid.UUID, error) {
+	var rows []struct {
+		UserID uuid.UUID `json:"user_id"`
+	}
+	err := b.client.PresenceLease.Query().
+		Where(
+			presencelease.ExpiresAtGT(time.Now()),
+			presencelease.NodeIDNEQ(excludeNodeID),
+		).
+		Unique(true).
+		Select(presencelease.FieldUserID).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get online users: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		userIDs[i] = row.UserID
+	}
+	return userIDs, nil
+}
+
+func (b *PostgresBroker) Close() error {
+	b.once.Do(func() { close(b.done) })
+	return b.listener.Close()
+}
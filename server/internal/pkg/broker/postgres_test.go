@@ -0,0 +1,82 @@
+package broker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// fakePayloadStore keeps stored payloads in memory
+type fakePayloadStore struct {
+	payloads map[uuid.UUID][]byte
+}
+
+func (s *fakePayloadStore) save(_ context.Context, payload []byte) (uuid.UUID, error) {
+	id := uuid.New()
+	s.payloads[id] = payload
+	return id, nil
+}
+
+func (s *fakePayloadStore) load(_ context.Context, id uuid.UUID) ([]byte, error) {
+	payload, ok := s.payloads[id]
+	if !ok {
+		return nil, fmt.Errorf("broker payload not found")
+	}
+	return payload, nil
+}
+
+func TestPostgresBrokerPayloadEncoding(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   int
+		stored bool
+	}{
+		{name: "small payload is sent inline", size: 100},
+		{name: "largest inline payload", size: maxNotifyPayload},
+		{name: "oversized payload is stored", size: maxNotifyPayload + 1, stored: true},
+		{name: "large message event is stored", size: 64 * 1024, stored: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakePayloadStore{payloads: make(map[uuid.UUID][]byte)}
+			b := &PostgresBroker{payloads: store}
+
+			payload := []byte(`{"data":"` + strings.Repeat("x", tt.size-11) + `"}`)
+
+			extra, err := b.encode(context.Background(), payload)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			if len(extra) > maxNotifyPayload {
+				t.Errorf("notification is %d bytes, over the NOTIFY limit", len(extra))
+			}
+			if stored := len(store.payloads) == 1; stored != tt.stored {
+				t.Errorf("stored = %v, want %v", stored, tt.stored)
+			}
+
+			decoded, err := b.decode(extra)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if !bytes.Equal(decoded, payload) {
+				t.Error("decoded payload differs from the published one")
+			}
+		})
+	}
+}
+
+func TestPostgresBrokerDecodeMissingPayload(t *testing.T) {
+	b := &PostgresBroker{payloads: &fakePayloadStore{payloads: make(map[uuid.UUID][]byte)}}
+
+	if _, err := b.decode(payloadRefPrefix + uuid.NewString()); err == nil {
+		t.Error("decode of an expired payload succeeded")
+	}
+	if _, err := b.decode(payloadRefPrefix + "not-a-uuid"); err == nil {
+		t.Error("decode of an invalid reference succeeded")
+	}
+}
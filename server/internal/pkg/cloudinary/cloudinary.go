@@ -22,14 +22,17 @@ type cloudinry struct {
 	cld *cloudinary.Cloudinary
 }
 
-func NewCloudinary() Cloudinary {
-	cldnry, _ := cloudinary.New()
+func NewCloudinary() (Cloudinary, error) {
+	cldnry, err := cloudinary.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize cloudinary: %w", err)
+	}
 	cldnry.Config.URL.Secure = true
 
 	return &cloudinry{
 		ctx: context.Background(),
 		cld: cldnry,
-	}
+	}, nil
 }
 
 // UploadImage uploads an image and returns its secure URL
@@ -0,0 +1,158 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+
+	_ "image/gif"
+	_ "image/png"
+
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format, only jpeg, png, gif and webp are allowed")
+	ErrTooLarge          = errors.New("image file is too large")
+	ErrDimensions        = errors.New("image dimensions are out of bounds")
+)
+
+// Variant names
+const (
+	VariantThumbnail string = "thumbnail"
+	VariantMedium    string = "medium"
+	VariantFull      string = "full"
+)
+
+// VariantSpec describes one generated size
+type VariantSpec struct {
+	Name    string
+	MaxSide int  // Longest side in pixels, images are never upscaled
+	Square  bool // Center crop to a square before scaling
+}
+
+// DefaultVariants are the sizes generated for profile photos
+var DefaultVariants = []VariantSpec{
+	{Name: VariantThumbnail, MaxSide: 200, Square: true},
+	{Name: VariantMedium, MaxSide: 640},
+	{Name: VariantFull, MaxSide: 1600},
+}
+
+// Options limit what Process accepts
+type Options struct {
+	MaxBytes     int64 // Maximum size of the uploaded file
+	MaxDimension int   // Maximum width or height of the uploaded image
+	MaxPixels    int   // Maximum width × height of the uploaded image, bounding the memory a decode takes
+	MinDimension int   // Minimum width and height of the uploaded image
+	Quality      int   // JPEG quality of the variants
+}
+
+// Variant is an encoded image ready to be stored
+type Variant struct {
+	Name        string
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// Process validates an uploaded image and renders its variants.
+// Every variant is re-encoded as JPEG which drops all metadata (EXIF, GPS, ...);
+// the EXIF orientation is applied to the pixels first so photos keep displaying upright.
+func Process(data []byte, opts Options, specs []VariantSpec) ([]Variant, error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		return nil, ErrTooLarge
+	}
+
+	format := Sniff(data)
+	if format == "" {
+		return nil, ErrUnsupportedFormat
+	}
+
+	// Check dimensions before decoding the full image
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	if opts.MaxDimension > 0 && (cfg.Width > opts.MaxDimension || cfg.Height > opts.MaxDimension) {
+		return nil, ErrDimensions
+	}
+	// Every decoded copy takes 4 bytes per pixel, so the area is limited as well as the sides
+	if opts.MaxPixels > 0 && int64(cfg.Width)*int64(cfg.Height) > int64(opts.MaxPixels) {
+		return nil, ErrDimensions
+	}
+	if cfg.Width < opts.MinDimension || cfg.Height < opts.MinDimension {
+		return nil, ErrDimensions
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+
+	img := flatten(src)
+	if format == FormatJPEG {
+		img = applyOrientation(img, exifOrientation(data))
+	}
+
+	quality := opts.Quality
+	if quality <= 0 {
+		quality = 85
+	}
+
+	variants := make([]Variant, 0, len(specs))
+	for _, spec := range specs {
+		resized := resize(img, spec)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resized, &jpeg.Options{Quality: quality}); err != nil {
+			return nil, fmt.Errorf("failed to encode %s variant: %w", spec.Name, err)
+		}
+
+		bounds := resized.Bounds()
+		variants = append(variants, Variant{
+			Name:        spec.Name,
+			Data:        buf.Bytes(),
+			ContentType: "image/jpeg",
+			Width:       bounds.Dx(),
+			Height:      bounds.Dy(),
+		})
+	}
+
+	return variants, nil
+}
+
+// flatten draws the image onto a white RGBA canvas, dropping transparency for JPEG output
+func flatten(src image.Image) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Over)
+	return dst
+}
+
+// resize scales the image so its longest side fits spec.MaxSide
+func resize(img *image.RGBA, spec VariantSpec) *image.RGBA {
+	bounds := img.Bounds()
+	if spec.Square {
+		side := min(bounds.Dx(), bounds.Dy())
+		x := (bounds.Dx() - side) / 2
+		y := (bounds.Dy() - side) / 2
+		bounds = image.Rect(x, y, x+side, y+side)
+	}
+
+	w, h := bounds.Dx(), bounds.Dy()
+	if longest := max(w, h); longest > spec.MaxSide {
+		w = max(1, w*spec.MaxSide/longest)
+		h = max(1, h*spec.MaxSide/longest)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, xdraw.Src, nil)
+	return dst
+}
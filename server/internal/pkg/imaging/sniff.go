@@ -0,0 +1,26 @@
+package imaging
+
+import "bytes"
+
+// Supported formats
+const (
+	FormatJPEG string = "jpeg"
+	FormatPNG  string = "png"
+	FormatGIF  string = "gif"
+	FormatWebP string = "webp"
+)
+
+// Sniff detects the image format from its magic bytes, returning "" for anything unsupported
+func Sniff(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		return FormatJPEG
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return FormatPNG
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return FormatGIF
+	case len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return FormatWebP
+	}
+	return ""
+}
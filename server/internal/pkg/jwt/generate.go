@@ -1,6 +1,8 @@
 package jwt
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -10,20 +12,47 @@ import (
 const (
 	PurposePasswordReset string = "password_reset"
 	PurposeLogin         string = "user_login"
+	PurposeRefresh       string = "refresh_token"
+	PurposeVerifyEmail   string = "email_verification"
+)
+
+const (
+	// ClaimSessionID is the claim holding the session a token belongs to
+	ClaimSessionID string = "sid"
+	// ClaimTokenID is the claim holding a unique token identifier
+	ClaimTokenID string = "jti"
+	// ClaimPasswordFingerprint binds a password reset token to the password hash it was issued for
+	ClaimPasswordFingerprint string = "pwh"
+	// ClaimEmail binds an email verification token to the address it was sent to
+	ClaimEmail string = "email"
 )
 
 func GenerateJWTToken(userID uuid.UUID, jwtSecret string, purpose string, duration time.Duration) (string, error) {
+	return GenerateJWTTokenWithClaims(userID, jwtSecret, purpose, duration, nil)
+}
+
+// GenerateJWTTokenWithClaims generates a token like GenerateJWTToken and adds the given extra claims.
+// Extra claims never override the standard userId, purpose, exp and iat claims.
+func GenerateJWTTokenWithClaims(userID uuid.UUID, jwtSecret string, purpose string, duration time.Duration, extra map[string]any) (string, error) {
 	expirationTime := time.Now().Add(duration)
 	issuedAt := time.Now()
 
-	claims := jwt.MapClaims{
-		"userId":  userID.String(),
-		"purpose": purpose,
-		"exp":     expirationTime.Unix(),
-		"iat":     issuedAt.Unix(),
+	claims := jwt.MapClaims{}
+	for key, value := range extra {
+		claims[key] = value
 	}
+	claims["userId"] = userID.String()
+	claims["purpose"] = purpose
+	claims["exp"] = expirationTime.Unix()
+	claims["iat"] = issuedAt.Unix()
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	signedToken, err := token.SignedString([]byte(jwtSecret))
 	return signedToken, err
 }
+
+// HashToken returns the hex encoded SHA-256 hash of a token, used to store tokens server side
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -10,6 +10,12 @@ import (
 )
 
 func VerifyJwtToken(ctx context.Context, tokenStr string, purpose, jwtSecret string) (uuid.UUID, error) {
+	userID, _, err := ParseJwtToken(ctx, tokenStr, purpose, jwtSecret)
+	return userID, err
+}
+
+// ParseJwtToken verifies a token like VerifyJwtToken and also returns all of its claims
+func ParseJwtToken(ctx context.Context, tokenStr string, purpose, jwtSecret string) (uuid.UUID, map[string]any, error) {
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (any, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -18,42 +24,52 @@ func VerifyJwtToken(ctx context.Context, tokenStr string, purpose, jwtSecret str
 	})
 
 	if err != nil || !token.Valid {
-		return uuid.Nil, fmt.Errorf("invalid or expired token")
+		return uuid.Nil, nil, fmt.Errorf("invalid or expired token")
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return uuid.Nil, fmt.Errorf("invalid token claims")
+		return uuid.Nil, nil, fmt.Errorf("invalid token claims")
 	}
 
 	purposeStr, ok := claims["purpose"].(string)
 	if !ok {
-		return uuid.Nil, fmt.Errorf("invalid or missing purpose in token claims")
+		return uuid.Nil, nil, fmt.Errorf("invalid or missing purpose in token claims")
 	}
 
 	if purposeStr != purpose {
-		return uuid.Nil, fmt.Errorf("token purpose mismatch")
+		return uuid.Nil, nil, fmt.Errorf("token purpose mismatch")
 	}
 
 	expFloat, ok := claims["exp"].(float64)
 	if !ok {
-		return uuid.Nil, fmt.Errorf("missing token expiration")
+		return uuid.Nil, nil, fmt.Errorf("missing token expiration")
 	}
 
 	expirationTime := time.Unix(int64(expFloat), 0)
 	if expirationTime.Before(time.Now()) {
-		return uuid.Nil, fmt.Errorf("token has expired")
+		return uuid.Nil, nil, fmt.Errorf("token has expired")
 	}
 
-	userIdStr, ok := claims["userId"].(string)
+	userID, err := ClaimUUID(claims, "userId")
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return userID, claims, nil
+}
+
+// ClaimUUID reads a UUID claim from parsed token claims
+func ClaimUUID(claims map[string]any, key string) (uuid.UUID, error) {
+	idStr, ok := claims[key].(string)
 	if !ok {
-		return uuid.Nil, fmt.Errorf("invalid user ID in token")
+		return uuid.Nil, fmt.Errorf("invalid %s in token", key)
 	}
 
-	userID, err := uuid.Parse(userIdStr)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("failed to parse user ID: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to parse %s: %w", key, err)
 	}
 
-	return userID, nil
+	return id, nil
 }
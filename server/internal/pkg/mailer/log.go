@@ -0,0 +1,46 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type logMailer struct {
+	dir  string
+	from string
+}
+
+// NewLogMailer creates a Mailer for local development.
+// Emails are written as .eml files to dir, or to the server log when dir is empty.
+func NewLogMailer(dir, from string) Mailer {
+	return &logMailer{
+		dir:  dir,
+		from: from,
+	}
+}
+
+func (m *logMailer) Send(ctx context.Context, msg Message) error {
+	if m.dir == "" {
+		log.Printf("📧 Email to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	name := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102T150405"), uuid.NewString())
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, buildMessage(m.from, msg), 0o644); err != nil {
+		return fmt.Errorf("failed to write email: %w", err)
+	}
+
+	log.Printf("📧 Email to %s written to %s", msg.To, path)
+	return nil
+}
@@ -0,0 +1,37 @@
+package mailer
+
+import (
+	"context"
+	"log"
+	"match-me/config"
+)
+
+const (
+	DriverSMTP string = "smtp"
+	DriverLog  string = "log"
+)
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers transactional emails (password resets, verification links, ...)
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewMailer returns the Mailer selected by MAIL_DRIVER, falling back to the log mailer
+func NewMailer(cfg *config.Config) Mailer {
+	switch cfg.MailDriver {
+	case DriverSMTP:
+		return NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom)
+	case DriverLog:
+		return NewLogMailer(cfg.MailLogDir, cfg.MailFrom)
+	default:
+		log.Printf("Warning: Unknown mail driver '%s', using log mailer", cfg.MailDriver)
+		return NewLogMailer(cfg.MailLogDir, cfg.MailFrom)
+	}
+}
@@ -0,0 +1,162 @@
+package matching
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor marks the last result of a feed page. Pages are keyed on (score, user ID)
+// rather than offsets, so a cursor stays valid when the ranking is recomputed.
+type Cursor struct {
+	Score  float64   `json:"s"`
+	UserID uuid.UUID `json:"u"`
+}
+
+// EncodeCursor returns an opaque cursor pointing after result
+func EncodeCursor(result Result) string {
+	data, _ := json.Marshal(Cursor{Score: result.Score, UserID: result.UserID})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor returned by EncodeCursor
+func DecodeCursor(cursor string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.UserID == uuid.Nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &c, nil
+}
+
+// Page returns up to limit results ranked after the cursor, and whether more follow.
+// Results must be in Rank order.
+func Page(results []Result, cursor *Cursor, limit int) ([]Result, bool) {
+	start := 0
+	if cursor != nil {
+		start = len(results)
+		for i, r := range results {
+			if r.Score < cursor.Score || (r.Score == cursor.Score && r.UserID.String() > cursor.UserID.String()) {
+				start = i
+				break
+			}
+		}
+	}
+
+	end := min(start+limit, len(results))
+	return results[start:end], end < len(results)
+}
+
+// FeedCache keeps each user's ranked feed for a limited time
+type FeedCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[uuid.UUID]feedEntry
+}
+
+type feedEntry struct {
+	results   []Result
+	expiresAt time.Time
+}
+
+// NewFeedCache creates a cache. A zero ttl disables caching.
+func NewFeedCache(ttl time.Duration) *FeedCache {
+	return &FeedCache{
+		ttl:     ttl,
+		entries: make(map[uuid.UUID]feedEntry),
+	}
+}
+
+// Get returns the cached feed of a user
+func (c *FeedCache) Get(userID uuid.UUID) ([]Result, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[userID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.results, true
+}
+
+// Set stores the feed of a user
+func (c *FeedCache) Set(userID uuid.UUID, results []Result) {
+	if c == nil || c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Drop expired entries while holding the lock anyway
+	now := time.Now()
+	for id, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+
+	c.entries[userID] = feedEntry{results: results, expiresAt: now.Add(c.ttl)}
+}
+
+// Invalidate drops the cached feeds of the given users
+func (c *FeedCache) Invalidate(userIDs ...uuid.UUID) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range userIDs {
+		delete(c.entries, id)
+	}
+}
+
+// InvalidateCandidates drops every cached feed that recommends one of the given users
+func (c *FeedCache) InvalidateCandidates(userIDs ...uuid.UUID) {
+	if c == nil || len(userIDs) == 0 {
+		return
+	}
+
+	candidates := make(map[uuid.UUID]bool, len(userIDs))
+	for _, id := range userIDs {
+		candidates[id] = true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, entry := range c.entries {
+		for _, result := range entry.results {
+			if candidates[result.UserID] {
+				delete(c.entries, id)
+				break
+			}
+		}
+	}
+}
+
+// Purge drops every cached feed
+func (c *FeedCache) Purge() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[uuid.UUID]feedEntry)
+}
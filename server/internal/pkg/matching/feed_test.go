@@ -0,0 +1,27 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestFeedCacheInvalidateCandidates(t *testing.T) {
+	cache := NewFeedCache(time.Minute)
+
+	viewerA, viewerB := uuid.New(), uuid.New()
+	candidate, other := uuid.New(), uuid.New()
+
+	cache.Set(viewerA, []Result{{UserID: other}, {UserID: candidate}})
+	cache.Set(viewerB, []Result{{UserID: other}})
+
+	cache.InvalidateCandidates(candidate)
+
+	if _, ok := cache.Get(viewerA); ok {
+		t.Error("feed recommending the changed candidate is still cached")
+	}
+	if _, ok := cache.Get(viewerB); !ok {
+		t.Error("feed without the changed candidate was dropped")
+	}
+}
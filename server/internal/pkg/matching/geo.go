@@ -0,0 +1,21 @@
+package matching
+
+import (
+	"math"
+
+	"match-me/ent/schema"
+)
+
+const earthRadiusKm = 6371.0
+
+// Distance returns the great-circle distance between two points in kilometers
+func Distance(a, b *schema.Point) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
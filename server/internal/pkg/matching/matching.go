@@ -0,0 +1,182 @@
+package matching
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"match-me/ent"
+
+	"github.com/google/uuid"
+)
+
+// Signal names reported in score breakdowns. Availability is never reported.
+const (
+	SignalInterests          = "shared_interests"
+	SignalCommunicationStyle = "communication_style"
+	SignalPrompts            = "shared_prompts"
+	SignalDistance           = "distance"
+	SignalAgeFit             = "age_fit"
+	SignalRecency            = "recency"
+	SignalCompleteness       = "profile_completeness"
+	SignalAvailability       = "availability"
+)
+
+// Scorer rates how compatible a candidate is for the viewing user
+type Scorer interface {
+	Score(viewer, candidate *ent.User) Result
+}
+
+// SignalFunc rates a single aspect of compatibility between 0 and 1,
+// with a short human readable reason when the signal is worth mentioning
+type SignalFunc func(viewer, candidate *ent.User, now time.Time) (float64, string)
+
+// WeightedSignal is a signal and its share of the final score.
+// Hidden signals count towards the score but are left out of the breakdown.
+type WeightedSignal struct {
+	Name   string
+	Weight float64
+	Func   SignalFunc
+	Hidden bool
+}
+
+// Signal is the contribution of one signal to a result
+type Signal struct {
+	Name   string  `json:"name"`
+	Value  float64 `json:"value"`
+	Weight float64 `json:"weight"`
+	Reason string  `json:"reason,omitempty"`
+}
+
+// Result is the score of a candidate (0-100) with its per-signal breakdown
+type Result struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Score   float64   `json:"score"`
+	Signals []Signal  `json:"signals"`
+}
+
+// Reasons lists the reasons of the signals that contributed, strongest first
+func (r Result) Reasons() []string {
+	signals := make([]Signal, 0, len(r.Signals))
+	for _, s := range r.Signals {
+		if s.Reason != "" && s.Value > 0 {
+			signals = append(signals, s)
+		}
+	}
+
+	sort.SliceStable(signals, func(i, j int) bool {
+		return signals[i].Value*signals[i].Weight > signals[j].Value*signals[j].Weight
+	})
+
+	reasons := make([]string, len(signals))
+	for i, s := range signals {
+		reasons[i] = s.Reason
+	}
+	return reasons
+}
+
+// Weights sets how much each default signal counts towards the final score
+type Weights struct {
+	Interests          float64
+	CommunicationStyle float64
+	Prompts            float64
+	Distance           float64
+	AgeFit             float64
+	Recency            float64
+	Completeness       float64
+	Availability       float64
+}
+
+// DefaultWeights favours shared interests and distance
+var DefaultWeights = Weights{
+	Interests:          0.30,
+	CommunicationStyle: 0.05,
+	Prompts:            0.05,
+	Distance:           0.25,
+	AgeFit:             0.15,
+	Recency:            0.10,
+	Completeness:       0.10,
+	Availability:       0.05,
+}
+
+// WeightedScorer combines signals into a weighted average
+type WeightedScorer struct {
+	signals []WeightedSignal
+	now     func() time.Time
+}
+
+// NewWeightedScorer creates a scorer from arbitrary signals
+func NewWeightedScorer(signals ...WeightedSignal) *WeightedScorer {
+	return &WeightedScorer{signals: signals, now: time.Now}
+}
+
+// NewScorer creates a scorer using the default signals with the given weights
+func NewScorer(w Weights) *WeightedScorer {
+	return NewWeightedScorer(
+		WeightedSignal{Name: SignalInterests, Weight: w.Interests, Func: SharedInterests},
+		WeightedSignal{Name: SignalCommunicationStyle, Weight: w.CommunicationStyle, Func: CommunicationStyle},
+		WeightedSignal{Name: SignalPrompts, Weight: w.Prompts, Func: SharedPrompts},
+		WeightedSignal{Name: SignalDistance, Weight: w.Distance, Func: DistanceDecay},
+		WeightedSignal{Name: SignalAgeFit, Weight: w.AgeFit, Func: AgeFit},
+		WeightedSignal{Name: SignalRecency, Weight: w.Recency, Func: Recency},
+		WeightedSignal{Name: SignalCompleteness, Weight: w.Completeness, Func: Completeness},
+		WeightedSignal{Name: SignalAvailability, Weight: w.Availability, Func: Availability, Hidden: true},
+	)
+}
+
+func (s *WeightedScorer) Score(viewer, candidate *ent.User) Result {
+	now := s.now()
+	result := Result{
+		UserID:  candidate.ID,
+		Signals: make([]Signal, 0, len(s.signals)),
+	}
+
+	var total, weights float64
+	for _, ws := range s.signals {
+		if ws.Weight <= 0 {
+			continue
+		}
+
+		value, reason := ws.Func(viewer, candidate, now)
+		value = math.Max(0, math.Min(1, value))
+
+		total += value * ws.Weight
+		weights += ws.Weight
+		if ws.Hidden {
+			continue
+		}
+		result.Signals = append(result.Signals, Signal{
+			Name:   ws.Name,
+			Value:  round(value, 3),
+			Weight: ws.Weight,
+			Reason: reason,
+		})
+	}
+
+	if weights > 0 {
+		result.Score = round(total/weights*100, 2)
+	}
+	return result
+}
+
+// Rank scores all candidates and sorts them best first. Ties are broken by user ID
+// so the order is stable between requests.
+func Rank(scorer Scorer, viewer *ent.User, candidates []*ent.User) []Result {
+	results := make([]Result, len(candidates))
+	for i, candidate := range candidates {
+		results[i] = scorer.Score(viewer, candidate)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].UserID.String() < results[j].UserID.String()
+	})
+	return results
+}
+
+func round(v float64, places int) float64 {
+	p := math.Pow(10, float64(places))
+	return math.Round(v*p) / p
+}
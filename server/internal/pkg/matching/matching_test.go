@@ -0,0 +1,58 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"match-me/ent"
+	"match-me/ent/user"
+
+	"github.com/google/uuid"
+)
+
+func TestScoreHidesAvailability(t *testing.T) {
+	scorer := NewScorer(DefaultWeights)
+	viewer := &ent.User{ID: uuid.New()}
+
+	online := &ent.User{ID: uuid.New(), ShowLastSeen: true, LastSeenAt: time.Now(), StatusMode: user.StatusModeAvailable}
+	away := &ent.User{ID: uuid.New(), ShowLastSeen: true, LastSeenAt: time.Now().Add(-2 * time.Hour), StatusMode: user.StatusModeAvailable}
+
+	onlineResult := scorer.Score(viewer, online)
+	for _, signal := range onlineResult.Signals {
+		if signal.Name == SignalAvailability {
+			t.Fatal("availability is reported in the score breakdown")
+		}
+	}
+
+	// The boost still counts towards the score
+	if onlineResult.Score <= scorer.Score(viewer, away).Score {
+		t.Error("recently seen candidate is not ranked higher")
+	}
+}
+
+func TestAvailability(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		candidate *ent.User
+		want      float64
+	}{
+		{"seen recently", &ent.User{ShowLastSeen: true, LastSeenAt: now.Add(-30 * time.Minute), StatusMode: user.StatusModeAvailable}, 1},
+		{"busy", &ent.User{ShowLastSeen: true, LastSeenAt: now, StatusMode: user.StatusModeBusy}, 1},
+		{"invisible", &ent.User{ShowLastSeen: true, LastSeenAt: now, StatusMode: user.StatusModeInvisible}, 0},
+		{"hides last seen", &ent.User{ShowLastSeen: false, LastSeenAt: now, StatusMode: user.StatusModeAvailable}, 0},
+		{"never seen", &ent.User{ShowLastSeen: true, StatusMode: user.StatusModeAvailable}, 0},
+		{"seen too long ago", &ent.User{ShowLastSeen: true, LastSeenAt: now.Add(-2 * time.Hour), StatusMode: user.StatusModeAvailable}, 0},
+	}
+
+	for _, tt := range tests {
+		value, reason := Availability(nil, tt.candidate, now)
+		if value != tt.want {
+			t.Errorf("%s: Availability() = %v, want %v", tt.name, value, tt.want)
+		}
+		if reason != "" {
+			t.Errorf("%s: Availability() gave reason %q, want none", tt.name, reason)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package matching
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"match-me/ent"
+	"match-me/ent/user"
+)
+
+const (
+	// interestTarget is the number of shared items that counts as a perfect overlap
+	interestTarget = 6
+
+	// recencyHalfLife is how long it takes an inactive profile to lose half its recency score
+	recencyHalfLife = 7 * 24 * time.Hour
+
+	// availabilityWindow is how recently a user must have been seen to count as available.
+	// It is kept coarse so rankings don't reveal who is online right now.
+	availabilityWindow = time.Hour
+)
+
+// SharedInterests rates the overlap of interests, music, food and what both users are looking for
+func SharedInterests(viewer, candidate *ent.User, _ time.Time) (float64, string) {
+	shared := countShared(viewer.Interests, candidate.Interests) +
+		countShared(viewer.MusicPreferences, candidate.MusicPreferences) +
+		countShared(viewer.FoodPreferences, candidate.FoodPreferences) +
+		countShared(viewer.LookingFor, candidate.LookingFor)
+
+	if shared == 0 {
+		return 0, ""
+	}
+	return float64(shared) / interestTarget, plural(shared, "shared interest", "shared interests")
+}
+
+// CommunicationStyle rates whether both users communicate the same way
+func CommunicationStyle(viewer, candidate *ent.User, _ time.Time) (float64, string) {
+	if viewer.CommunicationStyle == "" || viewer.CommunicationStyle != candidate.CommunicationStyle {
+		return 0, ""
+	}
+	return 1, "same communication style"
+}
+
+// SharedPrompts rates how many of the same prompts both users answered
+func SharedPrompts(viewer, candidate *ent.User, _ time.Time) (float64, string) {
+	if len(viewer.Prompts) == 0 || len(candidate.Prompts) == 0 {
+		return 0, ""
+	}
+
+	var a, b []string
+	for _, p := range viewer.Prompts {
+		a = append(a, p.Question)
+	}
+	for _, p := range candidate.Prompts {
+		b = append(b, p.Question)
+	}
+
+	shared := countShared(a, b)
+	if shared == 0 {
+		return 0, ""
+	}
+	return float64(shared) / float64(min(len(a), len(b))), plural(shared, "prompt answered by both", "prompts answered by both")
+}
+
+// DistanceDecay rates closer users higher, halving the score at half the viewer's preferred distance
+func DistanceDecay(viewer, candidate *ent.User, _ time.Time) (float64, string) {
+	if viewer.Coordinates == nil || candidate.Coordinates == nil {
+		return 0, ""
+	}
+
+	km := Distance(viewer.Coordinates, candidate.Coordinates)
+	scale := float64(viewer.PreferredDistance) / 2
+	if scale <= 0 {
+		scale = 25
+	}
+
+	value := math.Pow(0.5, km/scale)
+	if km < 1 {
+		return value, "less than 1 km away"
+	}
+	return value, fmt.Sprintf("%d km away", int(math.Round(km)))
+}
+
+// AgeFit rates whether each user's age falls in the other's preferred range
+func AgeFit(viewer, candidate *ent.User, _ time.Time) (float64, string) {
+	viewerLikes := inAgeRange(candidate.Age, viewer.PreferredAgeMin, viewer.PreferredAgeMax)
+	candidateLikes := inAgeRange(viewer.Age, candidate.PreferredAgeMin, candidate.PreferredAgeMax)
+
+	switch {
+	case viewerLikes && candidateLikes:
+		return 1, "within each other's age preferences"
+	case viewerLikes:
+		return 0.5, "within your age preference"
+	case candidateLikes:
+		return 0.5, "you are within their age preference"
+	default:
+		return 0, ""
+	}
+}
+
+// Recency rates recently active profiles higher. The last profile update is the
+// most recent activity stored for a user.
+func Recency(_, candidate *ent.User, now time.Time) (float64, string) {
+	if candidate.UpdatedAt.IsZero() {
+		return 0, ""
+	}
+
+	idle := now.Sub(candidate.UpdatedAt)
+	if idle < 0 {
+		idle = 0
+	}
+
+	value := math.Pow(0.5, float64(idle)/float64(recencyHalfLife))
+	days := int(idle / (24 * time.Hour))
+	switch {
+	case days == 0:
+		return value, "active today"
+	case days < 7:
+		return value, fmt.Sprintf("active %s ago", plural(days, "day", "days"))
+	default:
+		return value, ""
+	}
+}
+
+// Completeness rates fuller profiles higher
+func Completeness(_, candidate *ent.User, _ time.Time) (float64, string) {
+	if candidate.ProfileCompletion >= 100 {
+		return 1, "complete profile"
+	}
+	return float64(candidate.ProfileCompletion) / 100, ""
+}
+
+// Availability gives a small boost to users seen within the last hour who are not invisible.
+// Candidates are mostly strangers, so it never gives a reason and is hidden from the breakdown;
+// users who hide when they were last seen are never rated. Cached feeds are not refreshed on
+// presence changes, so the boost can lag by up to the feed cache TTL, which is well inside the window.
+func Availability(_, candidate *ent.User, now time.Time) (float64, string) {
+	if !candidate.ShowLastSeen || candidate.LastSeenAt.IsZero() || now.Sub(candidate.LastSeenAt) > availabilityWindow {
+		return 0, ""
+	}
+	if candidate.StatusMode == user.StatusModeInvisible {
+		return 0, ""
+	}
+	return 1, ""
+}
+
+func inAgeRange(age, minAge, maxAge int) bool {
+	if minAge > 0 && age < minAge {
+		return false
+	}
+	if maxAge > 0 && age > maxAge {
+		return false
+	}
+	return true
+}
+
+func countShared(a, b []string) int {
+	set := make(map[string]struct{}, len(a))
+	for _, v := range a {
+		set[strings.ToLower(v)] = struct{}{}
+	}
+
+	count := 0
+	for _, v := range b {
+		key := strings.ToLower(v)
+		if _, exists := set[key]; exists {
+			count++
+			delete(set, key)
+		}
+	}
+	return count
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", one)
+	}
+	return fmt.Sprintf("%d %s", n, many)
+}
@@ -0,0 +1,263 @@
+package matching
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"match-me/ent"
+	"match-me/ent/schema"
+
+	"github.com/google/uuid"
+)
+
+type signalCase struct {
+	name      string
+	viewer    *ent.User
+	candidate *ent.User
+	value     float64
+	reason    string
+}
+
+func runSignalCases(t *testing.T, signal SignalFunc, now time.Time, tests []signalCase) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, reason := signal(tt.viewer, tt.candidate, now)
+			if math.Abs(value-tt.value) > 1e-3 {
+				t.Errorf("value = %v, want %v", value, tt.value)
+			}
+			if reason != tt.reason {
+				t.Errorf("reason = %q, want %q", reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestSharedInterests(t *testing.T) {
+	runSignalCases(t, SharedInterests, time.Now(), []signalCase{
+		{
+			name:      "nothing shared",
+			viewer:    &ent.User{Interests: []string{"hiking"}},
+			candidate: &ent.User{Interests: []string{"chess"}},
+			value:     0,
+		},
+		{
+			name:      "shared across categories, ignoring case",
+			viewer:    &ent.User{Interests: []string{"Hiking"}, MusicPreferences: []string{"jazz"}, FoodPreferences: []string{"sushi"}},
+			candidate: &ent.User{Interests: []string{"hiking"}, MusicPreferences: []string{"Jazz"}, FoodPreferences: []string{"tacos"}},
+			value:     2.0 / interestTarget,
+			reason:    "2 shared interests",
+		},
+		{
+			name:      "duplicates count once",
+			viewer:    &ent.User{Interests: []string{"hiking"}},
+			candidate: &ent.User{Interests: []string{"hiking", "Hiking"}},
+			value:     1.0 / interestTarget,
+			reason:    "1 shared interest",
+		},
+	})
+}
+
+func TestCommunicationStyle(t *testing.T) {
+	runSignalCases(t, CommunicationStyle, time.Now(), []signalCase{
+		{
+			name:      "same style",
+			viewer:    &ent.User{CommunicationStyle: "texting"},
+			candidate: &ent.User{CommunicationStyle: "texting"},
+			value:     1,
+			reason:    "same communication style",
+		},
+		{
+			name:      "different style",
+			viewer:    &ent.User{CommunicationStyle: "texting"},
+			candidate: &ent.User{CommunicationStyle: "calls"},
+			value:     0,
+		},
+		{
+			name:      "no style set",
+			viewer:    &ent.User{},
+			candidate: &ent.User{},
+			value:     0,
+		},
+	})
+}
+
+func TestSharedPrompts(t *testing.T) {
+	prompts := func(questions ...string) []schema.Prompt {
+		result := make([]schema.Prompt, len(questions))
+		for i, q := range questions {
+			result[i] = schema.Prompt{Question: q}
+		}
+		return result
+	}
+
+	runSignalCases(t, SharedPrompts, time.Now(), []signalCase{
+		{
+			name:      "no prompts",
+			viewer:    &ent.User{},
+			candidate: &ent.User{Prompts: prompts("a")},
+			value:     0,
+		},
+		{
+			name:      "rated against the shorter list",
+			viewer:    &ent.User{Prompts: prompts("a", "b", "c", "d")},
+			candidate: &ent.User{Prompts: prompts("a", "x")},
+			value:     0.5,
+			reason:    "1 prompt answered by both",
+		},
+	})
+}
+
+func TestDistanceDecay(t *testing.T) {
+	helsinki := &schema.Point{Longitude: 24.9384, Latitude: 60.1699}
+	espoo := &schema.Point{Longitude: 24.6559, Latitude: 60.2055}
+
+	km := Distance(helsinki, espoo)
+
+	runSignalCases(t, DistanceDecay, time.Now(), []signalCase{
+		{
+			name:      "missing location",
+			viewer:    &ent.User{Coordinates: helsinki},
+			candidate: &ent.User{},
+			value:     0,
+		},
+		{
+			name:      "same place",
+			viewer:    &ent.User{Coordinates: helsinki, PreferredDistance: 50},
+			candidate: &ent.User{Coordinates: helsinki},
+			value:     1,
+			reason:    "less than 1 km away",
+		},
+		{
+			name:      "halves every half of the preferred distance",
+			viewer:    &ent.User{Coordinates: helsinki, PreferredDistance: 50},
+			candidate: &ent.User{Coordinates: espoo},
+			value:     math.Pow(0.5, km/25),
+			reason:    "16 km away",
+		},
+	})
+}
+
+func TestAgeFit(t *testing.T) {
+	runSignalCases(t, AgeFit, time.Now(), []signalCase{
+		{
+			name:      "both within range",
+			viewer:    &ent.User{Age: 30, PreferredAgeMin: 25, PreferredAgeMax: 35},
+			candidate: &ent.User{Age: 28, PreferredAgeMin: 25, PreferredAgeMax: 35},
+			value:     1,
+			reason:    "within each other's age preferences",
+		},
+		{
+			name:      "only the viewer's preference",
+			viewer:    &ent.User{Age: 40, PreferredAgeMin: 25, PreferredAgeMax: 35},
+			candidate: &ent.User{Age: 28, PreferredAgeMin: 25, PreferredAgeMax: 35},
+			value:     0.5,
+			reason:    "within your age preference",
+		},
+		{
+			name:      "unset bounds accept any age",
+			viewer:    &ent.User{Age: 60},
+			candidate: &ent.User{Age: 20},
+			value:     1,
+			reason:    "within each other's age preferences",
+		},
+		{
+			name:      "neither",
+			viewer:    &ent.User{Age: 60, PreferredAgeMax: 30},
+			candidate: &ent.User{Age: 40, PreferredAgeMax: 30},
+			value:     0,
+		},
+	})
+}
+
+func TestRecency(t *testing.T) {
+	now := time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)
+
+	runSignalCases(t, Recency, now, []signalCase{
+		{
+			name:      "never updated",
+			candidate: &ent.User{},
+			value:     0,
+		},
+		{
+			name:      "active today",
+			candidate: &ent.User{UpdatedAt: now.Add(-time.Hour)},
+			value:     math.Pow(0.5, float64(time.Hour)/float64(recencyHalfLife)),
+			reason:    "active today",
+		},
+		{
+			name:      "one half-life ago",
+			candidate: &ent.User{UpdatedAt: now.Add(-recencyHalfLife)},
+			value:     0.5,
+		},
+		{
+			name:      "updated in the future",
+			candidate: &ent.User{UpdatedAt: now.Add(time.Hour)},
+			value:     1,
+			reason:    "active today",
+		},
+	})
+}
+
+func TestCompleteness(t *testing.T) {
+	runSignalCases(t, Completeness, time.Now(), []signalCase{
+		{
+			name:      "complete",
+			candidate: &ent.User{ProfileCompletion: 100},
+			value:     1,
+			reason:    "complete profile",
+		},
+		{
+			name:      "partial",
+			candidate: &ent.User{ProfileCompletion: 80},
+			value:     0.8,
+		},
+	})
+}
+
+func TestWeightedScorer(t *testing.T) {
+	fixed := func(value float64, reason string) SignalFunc {
+		return func(_, _ *ent.User, _ time.Time) (float64, string) { return value, reason }
+	}
+
+	scorer := NewWeightedScorer(
+		WeightedSignal{Name: "strong", Weight: 3, Func: fixed(1, "strong reason")},
+		WeightedSignal{Name: "weak", Weight: 1, Func: fixed(0.5, "weak reason")},
+		WeightedSignal{Name: "clamped", Weight: 1, Func: fixed(2, "")},
+		WeightedSignal{Name: "disabled", Weight: 0, Func: fixed(1, "never shown")},
+	)
+
+	result := scorer.Score(&ent.User{}, &ent.User{ID: uuid.New()})
+
+	// (1*3 + 0.5*1 + 1*1) / 5
+	if result.Score != 90 {
+		t.Errorf("Score = %v, want 90", result.Score)
+	}
+	if len(result.Signals) != 3 {
+		t.Errorf("got %d signals, want 3 (zero weights are skipped)", len(result.Signals))
+	}
+
+	reasons := result.Reasons()
+	if len(reasons) != 2 || reasons[0] != "strong reason" || reasons[1] != "weak reason" {
+		t.Errorf("Reasons() = %v, want strongest first", reasons)
+	}
+}
+
+func TestRank(t *testing.T) {
+	scorer := NewWeightedScorer(WeightedSignal{Name: "completeness", Weight: 1, Func: Completeness})
+
+	low := &ent.User{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), ProfileCompletion: 50}
+	tieB := &ent.User{ID: uuid.MustParse("00000000-0000-0000-0000-00000000000b"), ProfileCompletion: 90}
+	tieA := &ent.User{ID: uuid.MustParse("00000000-0000-0000-0000-00000000000a"), ProfileCompletion: 90}
+
+	results := Rank(scorer, &ent.User{}, []*ent.User{low, tieB, tieA})
+
+	want := []uuid.UUID{tieA.ID, tieB.ID, low.ID}
+	for i, id := range want {
+		if results[i].UserID != id {
+			t.Fatalf("Rank order = %v, want best first with ties broken by user ID", results)
+		}
+	}
+}
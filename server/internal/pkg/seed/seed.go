@@ -203,6 +203,7 @@ func (s *Seeder) PopulateUsers(ctx context.Context, n int) error {
 			SetCommunicationStyle(commStyle).
 			SetPrompts(prompts).
 			SetProfileCompletion(100).
+			SetEmailVerifiedAt(time.Now()).
 			Save(ctx)
 
 		if err != nil {
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"match-me/internal/pkg/cloudinary"
+
+	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+)
+
+type cloudinaryStore struct {
+	cld cloudinary.Cloudinary
+}
+
+// NewCloudinaryStore creates a MediaStore backed by Cloudinary
+func NewCloudinaryStore(cld cloudinary.Cloudinary) MediaStore {
+	return &cloudinaryStore{
+		cld: cld,
+	}
+}
+
+func (s *cloudinaryStore) Upload(ctx context.Context, r io.Reader, opts UploadOptions) (*Object, error) {
+	data, contentType, err := readContent(r, opts.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	url, publicID, err := s.cld.UploadImage(bytes.NewReader(data), uploader.UploadParams{
+		Folder:   opts.Folder,
+		PublicID: opts.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Object{
+		Key:         publicID,
+		Ref:         url,
+		URL:         url,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}, nil
+}
+
+func (s *cloudinaryStore) Delete(ctx context.Context, key string) error {
+	return s.cld.DeleteImage(key)
+}
+
+func (s *cloudinaryStore) DeleteFolder(ctx context.Context, folder string) error {
+	return s.cld.DeleteFolder(folder)
+}
+
+func (s *cloudinaryStore) URL(key string) string {
+	return s.cld.GetImageURL(key)
+}
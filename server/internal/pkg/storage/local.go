@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalStore keeps media on the local filesystem, one file per stored object.
+// Objects are downloaded through signed, expiring URLs served by the /media route.
+type LocalStore struct {
+	root    string
+	baseURL string
+	secret  []byte
+	ttl     time.Duration
+}
+
+// NewLocalStore creates a LocalStore rooted at dir, generating URLs below baseURL + "/media/"
+func NewLocalStore(dir, baseURL, secret string, ttl time.Duration) (*LocalStore, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("MEDIA_URL_SECRET is required for the local media store")
+	}
+
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid media directory: %w", err)
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create media directory: %w", err)
+	}
+
+	return &LocalStore{
+		root:    root,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		secret:  []byte(secret),
+		ttl:     ttl,
+	}, nil
+}
+
+func (s *LocalStore) Upload(ctx context.Context, r io.Reader, opts UploadOptions) (*Object, error) {
+	data, contentType, err := readContent(r, opts.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := objectKey(opts, contentType)
+	if err != nil {
+		return nil, err
+	}
+	path := s.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create media folder: %w", err)
+	}
+
+	// Write to a temporary file first so readers never see partial content
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create media file: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, fmt.Errorf("failed to write media file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return nil, fmt.Errorf("failed to write media file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return nil, fmt.Errorf("failed to store media file: %w", err)
+	}
+
+	return &Object{
+		Key:         key,
+		Ref:         Ref(key),
+		URL:         s.URL(key),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}, nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(s.path(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete media file: %w", err)
+	}
+	return nil
+}
+
+func (s *LocalStore) DeleteFolder(ctx context.Context, folder string) error {
+	folder, err := cleanKey(folder)
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(s.path(folder)); err != nil {
+		return fmt.Errorf("failed to delete media folder: %w", err)
+	}
+	return nil
+}
+
+// URL returns a signed download URL. Expiry is aligned to half the TTL so the URL
+// of an object stays stable (and cacheable) for a while.
+func (s *LocalStore) URL(key string) string {
+	window := s.ttl / 2
+	if window <= 0 {
+		window = time.Minute
+	}
+	expires := time.Now().Truncate(window).Add(s.ttl).Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("sig", s.sign(key, expires))
+
+	return fmt.Sprintf("%s/media/%s?%s", s.baseURL, key, query.Encode())
+}
+
+// Open verifies a signed URL and returns the local file path of the object
+func (s *LocalStore) Open(key, expiresStr, sig string) (string, time.Time, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expires, err := strconv.ParseInt(expiresStr, 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid media signature")
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(s.sign(key, expires))) {
+		return "", time.Time{}, fmt.Errorf("invalid media signature")
+	}
+
+	expiresAt := time.Unix(expires, 0)
+	if time.Now().After(expiresAt) {
+		return "", time.Time{}, fmt.Errorf("media URL has expired")
+	}
+
+	path := s.path(key)
+	if _, err := os.Stat(path); err != nil {
+		return "", time.Time{}, fmt.Errorf("media not found")
+	}
+
+	return path, expiresAt, nil
+}
+
+func (s *LocalStore) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalStore) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
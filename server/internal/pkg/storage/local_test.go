@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestLocalStoreUploadsGetUniqueKeys(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), "http://localhost:8080", "media-secret", time.Hour)
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+
+	ctx := context.Background()
+	content := []byte("same bytes uploaded twice")
+
+	first, err := store.Upload(ctx, bytes.NewReader(content), UploadOptions{Folder: "user-photos/a"})
+	if err != nil {
+		t.Fatalf("first upload: %v", err)
+	}
+	second, err := store.Upload(ctx, bytes.NewReader(content), UploadOptions{Folder: "user-photos/a"})
+	if err != nil {
+		t.Fatalf("second upload: %v", err)
+	}
+
+	if first.Key == second.Key {
+		t.Fatalf("identical uploads share key %s", first.Key)
+	}
+
+	// Deleting one upload must leave the other intact
+	if err := store.Delete(ctx, first.Key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := os.Stat(store.path(second.Key)); err != nil {
+		t.Errorf("second upload was removed with the first: %v", err)
+	}
+}
+
+func TestObjectKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        UploadOptions
+		contentType string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "named object",
+			opts:        UploadOptions{Folder: "user-photos/a", Name: "photo_1_full"},
+			contentType: "image/jpeg",
+			want:        "user-photos/a/photo_1_full.jpg",
+		},
+		{
+			name:        "name with a path separator",
+			opts:        UploadOptions{Folder: "user-photos/a", Name: "../b/photo"},
+			contentType: "image/jpeg",
+			wantErr:     true,
+		},
+		{
+			name:        "folder escaping the root",
+			opts:        UploadOptions{Folder: "../../etc", Name: "photo"},
+			contentType: "image/png",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := objectKey(tt.opts, tt.contentType)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("objectKey() = %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("objectKey: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("objectKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
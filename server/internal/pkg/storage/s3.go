@@ -0,0 +1,358 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Options configure an S3 compatible bucket (AWS S3, MinIO, R2, ...)
+type S3Options struct {
+	Endpoint  string        // e.g. https://s3.eu-north-1.amazonaws.com or http://localhost:9000
+	Region    string        // Signing region, "us-east-1" for most S3 compatible servers
+	Bucket    string        // Bucket name
+	AccessKey string        // Access key ID
+	SecretKey string        // Secret access key
+	PublicURL string        // Optional public base URL; when empty objects are served via presigned URLs
+	PathStyle bool          // Address the bucket as <endpoint>/<bucket> instead of <bucket>.<host>
+	URLTTL    time.Duration // Lifetime of presigned download URLs
+}
+
+type s3Store struct {
+	opts     S3Options
+	endpoint *url.URL
+	http     *http.Client
+}
+
+// NewS3Store creates a MediaStore backed by an S3 compatible bucket.
+// Requests are signed with AWS Signature Version 4.
+func NewS3Store(opts S3Options) (MediaStore, error) {
+	if opts.Endpoint == "" || opts.Bucket == "" || opts.AccessKey == "" || opts.SecretKey == "" {
+		return nil, fmt.Errorf("S3 endpoint, bucket and credentials are required")
+	}
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+	if opts.URLTTL <= 0 {
+		opts.URLTTL = time.Hour
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(opts.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint: %s", opts.Endpoint)
+	}
+
+	return &s3Store{
+		opts:     opts,
+		endpoint: endpoint,
+		http:     &http.Client{Timeout: 60 * time.Second},
+	}, nil
+}
+
+func (s *s3Store) Upload(ctx context.Context, r io.Reader, opts UploadOptions) (*Object, error) {
+	data, contentType, err := readContent(r, opts.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := objectKey(opts, contentType)
+	if err != nil {
+		return nil, err
+	}
+	headers := http.Header{}
+	headers.Set("Content-Type", contentType)
+
+	resp, err := s.do(ctx, http.MethodPut, key, nil, headers, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload media: %w", err)
+	}
+	resp.Body.Close()
+
+	obj := &Object{
+		Key:         key,
+		Ref:         Ref(key),
+		URL:         s.URL(key),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}
+
+	// Public buckets get permanent URLs
+	if s.opts.PublicURL != "" {
+		obj.Ref = obj.URL
+	}
+
+	return obj, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete media: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *s3Store) DeleteFolder(ctx context.Context, folder string) error {
+	prefix := strings.TrimSuffix(folder, "/") + "/"
+	continuation := ""
+
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if continuation != "" {
+			query.Set("continuation-token", continuation)
+		}
+
+		resp, err := s.do(ctx, http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list media folder: %w", err)
+		}
+
+		var result struct {
+			Contents []struct {
+				Key string `xml:"Key"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to parse media folder listing: %w", err)
+		}
+
+		for _, obj := range result.Contents {
+			if err := s.Delete(ctx, obj.Key); err != nil {
+				return err
+			}
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return nil
+		}
+		continuation = result.NextContinuationToken
+	}
+}
+
+// URL returns the public URL when configured, otherwise a presigned GET URL.
+// Like the local store, expiry is aligned to half the TTL to keep URLs cacheable.
+func (s *s3Store) URL(key string) string {
+	if s.opts.PublicURL != "" {
+		return strings.TrimSuffix(s.opts.PublicURL, "/") + "/" + escapePath(key)
+	}
+
+	window := s.opts.URLTTL / 2
+	if window <= 0 {
+		window = time.Minute
+	}
+	signedAt := time.Now().UTC().Truncate(window)
+	expires := int64((s.opts.URLTTL + window) / time.Second)
+	if expires > 604800 {
+		expires = 604800 // SigV4 maximum of 7 days
+	}
+
+	return s.presign(key, signedAt, expires)
+}
+
+// presign builds a presigned GET URL for key, valid for expires seconds after signedAt
+func (s *s3Store) presign(key string, signedAt time.Time, expires int64) string {
+	u := s.objectURL(key)
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", s.opts.AccessKey+"/"+s.scope(signedAt))
+	query.Set("X-Amz-Date", signedAt.Format("20060102T150405Z"))
+	query.Set("X-Amz-Expires", strconv.FormatInt(expires, 10))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonical := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+
+	query.Set("X-Amz-Signature", s.signature(signedAt, canonical))
+	u.RawQuery = canonicalQuery(query)
+	return u.String()
+}
+
+// do sends a signed request for the object key (or the bucket when key is empty)
+func (s *s3Store) do(ctx context.Context, method, key string, query url.Values, headers http.Header, body []byte) (*http.Response, error) {
+	u := s.objectURL(key)
+	if query != nil {
+		u.RawQuery = canonicalQuery(query)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range headers {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	req.ContentLength = int64(len(body))
+
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	s.sign(req, payloadHash, time.Now().UTC())
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 && !(method == http.MethodDelete && resp.StatusCode == http.StatusNotFound) {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, fmt.Errorf("s3 %s %s: %s: %s", method, u.Path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	return resp, nil
+}
+
+// sign adds a SigV4 Authorization header to req, signing the host and every header already set
+func (s *s3Store) sign(req *http.Request, payloadHash string, t time.Time) {
+	req.Header.Set("X-Amz-Date", t.Format("20060102T150405Z"))
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	headers := map[string]string{"host": host}
+	for name := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(req.Header.Get(name))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.opts.AccessKey, s.scope(t), signedHeaders, s.signature(t, canonical)))
+}
+
+func (s *s3Store) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	rawPath := strings.TrimSuffix(u.Path, "/")
+
+	if s.opts.PathStyle {
+		rawPath += "/" + s.opts.Bucket
+	} else {
+		u.Host = s.opts.Bucket + "." + u.Host
+	}
+	if key != "" {
+		rawPath += "/" + key
+	} else {
+		rawPath += "/"
+	}
+
+	u.Path = rawPath
+	u.RawPath = escapePath(rawPath)
+	return &u
+}
+
+func (s *s3Store) scope(t time.Time) string {
+	return t.Format("20060102") + "/" + s.opts.Region + "/s3/aws4_request"
+}
+
+func (s *s3Store) signature(t time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		t.Format("20060102T150405Z"),
+		s.scope(t),
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.opts.SecretKey), t.Format("20060102"))
+	key = hmacSHA256(key, s.opts.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// canonicalQuery encodes query parameters sorted by key as SigV4 requires
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// escapePath URI-encodes every path segment, keeping the slashes
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// uriEncode encodes everything except RFC 3986 unreserved characters
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
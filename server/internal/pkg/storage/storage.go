@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"match-me/config"
+	"match-me/internal/pkg/cloudinary"
+
+	"github.com/google/uuid"
+)
+
+const (
+	BackendCloudinary string = "cloudinary"
+	BackendLocal      string = "local"
+	BackendS3         string = "s3"
+)
+
+// RefScheme prefixes persisted media references whose download URL is generated on read
+// (signed or expiring URLs). Permanent URLs are persisted as-is.
+const RefScheme = "media://"
+
+// MediaStore stores user photos and message media
+type MediaStore interface {
+	// Upload stores the content of r inside folder
+	Upload(ctx context.Context, r io.Reader, opts UploadOptions) (*Object, error)
+	// Delete removes a single object by key
+	Delete(ctx context.Context, key string) error
+	// DeleteFolder removes every object stored inside folder
+	DeleteFolder(ctx context.Context, folder string) error
+	// URL returns a download URL for the object with the given key
+	URL(key string) string
+}
+
+// UploadOptions describe where and how an object is stored
+type UploadOptions struct {
+	Folder      string
+	Name        string // Optional object name, unique per object; a random name is used when empty
+	ContentType string // Detected from the content when empty
+}
+
+// Object is a stored media object
+type Object struct {
+	Key         string // Storage ID, persisted as public ID
+	Ref         string // Value to persist as media URL (permanent URL or RefScheme + Key)
+	URL         string // URL usable right away
+	ContentType string
+	Size        int64
+}
+
+// NewMediaStore returns the MediaStore selected by MEDIA_BACKEND
+func NewMediaStore(cfg *config.Config) (MediaStore, error) {
+	switch cfg.MediaBackend {
+	case BackendCloudinary:
+		cld, err := cloudinary.NewCloudinary()
+		if err != nil {
+			return nil, err
+		}
+		return NewCloudinaryStore(cld), nil
+	case BackendLocal:
+		// Media URLs are signed with their own key, never the one used for auth tokens
+		if cfg.MediaURLSecret != "" && cfg.MediaURLSecret == cfg.JWTSecret {
+			return nil, fmt.Errorf("MEDIA_URL_SECRET must differ from JWT_SECRET")
+		}
+		return NewLocalStore(cfg.MediaLocalDir, cfg.MediaBaseURL, cfg.MediaURLSecret, cfg.MediaURLTTL)
+	case BackendS3:
+		return NewS3Store(S3Options{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PublicURL: cfg.S3PublicURL,
+			PathStyle: cfg.S3PathStyle,
+			URLTTL:    cfg.MediaURLTTL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown media backend: %s", cfg.MediaBackend)
+	}
+}
+
+// Ref returns the persisted reference of an object whose URL is generated on read
+func Ref(key string) string {
+	return RefScheme + key
+}
+
+// ResolveURL turns a persisted media reference into a download URL
+func ResolveURL(store MediaStore, ref string) string {
+	if key, ok := strings.CutPrefix(ref, RefScheme); ok {
+		return store.URL(key)
+	}
+	return ref
+}
+
+// readContent reads the whole upload and detects its content type
+func readContent(r io.Reader, contentType string) ([]byte, string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read upload: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, "", fmt.Errorf("empty upload")
+	}
+
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+
+	return data, contentType, nil
+}
+
+// objectKey builds a key that is unique to one stored object: <folder>/<name><ext>.
+// Uploads never share an object, so deleting one record's media cannot break another's.
+func objectKey(opts UploadOptions, contentType string) (string, error) {
+	name := opts.Name
+	if name == "" {
+		name = uuid.NewString()
+	}
+	if strings.Contains(name, "/") {
+		return "", fmt.Errorf("invalid media name")
+	}
+	return cleanKey(path.Join(opts.Folder, name+extensionFor(contentType)))
+}
+
+func extensionFor(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	}
+	if exts, err := mime.ExtensionsByType(contentType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
+
+// cleanKey rejects keys that could escape the storage root
+func cleanKey(key string) (string, error) {
+	key = strings.TrimPrefix(key, "/")
+	cleaned := path.Clean(key)
+	if key == "" || cleaned != key || cleaned == "." || strings.HasPrefix(cleaned, "../") || cleaned == ".." {
+		return "", fmt.Errorf("invalid media key")
+	}
+	return cleaned, nil
+}
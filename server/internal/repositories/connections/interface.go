@@ -3,6 +3,7 @@ package connections
 import (
 	"context"
 	"match-me/ent"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -26,7 +27,7 @@ type ConnectionRepository interface {
 // ConnectionRequestRepository defines methods for managing connection requests.
 type ConnectionRequestRepository interface {
 	// Request management
-	CreateConnectionRequest(ctx context.Context, senderID, receiverID uuid.UUID, message string) (*ent.ConnectionRequest, error)
+	CreateConnectionRequest(ctx context.Context, senderID, receiverID uuid.UUID, message string, expiresAt time.Time) (*ent.ConnectionRequest, error)
 	GetConnectionRequest(ctx context.Context, requestID uuid.UUID) (*ent.ConnectionRequest, error)
 	GetConnectionRequestBetweenUsers(ctx context.Context, senderID, receiverID uuid.UUID) (*ent.ConnectionRequest, error)
 	UpdateRequestStatus(ctx context.Context, requestID uuid.UUID, status string) (*ent.ConnectionRequest, error)
@@ -36,37 +37,57 @@ type ConnectionRequestRepository interface {
 	GetPendingRequestsForUser(ctx context.Context, userID uuid.UUID) ([]*ent.ConnectionRequest, error)
 	GetSentRequests(ctx context.Context, userID uuid.UUID) ([]*ent.ConnectionRequest, error)
 	GetReceivedRequests(ctx context.Context, userID uuid.UUID) ([]*ent.ConnectionRequest, error)
+	GetPendingRequestUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 
 	// Request actions
 	AcceptRequest(ctx context.Context, requestID uuid.UUID) (*ent.ConnectionRequest, *ent.Connection, error)
 	DeclineRequest(ctx context.Context, requestID uuid.UUID) (*ent.ConnectionRequest, error)
+	WithdrawRequest(ctx context.Context, requestID, senderID uuid.UUID) error
 
 	// Cleanup
-	ExpireOldRequests(ctx context.Context) (int, error)
+	ExpireOldRequests(ctx context.Context, defaultTTL time.Duration) ([]*ent.ConnectionRequest, error)
 }
 
 // MessageRepository defines methods for managing messages between connected users.
 type MessageRepository interface {
 	// Message management
-	CreateTextMessage(ctx context.Context, connectionID, senderID, receiverID uuid.UUID, content string) (*ent.Message, error)
-	CreateMediaMessage(ctx context.Context, connectionID uuid.UUID, senderID uuid.UUID, receiverID uuid.UUID, mediaURL string, mediaType string, publicID string, txtContent string) (*ent.Message, error)
+	CreateTextMessage(ctx context.Context, connectionID, senderID, receiverID uuid.UUID, content string, replyToID *uuid.UUID, clientMessageID string) (*ent.Message, error)
+	CreateMediaMessage(ctx context.Context, connectionID uuid.UUID, senderID uuid.UUID, receiverID uuid.UUID, mediaURL string, mediaType string, publicID string, txtContent string, replyToID *uuid.UUID) (*ent.Message, error)
 	GetMessage(ctx context.Context, messageID uuid.UUID) (*ent.Message, error)
+	GetMessageWithUsers(ctx context.Context, messageID uuid.UUID) (*ent.Message, error)
+	GetMessageByClientID(ctx context.Context, senderID uuid.UUID, clientMessageID string) (*ent.Message, error)
 	UpdateMessage(ctx context.Context, messageID uuid.UUID, content string) (*ent.Message, error)
 	DeleteMessage(ctx context.Context, messageID uuid.UUID) error
+	EditMessage(ctx context.Context, messageID uuid.UUID, content string) (*ent.Message, error)
+	SoftDeleteMessage(ctx context.Context, messageID uuid.UUID) (*ent.Message, error)
+	GetMessageEdits(ctx context.Context, messageID uuid.UUID) ([]*ent.MessageEdit, error)
 	DeleteMessagesByConnection(ctx context.Context, connID uuid.UUID) error
 
 	// Connection messages
-	GetConnectionMessages(ctx context.Context, connectionID uuid.UUID, limit, offset int) ([]*ent.Message, error)
-	GetConnectionMessagesWithUsers(ctx context.Context, connectionID uuid.UUID, limit, offset int) ([]*ent.Message, error)
+	GetMessagesBefore(ctx context.Context, connectionID uuid.UUID, cursor *MessageCursor, limit int) ([]*ent.Message, error)
+	GetMessagesAfter(ctx context.Context, connectionID uuid.UUID, cursor MessageCursor, limit int) ([]*ent.Message, error)
+	GetLatestMessage(ctx context.Context, connectionID uuid.UUID) (*ent.Message, error)
+	GetMessageReplies(ctx context.Context, messageID uuid.UUID) ([]*ent.Message, error)
 
 	// Read status
 	MarkMessageAsRead(ctx context.Context, messageID uuid.UUID) (*ent.Message, error)
-	MarkConnectionMessagesAsRead(ctx context.Context, connectionID, userID uuid.UUID) (int, error)
+	MarkMessagesReadUpTo(ctx context.Context, connectionID, readerID uuid.UUID, upTo MessageCursor) ([]*ent.Message, error)
+	GetReadMarkers(ctx context.Context, connectionID uuid.UUID) ([]*ent.ReadMarker, error)
 	GetUnreadMessagesCount(ctx context.Context, userID uuid.UUID) (int, error)
 	GetUnreadMessagesForConnection(ctx context.Context, connectionID, userID uuid.UUID) ([]*ent.Message, error)
 
+	// Delivery status
+	MarkMessagesDelivered(ctx context.Context, messageIDs []uuid.UUID) ([]*ent.Message, error)
+	MarkPendingMessagesDelivered(ctx context.Context, receiverID uuid.UUID) ([]*ent.Message, error)
+
+	// Reactions
+	AddReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) (*ent.MessageReaction, error)
+	RemoveReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) (bool, error)
+	GetMessageReactions(ctx context.Context, messageID uuid.UUID) ([]*ent.MessageReaction, error)
+
 	// Message queries
 	GetUserMessages(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*ent.Message, error)
 	GetMediaMessages(ctx context.Context, connectionID uuid.UUID) ([]*ent.Message, error)
-	SearchMessages(ctx context.Context, connectionID uuid.UUID, query string) ([]*ent.Message, error)
+	CountMediaReferences(ctx context.Context, publicID string) (int, error)
+	SearchMessages(ctx context.Context, userID uuid.UUID, connectionID *uuid.UUID, query string, cursor *MessageSearchCursor, limit int) ([]*MessageSearchHit, error)
 }
@@ -0,0 +1,73 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+	"match-me/ent"
+	"match-me/ent/messagereaction"
+
+	"github.com/google/uuid"
+)
+
+// orderReactions loads reactions in the order they were added
+func orderReactions(q *ent.MessageReactionQuery) {
+	q.Order(ent.Asc(messagereaction.FieldCreatedAt), ent.Asc(messagereaction.FieldID))
+}
+
+// AddReaction adds a user's emoji reaction to a message. Adding a reaction that already
+// exists returns the existing reaction.
+func (r *messageRepository) AddReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) (*ent.MessageReaction, error) {
+	reaction, err := r.client.MessageReaction.Create().
+		SetMessageID(messageID).
+		SetUserID(userID).
+		SetEmoji(emoji).
+		Save(ctx)
+	if err == nil {
+		return reaction, nil
+	}
+
+	if !ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	// The unique index rejected a duplicate, so the reaction is already there
+	reaction, err = r.client.MessageReaction.Query().
+		Where(
+			messagereaction.MessageIDEQ(messageID),
+			messagereaction.UserIDEQ(userID),
+			messagereaction.EmojiEQ(emoji),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add reaction: %w", err)
+	}
+	return reaction, nil
+}
+
+// RemoveReaction removes a user's emoji reaction from a message and reports whether it existed
+func (r *messageRepository) RemoveReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) (bool, error) {
+	deleted, err := r.client.MessageReaction.Delete().
+		Where(
+			messagereaction.MessageIDEQ(messageID),
+			messagereaction.UserIDEQ(userID),
+			messagereaction.EmojiEQ(emoji),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove reaction: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+// GetMessageReactions returns all reactions to a message in the order they were added
+func (r *messageRepository) GetMessageReactions(ctx context.Context, messageID uuid.UUID) ([]*ent.MessageReaction, error) {
+	query := r.client.MessageReaction.Query().
+		Where(messagereaction.MessageIDEQ(messageID))
+	orderReactions(query)
+
+	reactions, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message reactions: %w", err)
+	}
+	return reactions, nil
+}
@@ -0,0 +1,214 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+	"match-me/ent"
+	"match-me/ent/connection"
+	"match-me/ent/message"
+	"match-me/ent/predicate"
+	"match-me/ent/readmarker"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MarkMessagesDelivered sets the delivery time of the given messages that were not
+// delivered yet and returns those messages
+func (r *messageRepository) MarkMessagesDelivered(ctx context.Context, messageIDs []uuid.UUID) ([]*ent.Message, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+	return r.markDelivered(ctx, message.IDIn(messageIDs...))
+}
+
+// MarkPendingMessagesDelivered marks every undelivered message addressed to the receiver
+// as delivered and returns those messages
+func (r *messageRepository) MarkPendingMessagesDelivered(ctx context.Context, receiverID uuid.UUID) ([]*ent.Message, error) {
+	return r.markDelivered(ctx, message.ReceiverIDEQ(receiverID))
+}
+
+func (r *messageRepository) markDelivered(ctx context.Context, where predicate.Message) ([]*ent.Message, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Lock the pending messages so each delivery is reported only once
+	messages, err := tx.Message.Query().
+		Where(
+			where,
+			message.DeliveredAtIsNil(),
+			message.IsDeletedEQ(false),
+		).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to get undelivered messages: %w", err)
+	}
+
+	if len(messages) == 0 {
+		tx.Rollback()
+		return messages, nil
+	}
+
+	now := time.Now()
+	ids := make([]uuid.UUID, len(messages))
+	for i, msg := range messages {
+		ids[i] = msg.ID
+		msg.DeliveredAt = now
+	}
+
+	_, err = tx.Message.Update().
+		Where(message.IDIn(ids...)).
+		SetDeliveredAt(now).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to mark messages as delivered: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return messages, nil
+}
+
+// MarkMessagesReadUpTo marks the reader's unread messages up to and including the cursor
+// as read and moves the reader's watermark forward to the cursor. It returns the messages
+// that became read.
+func (r *messageRepository) MarkMessagesReadUpTo(ctx context.Context, connectionID, readerID uuid.UUID, upTo MessageCursor) ([]*ent.Message, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Lock the connection so concurrent reads move the watermark one at a time
+	_, err = tx.Connection.Query().
+		Where(connection.ID(connectionID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	messages, err := tx.Message.Query().
+		Where(
+			message.ConnectionIDEQ(connectionID),
+			message.ReceiverIDEQ(readerID),
+			message.IsReadEQ(false),
+			message.IsDeletedEQ(false),
+			message.Or(
+				message.CreatedAtLT(upTo.CreatedAt),
+				message.And(
+					message.CreatedAtEQ(upTo.CreatedAt),
+					message.IDLTE(upTo.ID),
+				),
+			),
+		).
+		Order(ent.Asc(message.FieldCreatedAt), ent.Asc(message.FieldID)).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to get unread messages: %w", err)
+	}
+
+	now := time.Now()
+	if len(messages) > 0 {
+		ids := make([]uuid.UUID, len(messages))
+		for i, msg := range messages {
+			ids[i] = msg.ID
+			msg.IsRead = true
+			msg.ReadAt = now
+		}
+
+		_, err = tx.Message.Update().
+			Where(message.IDIn(ids...)).
+			SetIsRead(true).
+			SetReadAt(now).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to mark messages as read: %w", err)
+		}
+
+		// A read message has necessarily been delivered
+		_, err = tx.Message.Update().
+			Where(
+				message.IDIn(ids...),
+				message.DeliveredAtIsNil(),
+			).
+			SetDeliveredAt(now).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to mark messages as delivered: %w", err)
+		}
+	}
+
+	if err := moveReadMarker(ctx, tx, connectionID, readerID, upTo); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return messages, nil
+}
+
+// moveReadMarker creates the reader's watermark or moves it forward to the cursor
+func moveReadMarker(ctx context.Context, tx *ent.Tx, connectionID, readerID uuid.UUID, upTo MessageCursor) error {
+	marker, err := tx.ReadMarker.Query().
+		Where(
+			readmarker.ConnectionIDEQ(connectionID),
+			readmarker.UserIDEQ(readerID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to get read marker: %w", err)
+	}
+
+	if marker == nil {
+		_, err = tx.ReadMarker.Create().
+			SetConnectionID(connectionID).
+			SetUserID(readerID).
+			SetLastReadMessageID(upTo.ID).
+			SetLastReadMessageAt(upTo.CreatedAt).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create read marker: %w", err)
+		}
+		return nil
+	}
+
+	// The watermark never moves backwards
+	current := MessageCursor{CreatedAt: marker.LastReadMessageAt, ID: marker.LastReadMessageID}
+	if !upTo.After(current) {
+		return nil
+	}
+
+	_, err = tx.ReadMarker.UpdateOne(marker).
+		SetLastReadMessageID(upTo.ID).
+		SetLastReadMessageAt(upTo.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update read marker: %w", err)
+	}
+	return nil
+}
+
+// GetReadMarkers returns the read watermarks of the users in a connection
+func (r *messageRepository) GetReadMarkers(ctx context.Context, connectionID uuid.UUID) ([]*ent.ReadMarker, error) {
+	markers, err := r.client.ReadMarker.Query().
+		Where(readmarker.ConnectionIDEQ(connectionID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get read markers: %w", err)
+	}
+	return markers, nil
+}
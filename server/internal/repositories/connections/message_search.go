@@ -0,0 +1,127 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+	"match-me/ent"
+	"match-me/ent/message"
+	"match-me/ent/schema"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Highlight markers wrapped around matched terms in search snippets. Control
+// characters never appear in rendered text, so callers can escape the snippet
+// and then swap the markers for markup.
+const (
+	SnippetStartSel = "\x02"
+	SnippetStopSel  = "\x03"
+)
+
+// snippetOptions configures ts_headline for search snippets
+const snippetOptions = "StartSel=" + SnippetStartSel + ", StopSel=" + SnippetStopSel + ", MaxWords=20, MinWords=8, MaxFragments=2"
+
+// MessageSearchCursor is the position of the last hit of a search page
+type MessageSearchCursor struct {
+	Rank      float32   `json:"rank"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// MessageSearchHit is a message matching a search with its rank and highlighted snippet
+type MessageSearchHit struct {
+	Message *ent.Message
+	Rank    float32
+	Snippet string
+}
+
+// SearchMessages runs a full-text search over the messages of the user's connections,
+// or of a single connection when connectionID is set. Hits are ordered by rank, then
+// most recent first, and start after the cursor when one is given.
+func (r *messageRepository) SearchMessages(ctx context.Context, userID uuid.UUID, connectionID *uuid.UUID, query string, cursor *MessageSearchCursor, limit int) ([]*MessageSearchHit, error) {
+	args := []any{query, userID, snippetOptions}
+	conditions := []string{
+		"m.search_vector @@ q.query",
+		"m.is_deleted = false",
+		"(c.user_a_id = $2 OR c.user_b_id = $2)",
+	}
+
+	if connectionID != nil {
+		args = append(args, *connectionID)
+		conditions = append(conditions, fmt.Sprintf("m.connection_id = $%d", len(args)))
+	}
+
+	if cursor != nil {
+		args = append(args, cursor.Rank, cursor.CreatedAt, cursor.ID)
+		n := len(args)
+		conditions = append(conditions, fmt.Sprintf(
+			"(ts_rank(m.search_vector, q.query), m.created_at, m.id) < ($%d::real, $%d::timestamptz, $%d::uuid)",
+			n-2, n-1, n,
+		))
+	}
+
+	args = append(args, limit)
+	stmt := fmt.Sprintf(`
+		SELECT m.id, ts_rank(m.search_vector, q.query) AS rank,
+			ts_headline('%[1]s', m.content, q.query, $3) AS snippet
+		FROM messages m
+		JOIN connections c ON c.id = m.connection_id
+		CROSS JOIN websearch_to_tsquery('%[1]s', $1) AS q(query)
+		WHERE %[2]s
+		ORDER BY rank DESC, m.created_at DESC, m.id DESC
+		LIMIT $%[3]d`,
+		schema.MessageSearchConfig, strings.Join(conditions, " AND "), len(args),
+	)
+
+	rows, err := r.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+	defer rows.Close()
+
+	hits := make([]*MessageSearchHit, 0, limit)
+	ids := make([]uuid.UUID, 0, limit)
+	for rows.Next() {
+		hit := &MessageSearchHit{}
+		var id uuid.UUID
+		if err := rows.Scan(&id, &hit.Rank, &hit.Snippet); err != nil {
+			return nil, fmt.Errorf("failed to scan search result: %w", err)
+		}
+		hits = append(hits, hit)
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return hits, nil
+	}
+
+	// Load the matched messages through ent so media references are resolved
+	messages, err := r.client.Message.Query().
+		Where(message.IDIn(ids...)).
+		WithSender().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matched messages: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*ent.Message, len(messages))
+	for _, msg := range messages {
+		byID[msg.ID] = msg
+	}
+
+	// Keep the ranked order, skipping messages removed since the search ran
+	results := hits[:0]
+	for i, hit := range hits {
+		if msg, ok := byID[ids[i]]; ok {
+			hit.Message = msg
+			results = append(results, hit)
+		}
+	}
+
+	return results, nil
+}
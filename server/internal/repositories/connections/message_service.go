@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"match-me/ent"
 	"match-me/ent/message"
-	"strings"
+	"match-me/ent/messageedit"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,21 +21,42 @@ func NewMessageRepository(client *ent.Client) MessageRepository {
 	}
 }
 
-func (r *messageRepository) CreateTextMessage(ctx context.Context, connectionID, senderID, receiverID uuid.UUID, content string) (*ent.Message, error) {
-	msg, err := r.client.Message.Create().
+func (r *messageRepository) CreateTextMessage(ctx context.Context, connectionID, senderID, receiverID uuid.UUID, content string, replyToID *uuid.UUID, clientMessageID string) (*ent.Message, error) {
+	create := r.client.Message.Create().
 		SetConnectionID(connectionID).
 		SetSenderID(senderID).
 		SetReceiverID(receiverID).
 		SetType(message.TypeText).
 		SetContent(content).
-		Save(ctx)
+		SetNillableReplyToID(replyToID)
+
+	if clientMessageID != "" {
+		create.SetClientMessageID(clientMessageID)
+	}
+
+	msg, err := create.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create text message: %w", err)
 	}
 	return msg, nil
 }
 
-func (r *messageRepository) CreateMediaMessage(ctx context.Context, connectionID, senderID, receiverID uuid.UUID, mediaURL, mediaType, publicID, txtContent string) (*ent.Message, error) {
+// GetMessageByClientID returns the message a sender created with the given idempotency key
+func (r *messageRepository) GetMessageByClientID(ctx context.Context, senderID uuid.UUID, clientMessageID string) (*ent.Message, error) {
+	msg, err := r.client.Message.Query().
+		Where(
+			message.SenderIDEQ(senderID),
+			message.ClientMessageIDEQ(clientMessageID),
+		).
+		WithReplyTo().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	return msg, nil
+}
+
+func (r *messageRepository) CreateMediaMessage(ctx context.Context, connectionID, senderID, receiverID uuid.UUID, mediaURL, mediaType, publicID, txtContent string, replyToID *uuid.UUID) (*ent.Message, error) {
 	create := r.client.Message.Create().
 		SetConnectionID(connectionID).
 		SetSenderID(senderID).
@@ -43,7 +64,8 @@ func (r *messageRepository) CreateMediaMessage(ctx context.Context, connectionID
 		SetType(message.TypeMedia).
 		SetMediaURL(mediaURL).
 		SetMediaType(mediaType).
-		SetMediaPublicID(publicID)
+		SetMediaPublicID(publicID).
+		SetNillableReplyToID(replyToID)
 
 	if txtContent != "" {
 		create.SetType(message.TypeMixed)
@@ -65,6 +87,20 @@ func (r *messageRepository) GetMessage(ctx context.Context, messageID uuid.UUID)
 	return msg, nil
 }
 
+func (r *messageRepository) GetMessageWithUsers(ctx context.Context, messageID uuid.UUID) (*ent.Message, error) {
+	msg, err := r.client.Message.Query().
+		Where(message.ID(messageID)).
+		WithSender().
+		WithReceiver().
+		WithReactions(orderReactions).
+		WithReplyTo().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	return msg, nil
+}
+
 func (r *messageRepository) UpdateMessage(ctx context.Context, messageID uuid.UUID, content string) (*ent.Message, error) {
 	msg, err := r.client.Message.UpdateOneID(messageID).
 		SetContent(content).
@@ -84,6 +120,123 @@ func (r *messageRepository) DeleteMessage(ctx context.Context, messageID uuid.UU
 	return nil
 }
 
+// EditMessage replaces the content of a message, recording the previous content in its edit history
+func (r *messageRepository) EditMessage(ctx context.Context, messageID uuid.UUID, content string) (*ent.Message, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Lock the message so concurrent edits are recorded in order
+	msg, err := tx.Message.Query().
+		Where(message.ID(messageID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	if msg.IsDeleted {
+		tx.Rollback()
+		return nil, fmt.Errorf("message has been deleted")
+	}
+
+	_, err = tx.MessageEdit.Create().
+		SetMessageID(messageID).
+		SetPreviousContent(msg.Content).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record message edit: %w", err)
+	}
+
+	err = tx.Message.UpdateOneID(messageID).
+		SetContent(content).
+		SetEditedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to update message: %w", err)
+	}
+
+	// Re-read the message so media references are resolved
+	msg, err = tx.Message.Get(ctx, messageID)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return msg, nil
+}
+
+// SoftDeleteMessage unsends a message: its content, media and edit history are removed
+// while the row is kept as a placeholder in the conversation
+func (r *messageRepository) SoftDeleteMessage(ctx context.Context, messageID uuid.UUID) (*ent.Message, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	count, err := tx.Message.Update().
+		Where(
+			message.ID(messageID),
+			message.IsDeletedEQ(false),
+		).
+		SetIsDeleted(true).
+		SetDeletedAt(time.Now()).
+		ClearContent().
+		ClearMediaURL().
+		ClearMediaType().
+		ClearMediaPublicID().
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete message: %w", err)
+	}
+
+	if count == 0 {
+		tx.Rollback()
+		return nil, fmt.Errorf("message has been deleted")
+	}
+
+	_, err = tx.MessageEdit.Delete().
+		Where(messageedit.MessageIDEQ(messageID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete message edits: %w", err)
+	}
+
+	msg, err := tx.Message.Get(ctx, messageID)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return msg, nil
+}
+
+// GetMessageEdits returns the edit history of a message, oldest first
+func (r *messageRepository) GetMessageEdits(ctx context.Context, messageID uuid.UUID) ([]*ent.MessageEdit, error) {
+	edits, err := r.client.MessageEdit.Query().
+		Where(messageedit.MessageIDEQ(messageID)).
+		Order(messageedit.ByEditedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message edits: %w", err)
+	}
+	return edits, nil
+}
+
 func (r *messageRepository) DeleteMessagesByConnection(ctx context.Context, connID uuid.UUID) error {
 	_, err := r.client.Message.Delete().Where(
 		message.ConnectionIDEQ(connID),
@@ -95,84 +248,130 @@ func (r *messageRepository) DeleteMessagesByConnection(ctx context.Context, conn
 	return nil
 }
 
-func (r *messageRepository) GetConnectionMessages(ctx context.Context, connectionID uuid.UUID, limit, offset int) ([]*ent.Message, error) {
+// MessageCursor is a position in a conversation, ordered by (created_at, id)
+type MessageCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// After reports whether the cursor is positioned after the other one
+func (c MessageCursor) After(other MessageCursor) bool {
+	if !c.CreatedAt.Equal(other.CreatedAt) {
+		return c.CreatedAt.After(other.CreatedAt)
+	}
+	return c.ID.String() > other.ID.String()
+}
+
+// CursorOf returns the cursor positioned at the given message
+func CursorOf(msg *ent.Message) MessageCursor {
+	return MessageCursor{CreatedAt: msg.CreatedAt, ID: msg.ID}
+}
+
+// GetMessagesBefore returns up to limit messages older than the cursor, newest first.
+// Without a cursor it returns the latest messages of the connection.
+func (r *messageRepository) GetMessagesBefore(ctx context.Context, connectionID uuid.UUID, cursor *MessageCursor, limit int) ([]*ent.Message, error) {
 	query := r.client.Message.Query().
 		Where(
-			message.And(
-				message.ConnectionIDEQ(connectionID),
-				message.IsDeletedEQ(false),
-			),
-		).
-		Order(ent.Desc(message.FieldCreatedAt))
+			message.ConnectionIDEQ(connectionID),
+			message.IsDeletedEQ(false),
+		)
 
-	if limit > 0 {
-		query = query.Limit(limit)
-	}
-	if offset > 0 {
-		query = query.Offset(offset)
+	if cursor != nil {
+		query = query.Where(
+			message.Or(
+				message.CreatedAtLT(cursor.CreatedAt),
+				message.And(
+					message.CreatedAtEQ(cursor.CreatedAt),
+					message.IDLT(cursor.ID),
+				),
+			),
+		)
 	}
 
-	messages, err := query.All(ctx)
+	messages, err := query.
+		WithSender().
+		WithReceiver().
+		WithReactions(orderReactions).
+		WithReplyTo().
+		Order(ent.Desc(message.FieldCreatedAt), ent.Desc(message.FieldID)).
+		Limit(limit).
+		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get connection messages: %w", err)
 	}
 	return messages, nil
 }
 
-func (r *messageRepository) GetConnectionMessagesWithUsers(ctx context.Context, connectionID uuid.UUID, limit, offset int) ([]*ent.Message, error) {
-	query := r.client.Message.Query().
+// GetMessagesAfter returns up to limit messages newer than the cursor, oldest first
+func (r *messageRepository) GetMessagesAfter(ctx context.Context, connectionID uuid.UUID, cursor MessageCursor, limit int) ([]*ent.Message, error) {
+	messages, err := r.client.Message.Query().
 		Where(
-			message.And(
-				message.ConnectionIDEQ(connectionID),
-				message.IsDeletedEQ(false),
+			message.ConnectionIDEQ(connectionID),
+			message.IsDeletedEQ(false),
+			message.Or(
+				message.CreatedAtGT(cursor.CreatedAt),
+				message.And(
+					message.CreatedAtEQ(cursor.CreatedAt),
+					message.IDGT(cursor.ID),
+				),
 			),
 		).
 		WithSender().
 		WithReceiver().
-		Order(ent.Desc(message.FieldCreatedAt))
-
-	if limit > 0 {
-		query = query.Limit(limit)
-	}
-	if offset > 0 {
-		query = query.Offset(offset)
+		WithReactions(orderReactions).
+		WithReplyTo().
+		Order(ent.Asc(message.FieldCreatedAt), ent.Asc(message.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection messages: %w", err)
 	}
+	return messages, nil
+}
 
-	messages, err := query.All(ctx)
+// GetMessageReplies returns the replies to a message that were not unsent, oldest first
+func (r *messageRepository) GetMessageReplies(ctx context.Context, messageID uuid.UUID) ([]*ent.Message, error) {
+	messages, err := r.client.Message.Query().
+		Where(
+			message.ReplyToIDEQ(messageID),
+			message.IsDeletedEQ(false),
+		).
+		WithSender().
+		WithReceiver().
+		WithReactions(orderReactions).
+		Order(ent.Asc(message.FieldCreatedAt), ent.Asc(message.FieldID)).
+		All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get connection messages with users: %w", err)
+		return nil, fmt.Errorf("failed to get message replies: %w", err)
 	}
 	return messages, nil
 }
 
-func (r *messageRepository) MarkMessageAsRead(ctx context.Context, messageID uuid.UUID) (*ent.Message, error) {
-	msg, err := r.client.Message.UpdateOneID(messageID).
-		SetIsRead(true).
-		SetReadAt(time.Now()).
-		Save(ctx)
+// GetLatestMessage returns the most recent message of a connection, including unsent ones,
+// or nil when the connection has no messages
+func (r *messageRepository) GetLatestMessage(ctx context.Context, connectionID uuid.UUID) (*ent.Message, error) {
+	msg, err := r.client.Message.Query().
+		Where(message.ConnectionIDEQ(connectionID)).
+		Order(ent.Desc(message.FieldCreatedAt), ent.Desc(message.FieldID)).
+		First(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to mark message as read: %w", err)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest message: %w", err)
 	}
 	return msg, nil
 }
 
-func (r *messageRepository) MarkConnectionMessagesAsRead(ctx context.Context, connectionID, userID uuid.UUID) (int, error) {
-	count, err := r.client.Message.Update().
-		Where(
-			message.And(
-				message.ConnectionIDEQ(connectionID),
-				message.ReceiverIDEQ(userID),
-				message.IsReadEQ(false),
-				message.IsDeletedEQ(false),
-			),
-		).
+func (r *messageRepository) MarkMessageAsRead(ctx context.Context, messageID uuid.UUID) (*ent.Message, error) {
+	msg, err := r.client.Message.UpdateOneID(messageID).
 		SetIsRead(true).
 		SetReadAt(time.Now()).
 		Save(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("failed to mark connection messages as read: %w", err)
+		return nil, fmt.Errorf("failed to mark message as read: %w", err)
 	}
-	return count, nil
+	return msg, nil
 }
 
 func (r *messageRepository) GetUnreadMessagesCount(ctx context.Context, userID uuid.UUID) (int, error) {
@@ -258,23 +457,14 @@ func (r *messageRepository) GetMediaMessages(ctx context.Context, connectionID u
 	return messages, nil
 }
 
-func (r *messageRepository) SearchMessages(ctx context.Context, connectionID uuid.UUID, query string) ([]*ent.Message, error) {
-	searchTerm := strings.ToLower(query)
-
-	messages, err := r.client.Message.Query().
-		Where(
-			message.And(
-				message.ConnectionIDEQ(connectionID),
-				message.TypeEQ(message.TypeText),
-				message.ContentContainsFold(searchTerm),
-				message.IsDeletedEQ(false),
-			),
-		).
-		WithSender().
-		Order(message.ByCreatedAt()).
-		All(ctx)
+// CountMediaReferences counts the messages still pointing at a stored media object.
+// Unsent messages clear their media, so they no longer count.
+func (r *messageRepository) CountMediaReferences(ctx context.Context, publicID string) (int, error) {
+	count, err := r.client.Message.Query().
+		Where(message.MediaPublicIDEQ(publicID)).
+		Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search messages: %w", err)
+		return 0, fmt.Errorf("failed to count media references: %w", err)
 	}
-	return messages, nil
+	return count, nil
 }
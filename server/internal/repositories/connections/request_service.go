@@ -21,11 +21,12 @@ func NewConnectionRequestRepository(client *ent.Client) ConnectionRequestReposit
 	}
 }
 
-func (r *connectionRequestRepository) CreateConnectionRequest(ctx context.Context, senderID, receiverID uuid.UUID, message string) (*ent.ConnectionRequest, error) {
+func (r *connectionRequestRepository) CreateConnectionRequest(ctx context.Context, senderID, receiverID uuid.UUID, message string, expiresAt time.Time) (*ent.ConnectionRequest, error) {
 	create := r.client.ConnectionRequest.Create().
 		SetSenderID(senderID).
 		SetReceiverID(receiverID).
-		SetStatus(connectionrequest.StatusPending)
+		SetStatus(connectionrequest.StatusPending).
+		SetExpiresAt(expiresAt)
 
 	if message != "" {
 		create = create.SetMessage(message)
@@ -90,6 +91,10 @@ func (r *connectionRequestRepository) GetPendingRequestsForUser(ctx context.Cont
 			connectionrequest.And(
 				connectionrequest.ReceiverIDEQ(userID),
 				connectionrequest.StatusEQ(connectionrequest.StatusPending),
+				connectionrequest.Or(
+					connectionrequest.ExpiresAtIsNil(),
+					connectionrequest.ExpiresAtGT(time.Now()),
+				),
 			),
 		).
 		WithSender().
@@ -111,6 +116,33 @@ func (r *connectionRequestRepository) GetSentRequests(ctx context.Context, userI
 	return requests, nil
 }
 
+// GetPendingRequestUserIDs returns the users with a pending request to or from userID in one query
+func (r *connectionRequestRepository) GetPendingRequestUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	requests, err := r.client.ConnectionRequest.Query().
+		Where(
+			connectionrequest.StatusEQ(connectionrequest.StatusPending),
+			connectionrequest.Or(
+				connectionrequest.SenderIDEQ(userID),
+				connectionrequest.ReceiverIDEQ(userID),
+			),
+		).
+		Select(connectionrequest.FieldSenderID, connectionrequest.FieldReceiverID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending request user IDs: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(requests))
+	for _, req := range requests {
+		if req.SenderID == userID {
+			userIDs = append(userIDs, req.ReceiverID)
+		} else {
+			userIDs = append(userIDs, req.SenderID)
+		}
+	}
+	return userIDs, nil
+}
+
 func (r *connectionRequestRepository) GetReceivedRequests(ctx context.Context, userID uuid.UUID) ([]*ent.ConnectionRequest, error) {
 	requests, err := r.client.ConnectionRequest.Query().
 		Where(connectionrequest.ReceiverIDEQ(userID)).
@@ -129,13 +161,21 @@ func (r *connectionRequestRepository) AcceptRequest(ctx context.Context, request
 		return nil, nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 
-	// Get the request
-	request, err := tx.ConnectionRequest.Get(ctx, requestID)
+	// Get the request, locking it so the expiry job cannot expire it mid-accept
+	request, err := tx.ConnectionRequest.Query().
+		Where(connectionrequest.ID(requestID)).
+		ForUpdate().
+		Only(ctx)
 	if err != nil {
 		tx.Rollback()
 		return nil, nil, fmt.Errorf("failed to get connection request: %w", err)
 	}
 
+	if request.Status != connectionrequest.StatusPending {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("request is no longer pending")
+	}
+
 	// Update request status
 	updatedRequest, err := tx.ConnectionRequest.UpdateOneID(requestID).
 		SetStatus(connectionrequest.StatusAccepted).
@@ -176,21 +216,77 @@ func (r *connectionRequestRepository) DeclineRequest(ctx context.Context, reques
 	return request, nil
 }
 
-func (r *connectionRequestRepository) ExpireOldRequests(ctx context.Context) (int, error) {
-	// Expire requests older than 30 days that are still pending
-	expireThreshold := time.Now().AddDate(0, 0, -30)
+// ExpireOldRequests marks pending requests past their expiry as expired and returns them.
+// Requests created before expires_at was populated expire defaultTTL after creation.
+func (r *connectionRequestRepository) ExpireOldRequests(ctx context.Context, defaultTTL time.Duration) ([]*ent.ConnectionRequest, error) {
+	now := time.Now()
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
 
-	count, err := r.client.ConnectionRequest.Update().
+	// Lock the due requests so a concurrent accept or decline cannot race the expiry
+	requests, err := tx.ConnectionRequest.Query().
 		Where(
-			connectionrequest.And(
-				connectionrequest.StatusEQ(connectionrequest.StatusPending),
-				connectionrequest.CreatedAtLT(expireThreshold),
+			connectionrequest.StatusEQ(connectionrequest.StatusPending),
+			connectionrequest.Or(
+				connectionrequest.ExpiresAtLTE(now),
+				connectionrequest.And(
+					connectionrequest.ExpiresAtIsNil(),
+					connectionrequest.CreatedAtLT(now.Add(-defaultTTL)),
+				),
 			),
 		).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to get expired requests: %w", err)
+	}
+
+	if len(requests) == 0 {
+		tx.Rollback()
+		return requests, nil
+	}
+
+	ids := make([]uuid.UUID, len(requests))
+	for i, request := range requests {
+		ids[i] = request.ID
+		request.Status = connectionrequest.StatusExpired
+	}
+
+	_, err = tx.ConnectionRequest.Update().
+		Where(connectionrequest.IDIn(ids...)).
 		SetStatus(connectionrequest.StatusExpired).
 		Save(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("failed to expire old requests: %w", err)
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to expire old requests: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return requests, nil
+}
+
+// WithdrawRequest deletes a request that is still pending, on behalf of its sender
+func (r *connectionRequestRepository) WithdrawRequest(ctx context.Context, requestID, senderID uuid.UUID) error {
+	count, err := r.client.ConnectionRequest.Delete().
+		Where(
+			connectionrequest.ID(requestID),
+			connectionrequest.SenderIDEQ(senderID),
+			connectionrequest.StatusEQ(connectionrequest.StatusPending),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to withdraw connection request: %w", err)
+	}
+
+	if count == 0 {
+		return fmt.Errorf("request is no longer pending")
 	}
-	return count, nil
-}
\ No newline at end of file
+	return nil
+}
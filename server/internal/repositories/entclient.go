@@ -7,6 +7,8 @@ import (
 	"match-me/config"
 	"match-me/ent"
 	"match-me/ent/schema"
+	"match-me/internal/pkg/matching"
+	"match-me/internal/pkg/storage"
 	"match-me/internal/repositories/hooks"
 
 	"entgo.io/ent/dialect"
@@ -31,10 +33,22 @@ func NewEntClient(ctx context.Context, cfg *config.Config) *ent.Client {
 		log.Println("PostGIS extension enabled")
 	}
 
-	// 3. Create an Ent driver that wraps our existing connection.
+	// 3. Renumber photo orders left behind by earlier uploads so the unique
+	// (user_id, order) index can be created.
+	if err := normalizePhotoOrders(ctx, db); err != nil {
+		log.Fatalf("failed normalizing photo orders: %v", err)
+	}
+
+	// 4. Treat accounts created before email verification existed as verified,
+	// so they stay matchable after the upgrade.
+	if err := backfillEmailVerification(ctx, db); err != nil {
+		log.Fatalf("failed backfilling email verification: %v", err)
+	}
+
+	// 5. Create an Ent driver that wraps our existing connection.
 	drv := entsql.OpenDB(dialect.Postgres, db)
 
-	// 4. Create the Ent client with the custom driver.
+	// 6. Create the Ent client with the custom driver.
 	client := ent.NewClient(ent.Driver(drv))
 
 	// Register hooks
@@ -46,6 +60,92 @@ func NewEntClient(ctx context.Context, cfg *config.Config) *ent.Client {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	// Add the generated message search column, which ent cannot declare
+	searchVector := schema.MessageSearchVector{}
+	if _, err := db.ExecContext(ctx, searchVector.SQL()); err != nil {
+		log.Fatalf("failed creating message search vector: %v", err)
+	}
+
 	log.Println("Ent client connected and schema created")
 	return client
 }
+
+// normalizePhotoOrders compacts every user's photo orders to 1..n. It only runs
+// until the unique photo order index exists, after which orders stay consistent.
+func normalizePhotoOrders(ctx context.Context, db *sql.DB) error {
+	var table, index sql.NullString
+	row := db.QueryRowContext(ctx, `SELECT to_regclass('user_photos')::text, to_regclass('userphoto_user_id_order')::text`)
+	if err := row.Scan(&table, &index); err != nil {
+		return err
+	}
+	if !table.Valid || index.Valid {
+		return nil
+	}
+
+	_, err := db.ExecContext(ctx, `
+		UPDATE user_photos p
+		SET "order" = r.position
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY "order", id) AS position
+			FROM user_photos
+		) r
+		WHERE p.id = r.id AND p."order" <> r.position`)
+	return err
+}
+
+// backfillEmailVerification adds the email_verified_at column to an existing users table
+// and marks every account already there as verified. It only runs once, before auto
+// migration would add the column empty; users registering afterwards verify as usual.
+func backfillEmailVerification(ctx context.Context, db *sql.DB) error {
+	var table sql.NullString
+	var hasColumn bool
+	row := db.QueryRowContext(ctx, `
+		SELECT to_regclass('users')::text,
+			EXISTS (
+				SELECT 1 FROM information_schema.columns
+				WHERE table_schema = current_schema() AND table_name = 'users' AND column_name = 'email_verified_at'
+			)`)
+	if err := row.Scan(&table, &hasColumn); err != nil {
+		return err
+	}
+	if !table.Valid || hasColumn {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `ALTER TABLE users ADD COLUMN email_verified_at timestamp with time zone NULL`); err != nil {
+		tx.Rollback()
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `UPDATE users SET email_verified_at = now()`)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if n, err := result.RowsAffected(); err == nil {
+		log.Printf("Marked %d existing users as email verified", n)
+	}
+	return nil
+}
+
+// UseMediaStore registers the interceptors resolving persisted media references with the given store
+func UseMediaStore(client *ent.Client, store storage.MediaStore) {
+	client.UserPhoto.Intercept(hooks.PhotoURLInterceptor(store))
+	client.Message.Intercept(hooks.MessageMediaInterceptor(store))
+}
+
+// UseFeedCache registers the hooks invalidating cached recommendation feeds
+func UseFeedCache(client *ent.Client, cache *matching.FeedCache) {
+	hook := hooks.FeedInvalidationHook(cache)
+	client.User.Use(hook)
+	client.UserInteraction.Use(hook)
+	client.ConnectionRequest.Use(hook)
+	client.Connection.Use(hook)
+}
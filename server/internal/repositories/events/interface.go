@@ -0,0 +1,28 @@
+package events
+
+import (
+	"context"
+	"match-me/ent"
+	"match-me/ent/schema"
+	"match-me/internal/requests"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventRepository defines methods for managing local events and their RSVPs.
+type EventRepository interface {
+	// Event management
+	CreateEvent(ctx context.Context, creatorID uuid.UUID, body requests.CreateEventBody) (*ent.Event, error)
+	GetEvent(ctx context.Context, eventID uuid.UUID) (*ent.Event, error)
+	UpdateEvent(ctx context.Context, eventID uuid.UUID, body requests.UpdateEventBody) (*ent.Event, error)
+	CancelEvent(ctx context.Context, eventID uuid.UUID) (*ent.Event, error)
+
+	// Event queries
+	GetUserEvents(ctx context.Context, userID uuid.UUID, now time.Time) ([]*ent.Event, error)
+	GetNearbyEvents(ctx context.Context, viewerID uuid.UUID, point *schema.Point, meters float64, now time.Time, limit int) ([]*ent.Event, error)
+
+	// RSVPs
+	SetRSVP(ctx context.Context, eventID, userID uuid.UUID, status string) (*ent.Event, *ent.EventParticipant, error)
+	GetParticipantIDs(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error)
+}
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"match-me/ent/event"
+	"match-me/ent/predicate"
+	"match-me/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// withinDistance matches events within meters of point.
+func withinDistance(point *schema.Point, meters float64) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("ST_DWithin(").WriteString(s.C(event.FieldLocation)).Comma()
+			writePoint(b, point)
+			b.Comma().Arg(meters).WriteString(")")
+		}))
+	})
+}
+
+// notEnded matches events that end after now. Events without an end time
+// are over once they start.
+func notEnded(now time.Time) predicate.Event {
+	return event.Or(
+		event.EndTimeGT(now),
+		event.And(event.EndTimeIsNil(), event.StartTimeGT(now)),
+	)
+}
+
+func writePoint(b *sql.Builder, point *schema.Point) {
+	b.WriteString("ST_SetSRID(ST_MakePoint(").
+		Arg(point.Longitude).Comma().Arg(point.Latitude).
+		WriteString("), 4326)::geography")
+}
@@ -0,0 +1,361 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"match-me/ent"
+	"match-me/ent/event"
+	"match-me/ent/eventparticipant"
+	"match-me/ent/schema"
+	"match-me/ent/userphoto"
+	"match-me/internal/requests"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type eventRepository struct {
+	client *ent.Client
+}
+
+func NewEventRepository(client *ent.Client) EventRepository {
+	return &eventRepository{
+		client: client,
+	}
+}
+
+// CreateEvent creates an event with its creator as the first confirmed participant
+func (r *eventRepository) CreateEvent(ctx context.Context, creatorID uuid.UUID, body requests.CreateEventBody) (*ent.Event, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	newEvent, err := tx.Event.Create().
+		SetCreatorID(creatorID).
+		SetTitle(body.Title).
+		SetNillableDescription(body.Description).
+		SetNillableEventType(body.EventType).
+		SetLocation(&schema.Point{Longitude: *body.LocationLng, Latitude: *body.LocationLat}).
+		SetNillableLocationName(body.LocationName).
+		SetNillableMaxParticipants(body.MaxParticipants).
+		SetStartTime(body.StartTime).
+		SetNillableEndTime(body.EndTime).
+		SetCurrentParticipants(1).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to create event: %w", err)
+	}
+
+	_, err = tx.EventParticipant.Create().
+		SetEventID(newEvent.ID).
+		SetUserID(creatorID).
+		SetStatus(eventparticipant.StatusConfirmed).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to add event creator: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return newEvent, nil
+}
+
+// GetEvent returns an event with its creator and everyone who has not declined
+func (r *eventRepository) GetEvent(ctx context.Context, eventID uuid.UUID) (*ent.Event, error) {
+	e, err := r.client.Event.Query().
+		Where(event.ID(eventID)).
+		WithCreator(withProfilePhotos).
+		WithParticipants(func(q *ent.EventParticipantQuery) {
+			q.Where(eventparticipant.StatusNEQ(eventparticipant.StatusDeclined)).
+				WithUser(withProfilePhotos).
+				Order(ent.Asc(eventparticipant.FieldJoinedAt))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("event not found")
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	return e, nil
+}
+
+// UpdateEvent changes an active event. The event is locked so a lower capacity
+// cannot race with participants confirming.
+func (r *eventRepository) UpdateEvent(ctx context.Context, eventID uuid.UUID, body requests.UpdateEventBody) (*ent.Event, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	current, err := tx.Event.Query().
+		Where(event.ID(eventID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("event not found")
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	if !current.IsActive {
+		tx.Rollback()
+		return nil, fmt.Errorf("event has been cancelled")
+	}
+
+	if body.MaxParticipants != nil && *body.MaxParticipants > 0 && *body.MaxParticipants < current.CurrentParticipants {
+		tx.Rollback()
+		return nil, fmt.Errorf("max participants is below the number of confirmed participants")
+	}
+
+	// Check the times as they will be after the update
+	startTime, endTime := current.StartTime, current.EndTime
+	if body.StartTime != nil {
+		startTime = *body.StartTime
+	}
+	if body.EndTime != nil {
+		endTime = *body.EndTime
+	}
+	if !endTime.IsZero() && !endTime.After(startTime) {
+		tx.Rollback()
+		return nil, fmt.Errorf("end time must be after start time")
+	}
+
+	update := tx.Event.UpdateOneID(eventID)
+
+	if body.Title != nil {
+		update = update.SetTitle(*body.Title)
+	}
+	if body.Description != nil {
+		update = update.SetDescription(*body.Description)
+	}
+	if body.EventType != nil {
+		update = update.SetEventType(*body.EventType)
+	}
+	if body.LocationLat != nil && body.LocationLng != nil {
+		update = update.SetLocation(&schema.Point{Longitude: *body.LocationLng, Latitude: *body.LocationLat})
+	}
+	if body.LocationName != nil {
+		update = update.SetLocationName(*body.LocationName)
+	}
+	if body.MaxParticipants != nil {
+		update = update.SetMaxParticipants(*body.MaxParticipants)
+	}
+	if body.StartTime != nil {
+		update = update.SetStartTime(*body.StartTime)
+	}
+	if body.EndTime != nil {
+		update = update.SetEndTime(*body.EndTime)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to update event: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return updated, nil
+}
+
+// CancelEvent marks an event as cancelled, keeping its RSVPs
+func (r *eventRepository) CancelEvent(ctx context.Context, eventID uuid.UUID) (*ent.Event, error) {
+	cancelled, err := r.client.Event.UpdateOneID(eventID).
+		SetIsActive(false).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("event not found")
+		}
+		return nil, fmt.Errorf("failed to cancel event: %w", err)
+	}
+	return cancelled, nil
+}
+
+// GetUserEvents returns the active events a user created or responded to without
+// declining, soonest first
+func (r *eventRepository) GetUserEvents(ctx context.Context, userID uuid.UUID, now time.Time) ([]*ent.Event, error) {
+	events, err := r.client.Event.Query().
+		Where(
+			event.IsActive(true),
+			notEnded(now),
+			event.Or(
+				event.CreatorID(userID),
+				event.HasParticipantsWith(
+					eventparticipant.UserID(userID),
+					eventparticipant.StatusNEQ(eventparticipant.StatusDeclined),
+				),
+			),
+		).
+		WithCreator(withProfilePhotos).
+		WithParticipants(viewerRSVP(userID)).
+		Order(ent.Asc(event.FieldStartTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user events: %w", err)
+	}
+	return events, nil
+}
+
+// GetNearbyEvents returns the active events within meters of point, soonest first
+func (r *eventRepository) GetNearbyEvents(ctx context.Context, viewerID uuid.UUID, point *schema.Point, meters float64, now time.Time, limit int) ([]*ent.Event, error) {
+	events, err := r.client.Event.Query().
+		Where(
+			event.IsActive(true),
+			notEnded(now),
+			withinDistance(point, meters),
+		).
+		WithCreator(withProfilePhotos).
+		WithParticipants(viewerRSVP(viewerID)).
+		Order(ent.Asc(event.FieldStartTime), ent.Asc(event.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nearby events: %w", err)
+	}
+	return events, nil
+}
+
+// SetRSVP records a user's response to an event. The event row is locked while the
+// confirmed participants are counted, so confirmations never exceed its capacity.
+func (r *eventRepository) SetRSVP(ctx context.Context, eventID, userID uuid.UUID, status string) (*ent.Event, *ent.EventParticipant, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	current, err := tx.Event.Query().
+		Where(event.ID(eventID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("event not found")
+		}
+		return nil, nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	if !current.IsActive {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("event has been cancelled")
+	}
+
+	if hasEnded(current, time.Now()) {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("event has already ended")
+	}
+
+	existing, err := tx.EventParticipant.Query().
+		Where(
+			eventparticipant.EventID(eventID),
+			eventparticipant.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to get RSVP: %w", err)
+	}
+
+	// Work out how the number of confirmed participants changes
+	newStatus := eventparticipant.Status(status)
+	wasConfirmed := existing != nil && existing.Status == eventparticipant.StatusConfirmed
+	isConfirmed := newStatus == eventparticipant.StatusConfirmed
+
+	delta := 0
+	switch {
+	case isConfirmed && !wasConfirmed:
+		if current.MaxParticipants > 0 && current.CurrentParticipants >= current.MaxParticipants {
+			tx.Rollback()
+			return nil, nil, fmt.Errorf("event is full")
+		}
+		delta = 1
+	case wasConfirmed && !isConfirmed:
+		delta = -1
+	}
+
+	var participant *ent.EventParticipant
+	if existing == nil {
+		participant, err = tx.EventParticipant.Create().
+			SetEventID(eventID).
+			SetUserID(userID).
+			SetStatus(newStatus).
+			Save(ctx)
+	} else {
+		participant, err = tx.EventParticipant.UpdateOne(existing).
+			SetStatus(newStatus).
+			Save(ctx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to save RSVP: %w", err)
+	}
+
+	if delta != 0 {
+		current, err = tx.Event.UpdateOneID(eventID).
+			AddCurrentParticipants(delta).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, fmt.Errorf("failed to update participant count: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return current, participant, nil
+}
+
+// GetParticipantIDs returns the users taking part in an event who have not declined
+func (r *eventRepository) GetParticipantIDs(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error) {
+	participants, err := r.client.EventParticipant.Query().
+		Where(
+			eventparticipant.EventID(eventID),
+			eventparticipant.StatusNEQ(eventparticipant.StatusDeclined),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event participants: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, len(participants))
+	for i, participant := range participants {
+		userIDs[i] = participant.UserID
+	}
+	return userIDs, nil
+}
+
+// hasEnded reports whether an event is over. Events without an end time are
+// over once they start.
+func hasEnded(e *ent.Event, now time.Time) bool {
+	if !e.EndTime.IsZero() {
+		return !e.EndTime.After(now)
+	}
+	return !e.StartTime.After(now)
+}
+
+// withProfilePhotos loads a user's photos in display order
+func withProfilePhotos(q *ent.UserQuery) {
+	q.WithPhotos(func(pq *ent.UserPhotoQuery) {
+		pq.Order(ent.Asc(userphoto.FieldOrder))
+	})
+}
+
+// viewerRSVP loads only the RSVP of the given user
+func viewerRSVP(userID uuid.UUID) func(*ent.EventParticipantQuery) {
+	return func(q *ent.EventParticipantQuery) {
+		q.Where(eventparticipant.UserID(userID))
+	}
+}
@@ -0,0 +1,99 @@
+package hooks
+
+import (
+	"context"
+	"match-me/ent"
+	"match-me/ent/user"
+	"match-me/internal/pkg/matching"
+
+	"github.com/google/uuid"
+)
+
+// FeedInvalidationHook drops cached recommendation feeds affected by a mutation.
+// Profile, preference, location, verification changes and deletes invalidate the user's own
+// feed and every feed recommending them, while interactions, requests and connections
+// invalidate the feeds of both users involved.
+// Other bulk updates and deletes do not return the changed rows, so they purge the whole cache.
+func FeedInvalidationHook(cache *matching.FeedCache) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			// Presence updates don't change the user's own recommendations
+			if onlyPresenceChanged(m) {
+				return next.Mutate(ctx, m)
+			}
+
+			// Resolve the users matched by bulk user mutations before they run
+			userIDs, hasUserIDs := mutatedUserIDs(ctx, m)
+
+			result, err := next.Mutate(ctx, m)
+			if err != nil {
+				return result, err
+			}
+
+			switch v := result.(type) {
+			case *ent.User:
+				cache.Invalidate(v.ID)
+				cache.InvalidateCandidates(v.ID)
+			case *ent.UserInteraction:
+				cache.Invalidate(v.UserID, v.TargetUserID)
+			case *ent.ConnectionRequest:
+				cache.Invalidate(v.SenderID, v.ReceiverID)
+			case *ent.Connection:
+				cache.Invalidate(v.UserAID, v.UserBID)
+			default:
+				if hasUserIDs {
+					cache.Invalidate(userIDs...)
+					cache.InvalidateCandidates(userIDs...)
+				} else {
+					cache.Purge()
+				}
+			}
+
+			return result, nil
+		})
+	}
+}
+
+// mutatedUserIDs returns the IDs of the users targeted by a user mutation
+func mutatedUserIDs(ctx context.Context, m ent.Mutation) ([]uuid.UUID, bool) {
+	userMutation, ok := m.(*ent.UserMutation)
+	if !ok || m.Op().Is(ent.OpCreate) {
+		return nil, false
+	}
+
+	ids, err := userMutation.IDs(ctx)
+	if err != nil {
+		return nil, false
+	}
+	return ids, true
+}
+
+// onlyPresenceChanged reports whether a user mutation only records when the user was last seen
+// or changes their status. These are frequent and don't affect the user's own recommendations;
+// the coarse availability boost they give in other feeds may lag until those feeds expire.
+func onlyPresenceChanged(m ent.Mutation) bool {
+	if _, ok := m.(*ent.UserMutation); !ok {
+		return false
+	}
+
+	for _, name := range m.Fields() {
+		if !isPresenceField(name) {
+			return false
+		}
+	}
+	for _, name := range m.ClearedFields() {
+		if !isPresenceField(name) {
+			return false
+		}
+	}
+	return len(m.Fields())+len(m.ClearedFields()) > 0
+}
+
+// isPresenceField reports whether a user field only describes presence
+func isPresenceField(name string) bool {
+	switch name {
+	case user.FieldLastSeenAt, user.FieldUpdatedAt, user.FieldStatusMode, user.FieldStatusMessage:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package hooks
+
+import (
+	"context"
+	"match-me/ent"
+	"match-me/internal/pkg/storage"
+)
+
+// PhotoURLInterceptor resolves stored photo references into download URLs
+// whenever photos are queried, including when they are eager loaded with users.
+// Backends with signed URLs therefore always return fresh, unexpired links.
+func PhotoURLInterceptor(store storage.MediaStore) ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			value, err := next.Query(ctx, q)
+			if err != nil {
+				return value, err
+			}
+
+			if photos, ok := value.([]*ent.UserPhoto); ok {
+				for _, photo := range photos {
+					photo.PhotoURL = storage.ResolveURL(store, photo.PhotoURL)
+					if photo.MediumURL != "" {
+						photo.MediumURL = storage.ResolveURL(store, photo.MediumURL)
+					}
+					if photo.ThumbnailURL != "" {
+						photo.ThumbnailURL = storage.ResolveURL(store, photo.ThumbnailURL)
+					}
+				}
+			}
+
+			return value, nil
+		})
+	})
+}
+
+// MessageMediaInterceptor resolves stored media references of messages into download URLs
+func MessageMediaInterceptor(store storage.MediaStore) ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			value, err := next.Query(ctx, q)
+			if err != nil {
+				return value, err
+			}
+
+			if messages, ok := value.([]*ent.Message); ok {
+				for _, message := range messages {
+					if message.MediaURL != "" {
+						message.MediaURL = storage.ResolveURL(store, message.MediaURL)
+					}
+				}
+			}
+
+			return value, nil
+		})
+	})
+}
@@ -13,6 +13,9 @@ type UserInteractionRepository interface {
 	// Record a new user interaction
 	RecordInteraction(ctx context.Context, userID, targetUserID uuid.UUID, interactionType string, expiresAt *time.Time, metadata map[string]interface{}) (*ent.UserInteraction, error)
 
+	// Record a like and create a connection when the target already liked the user back
+	RecordLike(ctx context.Context, userID, targetUserID uuid.UUID, interactionType string, expiresAt *time.Time) (*ent.UserInteraction, *ent.Connection, error)
+
 	// Get all active (non-expired) interactions for a user
 	GetActiveInteractions(ctx context.Context, userID uuid.UUID) ([]*ent.UserInteraction, error)
 
@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 	"match-me/ent"
+	"match-me/ent/connection"
+	"match-me/ent/connectionrequest"
+	"match-me/ent/predicate"
+	"match-me/ent/user"
 	"match-me/ent/userinteraction"
 	"time"
 
@@ -44,6 +48,139 @@ func (r *userInteractionRepository) RecordInteraction(ctx context.Context, userI
 	return interaction, nil
 }
 
+// likeTypes are the interaction types that count as a like
+var likeTypes = []userinteraction.InteractionType{
+	userinteraction.InteractionTypeLiked,
+	userinteraction.InteractionTypeSuperLiked,
+}
+
+func (r *userInteractionRepository) RecordLike(ctx context.Context, userID, targetUserID uuid.UUID, interactionType string, expiresAt *time.Time) (*ent.UserInteraction, *ent.Connection, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Lock both users in a fixed order so two likes between the same pair are
+	// serialized and the second one always sees the first
+	_, err = tx.User.Query().
+		Where(user.IDIn(userID, targetUserID)).
+		Order(ent.Asc(user.FieldID)).
+		ForUpdate().
+		IDs(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to lock users: %w", err)
+	}
+
+	activeLike := func(from, to uuid.UUID) predicate.UserInteraction {
+		return userinteraction.And(
+			userinteraction.UserID(from),
+			userinteraction.TargetUserID(to),
+			userinteraction.InteractionTypeIn(likeTypes...),
+			userinteraction.Or(
+				userinteraction.ExpiresAtIsNil(),
+				userinteraction.ExpiresAtGT(time.Now()),
+			),
+		)
+	}
+
+	alreadyLiked, err := tx.UserInteraction.Query().Where(activeLike(userID, targetUserID)).Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to check existing like: %w", err)
+	}
+	if alreadyLiked {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("user already liked")
+	}
+
+	// Expired likes still occupy the unique (user, target, type) index
+	_, err = tx.UserInteraction.Delete().
+		Where(
+			userinteraction.UserID(userID),
+			userinteraction.TargetUserID(targetUserID),
+			userinteraction.InteractionTypeIn(likeTypes...),
+		).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to remove expired like: %w", err)
+	}
+
+	mutation := tx.UserInteraction.Create().
+		SetUserID(userID).
+		SetTargetUserID(targetUserID).
+		SetInteractionType(userinteraction.InteractionType(interactionType))
+	if expiresAt != nil {
+		mutation = mutation.SetExpiresAt(*expiresAt)
+	}
+
+	interaction, err := mutation.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to record interaction: %w", err)
+	}
+
+	// A like only becomes a match when the target already liked the user back
+	mutual, err := tx.UserInteraction.Query().Where(activeLike(targetUserID, userID)).Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to check mutual like: %w", err)
+	}
+
+	var newConnection *ent.Connection
+	if mutual {
+		exists, err := tx.Connection.Query().
+			Where(
+				connection.Or(
+					connection.And(connection.UserAID(userID), connection.UserBID(targetUserID)),
+					connection.And(connection.UserAID(targetUserID), connection.UserBID(userID)),
+				),
+			).
+			Exist(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, fmt.Errorf("failed to check existing connection: %w", err)
+		}
+
+		if !exists {
+			// The user who liked first is user A, matching the sender of a request
+			newConnection, err = tx.Connection.Create().
+				SetUserAID(targetUserID).
+				SetUserBID(userID).
+				SetStatus(connection.StatusConnected).
+				Save(ctx)
+			if err != nil {
+				tx.Rollback()
+				return nil, nil, fmt.Errorf("failed to create connection: %w", err)
+			}
+
+			// Pending requests between the pair are settled by the match
+			_, err = tx.ConnectionRequest.Update().
+				Where(
+					connectionrequest.StatusEQ(connectionrequest.StatusPending),
+					connectionrequest.Or(
+						connectionrequest.And(connectionrequest.SenderID(userID), connectionrequest.ReceiverID(targetUserID)),
+						connectionrequest.And(connectionrequest.SenderID(targetUserID), connectionrequest.ReceiverID(userID)),
+					),
+				).
+				SetStatus(connectionrequest.StatusAccepted).
+				SetRespondedAt(time.Now()).
+				Save(ctx)
+			if err != nil {
+				tx.Rollback()
+				return nil, nil, fmt.Errorf("failed to update pending requests: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return interaction, newConnection, nil
+}
+
 func (r *userInteractionRepository) GetActiveInteractions(ctx context.Context, userID uuid.UUID) ([]*ent.UserInteraction, error) {
 	interactions, err := r.client.UserInteraction.Query().
 		Where(
@@ -0,0 +1,31 @@
+package session
+
+import (
+	"context"
+	"match-me/ent"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionRepository defines methods for managing refresh-token sessions.
+// A session represents one token family; its refresh token hash is rotated on every refresh.
+type SessionRepository interface {
+	// Session lifecycle
+	CreateSession(ctx context.Context, sessionID, userID uuid.UUID, tokenHash string, meta SessionMeta, expiresAt time.Time) (*ent.Session, error)
+	RotateRefreshToken(ctx context.Context, sessionID uuid.UUID, oldHash, newHash string, meta SessionMeta, expiresAt time.Time) (bool, error)
+	RevokeSession(ctx context.Context, sessionID uuid.UUID, reason string) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID, reason string) (int, error)
+	DeleteExpiredSessions(ctx context.Context) (int, error)
+
+	// Session retrieval
+	GetSession(ctx context.Context, sessionID uuid.UUID) (*ent.Session, error)
+	GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]*ent.Session, error)
+}
+
+// SessionMeta describes the client a session is used from
+type SessionMeta struct {
+	Device    string
+	IPAddress string
+	UserAgent string
+}
@@ -0,0 +1,138 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"match-me/ent"
+	"match-me/ent/session"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type sessionRepository struct {
+	client *ent.Client
+}
+
+func NewSessionRepository(client *ent.Client) SessionRepository {
+	return &sessionRepository{
+		client: client,
+	}
+}
+
+func (r *sessionRepository) CreateSession(ctx context.Context, sessionID, userID uuid.UUID, tokenHash string, meta SessionMeta, expiresAt time.Time) (*ent.Session, error) {
+	created, err := r.client.Session.Create().
+		SetID(sessionID).
+		SetUserID(userID).
+		SetRefreshTokenHash(tokenHash).
+		SetDevice(meta.Device).
+		SetIPAddress(meta.IPAddress).
+		SetUserAgent(meta.UserAgent).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return created, nil
+}
+
+// RotateRefreshToken swaps the stored refresh token hash only if the presented one is still the current one.
+// It reports false when no active session matched, which callers treat as token reuse.
+func (r *sessionRepository) RotateRefreshToken(ctx context.Context, sessionID uuid.UUID, oldHash, newHash string, meta SessionMeta, expiresAt time.Time) (bool, error) {
+	update := r.client.Session.Update().
+		Where(
+			session.ID(sessionID),
+			session.RefreshTokenHash(oldHash),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		SetRefreshTokenHash(newHash).
+		SetLastUsedAt(time.Now()).
+		SetExpiresAt(expiresAt)
+
+	if meta.IPAddress != "" {
+		update = update.SetIPAddress(meta.IPAddress)
+	}
+	if meta.UserAgent != "" {
+		update = update.SetUserAgent(meta.UserAgent)
+	}
+
+	affected, err := update.Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	return affected == 1, nil
+}
+
+func (r *sessionRepository) RevokeSession(ctx context.Context, sessionID uuid.UUID, reason string) error {
+	_, err := r.client.Session.Update().
+		Where(
+			session.ID(sessionID),
+			session.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		SetRevokeReason(reason).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) RevokeUserSessions(ctx context.Context, userID uuid.UUID, reason string) (int, error) {
+	count, err := r.client.Session.Update().
+		Where(
+			session.UserID(userID),
+			session.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		SetRevokeReason(reason).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke user sessions: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *sessionRepository) DeleteExpiredSessions(ctx context.Context) (int, error) {
+	count, err := r.client.Session.Delete().
+		Where(session.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *sessionRepository) GetSession(ctx context.Context, sessionID uuid.UUID) (*ent.Session, error) {
+	found, err := r.client.Session.Get(ctx, sessionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("session not found")
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	return found, nil
+}
+
+func (r *sessionRepository) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]*ent.Session, error) {
+	sessions, err := r.client.Session.Query().
+		Where(
+			session.UserID(userID),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(session.FieldLastUsedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active sessions: %w", err)
+	}
+
+	return sessions, nil
+}
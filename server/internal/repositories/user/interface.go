@@ -4,6 +4,7 @@ import (
 	"context"
 	"match-me/ent"
 	"match-me/internal/requests"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,6 +16,9 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, userData requests.RegisterUser) (*ent.User, error)
 	Authenticate(ctx context.Context, email, password string) (*ent.User, error)
 	UpdatePassword(ctx context.Context, userID uuid.UUID, hashedPassword string) error
+	ResetPassword(ctx context.Context, userID uuid.UUID, currentHash, newPassword string) error
+	MarkEmailVerified(ctx context.Context, userID uuid.UUID) error
+	MarkVerificationSent(ctx context.Context, userID uuid.UUID, interval time.Duration) (bool, error)
 
 	// User retrieval
 	GetByID(ctx context.Context, userID uuid.UUID) (*ent.User, error)
@@ -25,9 +29,17 @@ type UserRepository interface {
 	UpdateUserLocation(ctx context.Context, userID uuid.UUID, lat, lng float64) error
 	DeleteUser(ctx context.Context, userID uuid.UUID) error
 
+	// Presence
+	MarkLastSeen(ctx context.Context, userIDs []uuid.UUID, interval time.Duration) (int, error)
+	GetStatuses(ctx context.Context, userIDs []uuid.UUID) ([]*ent.User, error)
+	UpdateStatus(ctx context.Context, userID uuid.UUID, status requests.UserStatus) (*ent.User, error)
+
 	// Media management
-	AddPhoto(ctx context.Context, photoID, userID uuid.UUID, photo requests.UserPhoto) (*ent.UserPhoto, error)
+	AddPhotos(ctx context.Context, userID uuid.UUID, photos []requests.UserPhoto, maxPhotos int) ([]*ent.UserPhoto, error)
+	ReplacePhoto(ctx context.Context, photoID, userID uuid.UUID, photo requests.UserPhoto) (*ent.UserPhoto, error)
+	ReorderPhotos(ctx context.Context, userID uuid.UUID, photoIDs []uuid.UUID) ([]*ent.UserPhoto, error)
 	DeletePhoto(ctx context.Context, photoID, userID uuid.UUID) error
+	GetReferencedPhotoKeys(ctx context.Context, keys []string) (map[string]bool, error)
 
 	// Location specific
 	GetUsersByPreference(ctx context.Context, reqUserID uuid.UUID) ([]*ent.User, *ent.User, error)
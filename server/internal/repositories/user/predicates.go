@@ -0,0 +1,102 @@
+package user
+
+import (
+	"match-me/ent/predicate"
+	"match-me/ent/schema"
+	"match-me/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Predicates used to build two-sided match queries. Each side of a match is
+// expressed separately: what the viewer wants in a candidate, and whether the
+// candidate would want the viewer back.
+
+// ageInRange matches candidates whose age is within [minAge, maxAge].
+// A zero bound is treated as unset.
+func ageInRange(minAge, maxAge int) predicate.User {
+	var preds []predicate.User
+	if minAge > 0 {
+		preds = append(preds, user.AgeGTE(minAge))
+	}
+	if maxAge > 0 {
+		preds = append(preds, user.AgeLTE(maxAge))
+	}
+	return user.And(preds...)
+}
+
+// acceptsAge matches candidates whose preferred age range includes age.
+// Candidates without a bound accept any age on that side.
+func acceptsAge(age int) predicate.User {
+	return user.And(
+		user.Or(user.PreferredAgeMinIsNil(), user.PreferredAgeMinLTE(age)),
+		user.Or(user.PreferredAgeMaxIsNil(), user.PreferredAgeMax(0), user.PreferredAgeMaxGTE(age)),
+	)
+}
+
+// hasGender matches candidates of the preferred gender, or everyone for "all".
+func hasGender(preferred user.PreferredGender) predicate.User {
+	if preferred == user.PreferredGenderAll || preferred == "" {
+		return user.And()
+	}
+	return user.GenderEQ(user.Gender(preferred))
+}
+
+// acceptsGender matches candidates whose gender preference includes gender.
+// Genders without a matching preference value are only accepted by "all".
+func acceptsGender(gender user.Gender) predicate.User {
+	preferred := user.PreferredGender(gender)
+	if user.PreferredGenderValidator(preferred) != nil {
+		return user.PreferredGenderEQ(user.PreferredGenderAll)
+	}
+	return user.PreferredGenderIn(preferred, user.PreferredGenderAll)
+}
+
+// withinDistance matches candidates within meters of point. Without a point
+// nothing is in range.
+func withinDistance(point *schema.Point, meters float64) predicate.User {
+	if point == nil {
+		return noUsers()
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("ST_DWithin(").WriteString(s.C(user.FieldCoordinates)).Comma()
+			writePoint(b, point)
+			b.Comma().Arg(meters).WriteString(")")
+		}))
+	})
+}
+
+// withinPreferredDistance matches candidates whose own preferred distance
+// reaches point. Candidates without a preferred distance accept any distance;
+// without a point no candidate can be reached.
+func withinPreferredDistance(point *schema.Point) predicate.User {
+	if point == nil {
+		return noUsers()
+	}
+	return user.Or(
+		user.PreferredDistanceIsNil(),
+		user.PreferredDistance(0),
+		predicate.User(func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("ST_DWithin(").WriteString(s.C(user.FieldCoordinates)).Comma()
+				writePoint(b, point)
+				b.Comma().WriteString(s.C(user.FieldPreferredDistance)).WriteString(" * 1000)")
+			}))
+		}),
+	)
+}
+
+// noUsers matches no candidates.
+func noUsers() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.False())
+	})
+}
+
+// writePoint writes point as a geography literal with bound arguments.
+func writePoint(b *sql.Builder, point *schema.Point) {
+	b.WriteString("ST_SetSRID(ST_MakePoint(").
+		Arg(point.Longitude).Comma().Arg(point.Latitude).
+		WriteString("), 4326)::geography")
+}
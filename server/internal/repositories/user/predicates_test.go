@@ -0,0 +1,180 @@
+package user
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"match-me/ent/predicate"
+	"match-me/ent/schema"
+	"match-me/ent/user"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// where renders the WHERE clause a predicate adds to a users query, along with its arguments.
+// An empty clause means the predicate matches every candidate.
+func where(p predicate.User) (string, []any) {
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(user.Table))
+	p(s)
+	query, args := s.Query()
+
+	_, clause, _ := strings.Cut(query, " WHERE ")
+	return clause, args
+}
+
+type predicateCase struct {
+	name   string
+	pred   predicate.User
+	clause string
+	args   []any
+}
+
+func runPredicateCases(t *testing.T, tests []predicateCase) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause, args := where(tt.pred)
+			if clause != tt.clause {
+				t.Errorf("clause =\n  %s\nwant\n  %s", clause, tt.clause)
+			}
+			if len(args) != 0 || len(tt.args) != 0 {
+				if !reflect.DeepEqual(args, tt.args) {
+					t.Errorf("args = %v, want %v", args, tt.args)
+				}
+			}
+		})
+	}
+}
+
+func TestAgeInRange(t *testing.T) {
+	runPredicateCases(t, []predicateCase{
+		{
+			name:   "no bounds",
+			pred:   ageInRange(0, 0),
+			clause: "",
+		},
+		{
+			name:   "minimum only",
+			pred:   ageInRange(25, 0),
+			clause: `"users"."age" >= $1`,
+			args:   []any{25},
+		},
+		{
+			name:   "maximum only",
+			pred:   ageInRange(0, 40),
+			clause: `"users"."age" <= $1`,
+			args:   []any{40},
+		},
+		{
+			name:   "both bounds",
+			pred:   ageInRange(25, 40),
+			clause: `"users"."age" >= $1 AND "users"."age" <= $2`,
+			args:   []any{25, 40},
+		},
+	})
+}
+
+func TestAcceptsAge(t *testing.T) {
+	// Candidates without a bound (NULL, or 0 for the maximum) accept any age on that side
+	runPredicateCases(t, []predicateCase{
+		{
+			name: "candidate bounds may be unset",
+			pred: acceptsAge(30),
+			clause: `("users"."preferred_age_min" IS NULL OR "users"."preferred_age_min" <= $1) AND ` +
+				`("users"."preferred_age_max" IS NULL OR "users"."preferred_age_max" = $2 OR "users"."preferred_age_max" >= $3)`,
+			args: []any{30, 0, 30},
+		},
+	})
+}
+
+func TestHasGender(t *testing.T) {
+	runPredicateCases(t, []predicateCase{
+		{
+			name:   "all",
+			pred:   hasGender(user.PreferredGenderAll),
+			clause: "",
+		},
+		{
+			name:   "no preference",
+			pred:   hasGender(""),
+			clause: "",
+		},
+		{
+			name:   "female",
+			pred:   hasGender(user.PreferredGenderFemale),
+			clause: `"users"."gender" = $1`,
+			args:   []any{user.GenderFemale},
+		},
+		{
+			name:   "non-binary",
+			pred:   hasGender(user.PreferredGenderNonBinary),
+			clause: `"users"."gender" = $1`,
+			args:   []any{user.GenderNonBinary},
+		},
+	})
+}
+
+func TestAcceptsGender(t *testing.T) {
+	runPredicateCases(t, []predicateCase{
+		{
+			name:   "male",
+			pred:   acceptsGender(user.GenderMale),
+			clause: `"users"."preferred_gender" IN ($1, $2)`,
+			args:   []any{user.PreferredGenderMale, user.PreferredGenderAll},
+		},
+		{
+			name:   "non-binary",
+			pred:   acceptsGender(user.GenderNonBinary),
+			clause: `"users"."preferred_gender" IN ($1, $2)`,
+			args:   []any{user.PreferredGenderNonBinary, user.PreferredGenderAll},
+		},
+		{
+			// No preference value matches, so only candidates open to everyone accept it
+			name:   "prefer not to say",
+			pred:   acceptsGender(user.GenderPreferNotToSay),
+			clause: `"users"."preferred_gender" = $1`,
+			args:   []any{user.PreferredGenderAll},
+		},
+	})
+}
+
+func TestWithinDistance(t *testing.T) {
+	point := &schema.Point{Longitude: 24.94, Latitude: 60.17}
+
+	runPredicateCases(t, []predicateCase{
+		{
+			name:   "within meters of the point",
+			pred:   withinDistance(point, 5000),
+			clause: `ST_DWithin("users"."coordinates", ST_SetSRID(ST_MakePoint($1, $2), 4326)::geography, $3)`,
+			args:   []any{24.94, 60.17, 5000.0},
+		},
+		{
+			name:   "missing location",
+			pred:   withinDistance(nil, 5000),
+			clause: "FALSE",
+		},
+	})
+}
+
+func TestWithinPreferredDistance(t *testing.T) {
+	point := &schema.Point{Longitude: 24.94, Latitude: 60.17}
+
+	runPredicateCases(t, []predicateCase{
+		{
+			// Candidates without a preferred distance (NULL or 0) accept any distance
+			name: "candidate distance may be unset",
+			pred: withinPreferredDistance(point),
+			clause: `"users"."preferred_distance" IS NULL OR "users"."preferred_distance" = $1 OR ` +
+				`ST_DWithin("users"."coordinates", ST_SetSRID(ST_MakePoint($2, $3), 4326)::geography, "users"."preferred_distance" * 1000)`,
+			args: []any{0, 24.94, 60.17},
+		},
+		{
+			name:   "missing location",
+			pred:   withinPreferredDistance(nil),
+			clause: "FALSE",
+		},
+	})
+}
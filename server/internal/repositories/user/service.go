@@ -10,8 +10,8 @@ import (
 	"match-me/ent/userphoto"
 	"match-me/internal/requests"
 	"math"
+	"time"
 
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -80,10 +80,141 @@ func (r *userRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, h
 	return nil
 }
 
+// MarkEmailVerified records that the user confirmed their email address
+func (r *userRepository) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.client.User.Update().
+		Where(
+			user.ID(userID),
+			user.EmailVerifiedAtIsNil(),
+		).
+		SetEmailVerifiedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark email as verified: %w", err)
+	}
+
+	return nil
+}
+
+// MarkVerificationSent records a verification email send unless one was sent within the given interval.
+// It reports false when the send is throttled.
+func (r *userRepository) MarkVerificationSent(ctx context.Context, userID uuid.UUID, interval time.Duration) (bool, error) {
+	updated, err := r.client.User.Update().
+		Where(
+			user.ID(userID),
+			user.Or(
+				user.VerificationSentAtIsNil(),
+				user.VerificationSentAtLT(time.Now().Add(-interval)),
+			),
+		).
+		SetVerificationSentAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to record verification email: %w", err)
+	}
+
+	return updated == 1, nil
+}
+
+// MarkLastSeen records the users as seen now, skipping those already seen within the given interval.
+// It returns the number of users updated.
+func (r *userRepository) MarkLastSeen(ctx context.Context, userIDs []uuid.UUID, interval time.Duration) (int, error) {
+	now := time.Now()
+	updated, err := r.client.User.Update().
+		Where(
+			user.IDIn(userIDs...),
+			user.Or(
+				user.LastSeenAtIsNil(),
+				user.LastSeenAtLTE(now.Add(-interval)),
+			),
+		).
+		SetLastSeenAt(now).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record last seen: %w", err)
+	}
+
+	return updated, nil
+}
+
+// GetStatuses returns the status mode, status message and last-seen time of the given users
+func (r *userRepository) GetStatuses(ctx context.Context, userIDs []uuid.UUID) ([]*ent.User, error) {
+	users, err := r.client.User.Query().
+		Where(user.IDIn(userIDs...)).
+		Select(
+			user.FieldID,
+			user.FieldStatusMode,
+			user.FieldStatusMessage,
+			user.FieldLastSeenAt,
+			user.FieldShowLastSeen,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statuses: %w", err)
+	}
+
+	return users, nil
+}
+
+// UpdateStatus sets the status mode and status message of a user
+func (r *userRepository) UpdateStatus(ctx context.Context, userID uuid.UUID, status requests.UserStatus) (*ent.User, error) {
+	update := r.client.User.UpdateOneID(userID)
+
+	if status.Mode != nil {
+		update = update.SetStatusMode(user.StatusMode(*status.Mode))
+	}
+
+	if status.StatusMessage != nil {
+		if *status.StatusMessage == "" {
+			update = update.ClearStatusMessage()
+		} else {
+			update = update.SetStatusMessage(*status.StatusMessage)
+		}
+	}
+
+	updatedUser, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return updatedUser, nil
+}
+
+// ResetPassword sets a new password only if the stored hash is still currentHash,
+// so a reset token bound to that hash can be consumed at most once.
+func (r *userRepository) ResetPassword(ctx context.Context, userID uuid.UUID, currentHash, newPassword string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	updated, err := r.client.User.Update().
+		Where(
+			user.ID(userID),
+			user.PasswordHash(currentHash),
+		).
+		SetPasswordHash(string(hash)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+
+	if updated == 0 {
+		return fmt.Errorf("reset token has already been used")
+	}
+
+	return nil
+}
+
 func (r *userRepository) GetByID(ctx context.Context, userID uuid.UUID) (*ent.User, error) {
 	user, err := r.client.User.Query().
 		Where(user.ID(userID)).
-		WithPhotos().
+		WithPhotos(func(q *ent.UserPhotoQuery) {
+			q.Order(ent.Asc(userphoto.FieldOrder))
+		}).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -194,6 +325,11 @@ func (r *userRepository) UpdateUser(ctx context.Context, userID uuid.UUID, userD
 		update = update.SetPreferredGender(user.PreferredGender(*userData.PreferredGender))
 	}
 
+	// Optional: Last seen visibility
+	if userData.ShowLastSeen != nil {
+		update = update.SetShowLastSeen(*userData.ShowLastSeen)
+	}
+
 	updatedUser, err := update.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
@@ -211,35 +347,221 @@ func (r *userRepository) DeleteUser(ctx context.Context, userID uuid.UUID) error
 	return nil
 }
 
-func (r *userRepository) AddPhoto(ctx context.Context, photoID, userID uuid.UUID, photo requests.UserPhoto) (*ent.UserPhoto, error) {
-	userPhoto, err := r.client.UserPhoto.Create().
-		SetID(photoID).
+func (r *userRepository) AddPhotos(ctx context.Context, userID uuid.UUID, photos []requests.UserPhoto, maxPhotos int) ([]*ent.UserPhoto, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Orders are kept compact, so new photos are appended after the current count.
+	// A concurrent upload computing the same positions fails on the unique index.
+	count, err := tx.UserPhoto.Query().
+		Where(userphoto.UserID(userID)).
+		Count(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to count photos: %w", err)
+	}
+
+	if maxPhotos > 0 && count+len(photos) > maxPhotos {
+		tx.Rollback()
+		return nil, fmt.Errorf("photo limit reached")
+	}
+
+	userPhotos := make([]*ent.UserPhoto, len(photos))
+	for i, photo := range photos {
+		userPhotos[i], err = tx.UserPhoto.Create().
+			SetPhotoURL(photo.PhotoUrl).
+			SetPublicID(photo.PID).
+			SetMediumURL(photo.MediumUrl).
+			SetMediumPublicID(photo.MediumPID).
+			SetThumbnailURL(photo.ThumbnailUrl).
+			SetThumbnailPublicID(photo.ThumbnailPID).
+			SetOrder(count + i + 1).
+			SetUserID(userID).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			if ent.IsConstraintError(err) {
+				return nil, fmt.Errorf("photos were modified concurrently, please retry")
+			}
+			return nil, fmt.Errorf("failed to add photo: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return userPhotos, nil
+}
+
+func (r *userRepository) ReplacePhoto(ctx context.Context, photoID, userID uuid.UUID, photo requests.UserPhoto) (*ent.UserPhoto, error) {
+	affected, err := r.client.UserPhoto.Update().
+		Where(
+			userphoto.ID(photoID),
+			userphoto.UserID(userID),
+		).
 		SetPhotoURL(photo.PhotoUrl).
 		SetPublicID(photo.PID).
-		SetOrder(photo.Order).
-		SetUserID(userID).
+		SetMediumURL(photo.MediumUrl).
+		SetMediumPublicID(photo.MediumPID).
+		SetThumbnailURL(photo.ThumbnailUrl).
+		SetThumbnailPublicID(photo.ThumbnailPID).
 		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to add photo: %w", err)
+		return nil, fmt.Errorf("failed to replace photo: %w", err)
+	}
+
+	if affected == 0 {
+		return nil, fmt.Errorf("photo not found for user")
+	}
+
+	userPhoto, err := r.client.UserPhoto.Get(ctx, photoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get photo: %w", err)
 	}
 
 	return userPhoto, nil
 }
 
+func (r *userRepository) ReorderPhotos(ctx context.Context, userID uuid.UUID, photoIDs []uuid.UUID) ([]*ent.UserPhoto, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	photos, err := tx.UserPhoto.Query().
+		Where(userphoto.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to get photos: %w", err)
+	}
+
+	// The new order must be a permutation of the user's photos
+	maxOrder := 0
+	owned := make(map[uuid.UUID]bool, len(photos))
+	for _, photo := range photos {
+		owned[photo.ID] = true
+		if photo.Order > maxOrder {
+			maxOrder = photo.Order
+		}
+	}
+
+	if len(photoIDs) != len(photos) {
+		tx.Rollback()
+		return nil, fmt.Errorf("photo order must list each photo exactly once")
+	}
+	for _, id := range photoIDs {
+		if !owned[id] {
+			tx.Rollback()
+			return nil, fmt.Errorf("photo order must list each photo exactly once")
+		}
+		delete(owned, id)
+	}
+
+	// Move every photo past the current highest order first, so assigning the
+	// final positions never collides with the unique (user_id, order) index
+	if err := tx.UserPhoto.Update().
+		Where(userphoto.UserID(userID)).
+		AddOrder(maxOrder).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to reorder photos: %w", err)
+	}
+
+	reordered := make([]*ent.UserPhoto, len(photoIDs))
+	for i, id := range photoIDs {
+		reordered[i], err = tx.UserPhoto.UpdateOneID(id).
+			SetOrder(i + 1).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to reorder photos: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return reordered, nil
+}
+
 func (r *userRepository) DeletePhoto(ctx context.Context, photoID, userID uuid.UUID) error {
-	_, err := r.client.UserPhoto.Delete().
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	_, err = tx.UserPhoto.Delete().
 		Where(
 			userphoto.ID(photoID),
 			userphoto.UserID(userID),
 		).
 		Exec(ctx)
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf("failed to delete photo: %w", err)
 	}
 
+	// Close the gap left by the deleted photo. Walking the remaining photos in
+	// ascending order means each target position is already free.
+	remaining, err := tx.UserPhoto.Query().
+		Where(userphoto.UserID(userID)).
+		Order(ent.Asc(userphoto.FieldOrder)).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to get photos: %w", err)
+	}
+
+	for i, photo := range remaining {
+		if photo.Order == i+1 {
+			continue
+		}
+		if err := tx.UserPhoto.UpdateOneID(photo.ID).
+			SetOrder(i + 1).
+			Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to update photo order: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return nil
 }
 
+// GetReferencedPhotoKeys returns which of the given storage keys are still used by a photo
+func (r *userRepository) GetReferencedPhotoKeys(ctx context.Context, keys []string) (map[string]bool, error) {
+	referenced := make(map[string]bool)
+	if len(keys) == 0 {
+		return referenced, nil
+	}
+
+	photos, err := r.client.UserPhoto.Query().
+		Where(userphoto.Or(
+			userphoto.PublicIDIn(keys...),
+			userphoto.MediumPublicIDIn(keys...),
+			userphoto.ThumbnailPublicIDIn(keys...),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get photos by key: %w", err)
+	}
+
+	for _, photo := range photos {
+		referenced[photo.PublicID] = true
+		referenced[photo.MediumPublicID] = true
+		referenced[photo.ThumbnailPublicID] = true
+	}
+	return referenced, nil
+}
+
 func (r *userRepository) UpdateUserLocation(ctx context.Context, userID uuid.UUID, lat, lng float64) error {
 	coordinates := &schema.Point{
 		Longitude: lng,
@@ -336,20 +658,19 @@ func (r *userRepository) GetUsersByPreference(
 
 	query := r.client.User.Query().Where(
 		user.IDNEQ(reqUserID),
-		user.PreferredAgeMinGTE(currentUser.PreferredAgeMin),
-		user.PreferredAgeMaxLTE(currentUser.PreferredAgeMax),
 		user.ProfileCompletionGTE(95),
-		func(s *sql.Selector) {
-			s.Where(sql.ExprP(
-				"ST_DWithin(coordinates, ST_SetSRID(ST_MakePoint($5, $6), 4326)::geography, $7)",
-				currentUser.Coordinates.Longitude, currentUser.Coordinates.Latitude, distanceInMeters,
-			))
-		},
-	)
+		user.EmailVerifiedAtNotNil(),
 
-	if currentUser.PreferredGender != user.PreferredGenderAll {
-		query = query.Where(user.GenderEQ(user.Gender(currentUser.PreferredGender)))
-	}
+		// What the current user is looking for
+		ageInRange(currentUser.PreferredAgeMin, currentUser.PreferredAgeMax),
+		hasGender(currentUser.PreferredGender),
+		withinDistance(currentUser.Coordinates, distanceInMeters),
+
+		// Whether the candidate would want the current user back
+		acceptsAge(currentUser.Age),
+		acceptsGender(currentUser.Gender),
+		withinPreferredDistance(currentUser.Coordinates),
+	)
 
 	users, err := query.All(ctx)
 	if err != nil {
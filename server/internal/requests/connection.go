@@ -38,6 +38,35 @@ type UserInteraction struct {
 
 // SendTextMessageBody represents the request body for sending a text message
 type SendTextMessageBody struct {
-	ConnectionID uuid.UUID `json:"connection_id" binding:"required"`
-	Content      string    `json:"content" binding:"required"`
+	ConnectionID uuid.UUID  `json:"connection_id" binding:"required"`
+	Content      string     `json:"content" binding:"required,max=4000"`
+	ReplyToID    *uuid.UUID `json:"reply_to_id"`
+
+	// Optional idempotency key; resending with the same key returns the stored message
+	ClientMessageID string `json:"client_message_id" binding:"omitempty,max=64"`
+}
+
+// MessageHistoryQuery represents the query parameters for loading a page of message history.
+// At most one of Before, After and Around may be set.
+type MessageHistoryQuery struct {
+	Before string `form:"before"`
+	After  string `form:"after"`
+	Around string `form:"around"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// EditMessageBody represents the request body for editing a message
+type EditMessageBody struct {
+	Content string `json:"content" binding:"required,max=4000"`
+}
+
+// ReactionBody represents the request body for reacting to a message
+type ReactionBody struct {
+	Emoji string `json:"emoji" binding:"required"`
+}
+
+// MarkReadBody represents the optional request body for marking messages as read.
+// Without UpToMessageID everything up to the latest message is marked as read.
+type MarkReadBody struct {
+	UpToMessageID *uuid.UUID `json:"up_to_message_id"`
 }
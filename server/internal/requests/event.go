@@ -2,36 +2,45 @@ package requests
 
 import (
 	"time"
-
-	"github.com/google/uuid"
 )
 
-// Event represents local events (Couchsurfing-style)
-type Event struct {
-	ID                  uuid.UUID  `json:"id" db:"id"`
-	CreatorID           uuid.UUID  `json:"creator_id" db:"creator_id"`
-	Title               string     `json:"title" db:"title"`
-	Description         *string    `json:"description,omitempty" db:"description"`
-	EventType           *string    `json:"event_type,omitempty" db:"event_type"`
-	LocationLat         *float64   `json:"location_lat,omitempty" db:"location_lat"`
-	LocationLng         *float64   `json:"location_lng,omitempty" db:"location_lng"`
-	LocationName        *string    `json:"location_name,omitempty" db:"location_name"`
-	MaxParticipants     *int       `json:"max_participants,omitempty" db:"max_participants"`
-	CurrentParticipants int        `json:"current_participants" db:"current_participants"`
-	StartTime           *time.Time `json:"start_time,omitempty" db:"start_time"`
-	EndTime             *time.Time `json:"end_time,omitempty" db:"end_time"`
-	IsActive            bool       `json:"is_active" db:"is_active"`
-	CreatedAt           time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt           time.Time  `json:"updated_at" db:"updated_at"`
+// CreateEventBody represents the request body for creating a local event
+type CreateEventBody struct {
+	Title           string     `json:"title" binding:"required,max=120"`
+	Description     *string    `json:"description" binding:"omitempty,max=2000"`
+	EventType       *string    `json:"event_type" binding:"omitempty,max=50"`
+	LocationLat     *float64   `json:"location_lat" binding:"required,min=-90,max=90"`
+	LocationLng     *float64   `json:"location_lng" binding:"required,min=-180,max=180"`
+	LocationName    *string    `json:"location_name" binding:"omitempty,max=200"`
+	MaxParticipants *int       `json:"max_participants" binding:"omitempty,min=0"`
+	StartTime       time.Time  `json:"start_time" binding:"required"`
+	EndTime         *time.Time `json:"end_time"`
+}
+
+// UpdateEventBody represents the request body for changing an event.
+// Only the fields that are set are changed.
+type UpdateEventBody struct {
+	Title           *string    `json:"title" binding:"omitempty,min=1,max=120"`
+	Description     *string    `json:"description" binding:"omitempty,max=2000"`
+	EventType       *string    `json:"event_type" binding:"omitempty,max=50"`
+	LocationLat     *float64   `json:"location_lat" binding:"required_with=LocationLng,omitempty,min=-90,max=90"`
+	LocationLng     *float64   `json:"location_lng" binding:"required_with=LocationLat,omitempty,min=-180,max=180"`
+	LocationName    *string    `json:"location_name" binding:"omitempty,max=200"`
+	MaxParticipants *int       `json:"max_participants" binding:"omitempty,min=0"`
+	StartTime       *time.Time `json:"start_time"`
+	EndTime         *time.Time `json:"end_time"`
+}
 
-	// Computed field
-	Distance *float64 `json:"distance,omitempty" db:"-"`
+// NearbyEventsQuery represents the query parameters for discovering events nearby.
+// Without a location the user's own coordinates are used.
+type NearbyEventsQuery struct {
+	Lat      *float64 `form:"lat" binding:"required_with=Lng,omitempty,min=-90,max=90"`
+	Lng      *float64 `form:"lng" binding:"required_with=Lat,omitempty,min=-180,max=180"`
+	RadiusKm int      `form:"radius_km" binding:"omitempty,min=1,max=500"`
+	Limit    int      `form:"limit" binding:"omitempty,min=1,max=100"`
 }
 
-// EventParticipant represents event participation
-type EventParticipant struct {
-	EventID  uuid.UUID `json:"event_id" db:"event_id"`
-	UserID   uuid.UUID `json:"user_id" db:"user_id"`
-	Status   string    `json:"status" db:"status"` // 'interested', 'confirmed', 'declined'
-	JoinedAt time.Time `json:"joined_at" db:"joined_at"`
+// RSVPBody represents the request body for responding to an event
+type RSVPBody struct {
+	Status string `json:"status" binding:"required,oneof=interested confirmed declined"`
 }
@@ -6,14 +6,11 @@ import (
 	"github.com/google/uuid"
 )
 
-// UserStatus represents live status indicators
+// UserStatus changes the availability mode and custom status message of the current user.
+// An empty status message clears it.
 type UserStatus struct {
-	UserID        uuid.UUID `json:"user_id" db:"user_id"`
-	CurrentModeID uuid.UUID `json:"current_mode_id" db:"current_mode_id"`
-	IsAvailable   bool      `json:"is_available" db:"is_available"`
-	StatusMessage *string   `json:"status_message,omitempty" db:"status_message"`
-	LastActivity  time.Time `json:"last_activity" db:"last_activity"`
-	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+	Mode          *string `json:"mode,omitempty" validate:"omitempty,oneof=available busy invisible"`
+	StatusMessage *string `json:"status_message,omitempty" validate:"omitempty,max=80"`
 }
 
 // TypingIndicator represents real-time typing status
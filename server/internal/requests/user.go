@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+
+	"github.com/google/uuid"
 )
 
 // User represents the core user entity
@@ -14,14 +16,38 @@ type RegisterUser struct {
 	LastName  string `json:"last_name" validate:"required,min=3,max=30"`
 	Age       int    `json:"age" validate:"required,min=18,max=100"`
 	Gender    string `json:"gender" validate:"required,oneof=male female non_binary prefer_not_to_say"`
+	Device    string `json:"device" validate:"omitempty,max=100"`
 }
 
 type LoginRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=6"`
+	Device   string `json:"device" validate:"omitempty,max=100"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
 }
 
 type UpdatePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=6"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
 	NewPassword string `json:"new_password" validate:"required,min=6"`
 }
 
@@ -37,12 +63,21 @@ type UpdateUser struct {
 	PreferredAgeMax   *int      `json:"preferred_age_max" validate:"omitempty,min=18,max=100"`
 	PreferredGender   *string   `json:"preferred_gender" validate:"omitempty,oneof=male female non_binary all"`
 	PreferredDistance *int      `json:"preferred_distance" validate:"omitempty,min=0,max=1000"`
+	ShowLastSeen      *bool     `json:"show_last_seen" validate:"omitempty"`
+}
+
+type ReorderPhotosRequest struct {
+	PhotoIDs []uuid.UUID `json:"photo_ids" validate:"required,min=1"`
 }
 
 type UserPhoto struct {
-	PhotoUrl string `json:"photo_url" validate:"omitempty,url"`
-	Order    int    `json:"order" validate:"omitempty,min=1"`
-	PID      string `json:"public_id" validate:"omitempty,url"`
+	PhotoUrl     string `json:"photo_url" validate:"omitempty,url"`
+	Order        int    `json:"order" validate:"omitempty,min=1"`
+	PID          string `json:"public_id" validate:"omitempty,url"`
+	MediumUrl    string `json:"medium_url" validate:"omitempty,url"`
+	MediumPID    string `json:"medium_public_id" validate:"omitempty"`
+	ThumbnailUrl string `json:"thumbnail_url" validate:"omitempty,url"`
+	ThumbnailPID string `json:"thumbnail_public_id" validate:"omitempty"`
 }
 
 type Location struct {
@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"time"
+
+	"match-me/config"
+	"match-me/ent"
+	"match-me/internal/repositories/interactions"
+	"match-me/internal/repositories/session"
+)
+
+// Job names, also accepted by the -run-job flag
+const (
+	JobExpireRequests      = "expire-requests"
+	JobCleanupInteractions = "cleanup-interactions"
+	JobCleanupSessions     = "cleanup-sessions"
+)
+
+// DefaultJobTimeout bounds a single run of the built-in jobs
+const DefaultJobTimeout = 5 * time.Minute
+
+// RegisterJobs registers the built-in maintenance jobs.
+// Request expiry broadcasts over WebSocket, so it is registered alongside the routes.
+func RegisterJobs(s *Scheduler, client *ent.Client, cfg *config.Config) {
+	interactionRepo := interactions.NewUserInteractionRepository(client)
+	sessionRepo := session.NewSessionRepository(client)
+
+	s.Register(Job{
+		Name:     JobCleanupInteractions,
+		Interval: cfg.CleanupInteractionsInterval,
+		Timeout:  DefaultJobTimeout,
+		Run:      interactionRepo.CleanupExpiredInteractions,
+	})
+
+	s.Register(Job{
+		Name:     JobCleanupSessions,
+		Interval: cfg.CleanupSessionsInterval,
+		Timeout:  DefaultJobTimeout,
+		Run:      sessionRepo.DeleteExpiredSessions,
+	})
+}
@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"match-me/ent"
+)
+
+// locker runs fn while holding the lock for name, reporting false without running fn
+// when another instance already holds it
+type locker interface {
+	withLock(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error)
+}
+
+// advisoryLocker locks jobs with Postgres advisory locks
+type advisoryLocker struct {
+	client *ent.Client
+}
+
+func (l advisoryLocker) withLock(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	return withAdvisoryLock(ctx, l.client, name, fn)
+}
+
+// withAdvisoryLock runs fn while holding a transaction-scoped Postgres advisory lock
+// derived from name. The lock is released when the transaction ends, even if the
+// process dies. It reports false without running fn when the lock is already taken.
+func withAdvisoryLock(ctx context.Context, client *ent.Client, name string, fn func(ctx context.Context) error) (bool, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, "SELECT pg_try_advisory_xact_lock($1)", lockKey(name))
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire advisory lock: %w", err)
+	}
+
+	var acquired bool
+	if rows.Next() {
+		err = rows.Scan(&acquired)
+	}
+	rows.Close()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire advisory lock: %w", err)
+	}
+
+	if !acquired {
+		return false, nil
+	}
+
+	return true, fn(ctx)
+}
+
+// lockKey maps a job name to an advisory lock key
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("match-me:job:" + name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,226 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"match-me/ent"
+)
+
+// JobFunc runs a job once and returns the number of rows it affected
+type JobFunc func(ctx context.Context) (int, error)
+
+// Job is a periodic task run by the scheduler
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Timeout  time.Duration
+	Run      JobFunc
+}
+
+// JobMetrics describes the runs of a job in this process
+type JobMetrics struct {
+	Name         string        `json:"name"`
+	Interval     time.Duration `json:"-"`
+	Runs         int           `json:"runs"`
+	Failures     int           `json:"failures"`
+	Skipped      int           `json:"skipped"`
+	LastRunAt    *time.Time    `json:"last_run_at,omitempty"`
+	LastDuration time.Duration `json:"-"`
+	LastAffected int           `json:"last_affected"`
+	LastError    string        `json:"last_error,omitempty"`
+}
+
+// MarshalJSON writes the durations in a readable form, e.g. "1h0m0s"
+func (m JobMetrics) MarshalJSON() ([]byte, error) {
+	type metrics JobMetrics
+	return json.Marshal(struct {
+		metrics
+		Interval     string `json:"interval"`
+		LastDuration string `json:"last_duration"`
+	}{
+		metrics:      metrics(m),
+		Interval:     m.Interval.String(),
+		LastDuration: m.LastDuration.String(),
+	})
+}
+
+// Scheduler runs registered jobs periodically. Each run takes a Postgres advisory
+// lock first, so when several instances are deployed only one of them runs a job at a time.
+type Scheduler struct {
+	locker locker
+	jitter time.Duration
+
+	mu      sync.Mutex
+	jobs    map[string]*Job
+	metrics map[string]*JobMetrics
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a scheduler. Every run is delayed by a random duration up to jitter
+// so instances started together do not all compete for the same lock.
+func New(client *ent.Client, jitter time.Duration) *Scheduler {
+	return &Scheduler{
+		locker:  advisoryLocker{client: client},
+		jitter:  jitter,
+		jobs:    make(map[string]*Job),
+		metrics: make(map[string]*JobMetrics),
+	}
+}
+
+// Register adds a job to the scheduler. Jobs must be registered before Start.
+func (s *Scheduler) Register(job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs[job.Name] = &job
+	s.metrics[job.Name] = &JobMetrics{Name: job.Name, Interval: job.Interval}
+}
+
+// Start runs every registered job on its interval until Stop is called
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+	log.Printf("Scheduler started with %d jobs", len(s.jobs))
+}
+
+// Stop cancels pending runs and waits for running jobs to finish
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	log.Printf("Scheduler stopped")
+}
+
+// RunNow runs a job once, still honoring the advisory lock
+func (s *Scheduler) RunNow(ctx context.Context, name string) (int, error) {
+	s.mu.Lock()
+	job, ok := s.jobs[name]
+	s.mu.Unlock()
+
+	if !ok {
+		return 0, fmt.Errorf("unknown job: %s", name)
+	}
+
+	affected, ran, err := s.run(ctx, job)
+	if err != nil {
+		return 0, err
+	}
+	if !ran {
+		return 0, fmt.Errorf("job %s is already running on another instance", name)
+	}
+	return affected, nil
+}
+
+// JobNames returns the names of the registered jobs
+func (s *Scheduler) JobNames() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Metrics returns a snapshot of the metrics of every job
+func (s *Scheduler) Metrics() []JobMetrics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metrics := make([]JobMetrics, 0, len(s.metrics))
+	for _, m := range s.metrics {
+		metrics = append(metrics, *m)
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Name < metrics[j].Name
+	})
+	return metrics
+}
+
+func (s *Scheduler) loop(ctx context.Context, job *Job) {
+	defer s.wg.Done()
+
+	for {
+		delay := job.Interval
+		if s.jitter > 0 {
+			delay += time.Duration(rand.Int63n(int64(s.jitter)))
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		affected, ran, err := s.run(ctx, job)
+		switch {
+		case err != nil:
+			log.Printf("Job %s failed: %v", job.Name, err)
+		case ran && affected > 0:
+			log.Printf("Job %s completed: %d rows affected", job.Name, affected)
+		}
+	}
+}
+
+// run executes a job while holding its advisory lock. ran is false when another
+// instance holds the lock.
+func (s *Scheduler) run(ctx context.Context, job *Job) (affected int, ran bool, err error) {
+	if job.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, job.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	ran, err = s.locker.withLock(ctx, job.Name, func(ctx context.Context) error {
+		var runErr error
+		affected, runErr = job.Run(ctx)
+		return runErr
+	})
+
+	s.record(job.Name, start, affected, ran, err)
+	return affected, ran, err
+}
+
+func (s *Scheduler) record(name string, start time.Time, affected int, ran bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := s.metrics[name]
+	if !ran && err == nil {
+		m.Skipped++
+		return
+	}
+
+	m.Runs++
+	m.LastRunAt = &start
+	m.LastDuration = time.Since(start)
+	m.LastAffected = affected
+	m.LastError = ""
+	if err != nil {
+		m.Failures++
+		m.LastError = err.Error()
+	}
+}
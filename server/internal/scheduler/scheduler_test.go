@@ -0,0 +1,131 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeLocker stands in for the advisory lock. When held is true another instance
+// owns every lock, so fn is never run.
+type fakeLocker struct {
+	held bool
+}
+
+func (l *fakeLocker) withLock(ctx context.Context, _ string, fn func(ctx context.Context) error) (bool, error) {
+	if l.held {
+		return false, nil
+	}
+	return true, fn(ctx)
+}
+
+func newTestScheduler(l *fakeLocker, jobs ...Job) *Scheduler {
+	s := New(nil, 0)
+	s.locker = l
+	for _, job := range jobs {
+		s.Register(job)
+	}
+	return s
+}
+
+func metricsFor(t *testing.T, s *Scheduler, name string) JobMetrics {
+	t.Helper()
+
+	for _, m := range s.Metrics() {
+		if m.Name == name {
+			return m
+		}
+	}
+	t.Fatalf("no metrics for job %s", name)
+	return JobMetrics{}
+}
+
+func TestRunNowUnknownJob(t *testing.T) {
+	s := newTestScheduler(&fakeLocker{})
+
+	_, err := s.RunNow(context.Background(), "missing")
+	if err == nil || err.Error() != "unknown job: missing" {
+		t.Fatalf("RunNow error = %v, want unknown job", err)
+	}
+}
+
+func TestRunNowRecordsMetrics(t *testing.T) {
+	runErr := errors.New("database unavailable")
+
+	tests := []struct {
+		name         string
+		run          JobFunc
+		wantAffected int
+		wantErr      error
+		wantFailures int
+		wantLastErr  string
+	}{
+		{
+			name:         "success",
+			run:          func(context.Context) (int, error) { return 7, nil },
+			wantAffected: 7,
+		},
+		{
+			name:         "failure",
+			run:          func(context.Context) (int, error) { return 0, runErr },
+			wantErr:      runErr,
+			wantFailures: 1,
+			wantLastErr:  runErr.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestScheduler(&fakeLocker{}, Job{Name: "job", Interval: time.Hour, Run: tt.run})
+
+			before := time.Now()
+			affected, err := s.RunNow(context.Background(), "job")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RunNow error = %v, want %v", err, tt.wantErr)
+			}
+			if affected != tt.wantAffected {
+				t.Errorf("RunNow affected = %d, want %d", affected, tt.wantAffected)
+			}
+
+			m := metricsFor(t, s, "job")
+			if m.Runs != 1 || m.Failures != tt.wantFailures || m.Skipped != 0 {
+				t.Errorf("runs/failures/skipped = %d/%d/%d, want 1/%d/0", m.Runs, m.Failures, m.Skipped, tt.wantFailures)
+			}
+			if m.LastAffected != tt.wantAffected {
+				t.Errorf("LastAffected = %d, want %d", m.LastAffected, tt.wantAffected)
+			}
+			if m.LastError != tt.wantLastErr {
+				t.Errorf("LastError = %q, want %q", m.LastError, tt.wantLastErr)
+			}
+			if m.LastRunAt == nil || m.LastRunAt.Before(before) {
+				t.Errorf("LastRunAt = %v, want the time of the run", m.LastRunAt)
+			}
+		})
+	}
+}
+
+func TestRunNowSkipsWhenLockHeld(t *testing.T) {
+	called := false
+	s := newTestScheduler(&fakeLocker{held: true}, Job{
+		Name:     "job",
+		Interval: time.Hour,
+		Run: func(context.Context) (int, error) {
+			called = true
+			return 1, nil
+		},
+	})
+
+	_, err := s.RunNow(context.Background(), "job")
+	if err == nil || err.Error() != "job job is already running on another instance" {
+		t.Fatalf("RunNow error = %v, want already running", err)
+	}
+	if called {
+		t.Error("job ran without holding the lock")
+	}
+
+	m := metricsFor(t, s, "job")
+	if m.Skipped != 1 || m.Runs != 0 || m.LastRunAt != nil {
+		t.Errorf("skipped/runs = %d/%d with LastRunAt %v, want only a skip recorded", m.Skipped, m.Runs, m.LastRunAt)
+	}
+}
@@ -5,9 +5,10 @@ import (
 	"fmt"
 	"match-me/ent"
 	"match-me/internal/models"
-	"match-me/internal/pkg/cloudinary"
+	"match-me/internal/pkg/storage"
 	"match-me/internal/repositories/connections"
 	"match-me/internal/usecases/interactions"
+	"match-me/internal/websocket"
 
 	"github.com/google/uuid"
 )
@@ -16,18 +17,21 @@ type connectionUsecase struct {
 	connectionRepo connections.ConnectionRepository
 	messageRepo    connections.MessageRepository
 	interactionUC  interactions.UserInteractionUsecase
-	cld            cloudinary.Cloudinary
+	media          storage.MediaStore
+	wsService      *websocket.WebSocketService
 }
 
 func NewConnectionUsecase(messageRepo connections.MessageRepository,
 	connectionRepo connections.ConnectionRepository,
 	interactionUC interactions.UserInteractionUsecase,
-	cld cloudinary.Cloudinary) ConnectionUsecase {
+	media storage.MediaStore,
+	wsService *websocket.WebSocketService) ConnectionUsecase {
 	return &connectionUsecase{
 		messageRepo:    messageRepo,
 		connectionRepo: connectionRepo,
 		interactionUC:  interactionUC,
-		cld:            cld,
+		media:          media,
+		wsService:      wsService,
 	}
 }
 
@@ -110,8 +114,9 @@ func (u *connectionUsecase) DeleteConnection(ctx context.Context, userID, connec
 
 	// delete all message media in go routine
 	go func() {
-		folder := fmt.Sprintf("%s_media_photo", connectionID.String())
-		_ = u.cld.DeleteFolder(folder)
+		if err := u.media.DeleteFolder(context.Background(), connectionMediaFolder(connectionID)); err != nil {
+			fmt.Printf("Warning: Failed to delete connection media: %v\n", err)
+		}
 	}()
 
 	// Delete the connection
@@ -120,5 +125,15 @@ func (u *connectionUsecase) DeleteConnection(ctx context.Context, userID, connec
 		return fmt.Errorf("failed to delete connection: %w", err)
 	}
 
+	// The two users no longer see each other's status
+	if u.wsService != nil {
+		u.wsService.UnlinkUsers(connection.UserAID, connection.UserBID)
+	}
+
 	return nil
 }
+
+// connectionMediaFolder is the media store folder holding the media messages of a connection
+func connectionMediaFolder(connectionID uuid.UUID) string {
+	return fmt.Sprintf("%s_media_photo", connectionID.String())
+}
@@ -0,0 +1,25 @@
+package connections
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// encodeCursor returns an opaque cursor holding the given position
+func encodeCursor(position any) string {
+	data, _ := json.Marshal(position)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor parses a cursor returned by encodeCursor into position
+func decodeCursor(cursor string, position any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor")
+	}
+	if err := json.Unmarshal(data, position); err != nil {
+		return fmt.Errorf("invalid cursor")
+	}
+	return nil
+}
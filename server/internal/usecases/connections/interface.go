@@ -2,7 +2,10 @@ package connections
 
 import (
 	"context"
+	"io"
 	"match-me/internal/models"
+	"match-me/internal/requests"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -16,18 +19,31 @@ type ConnectionUsecase interface {
 
 // ConnectionRequestUsecase handles business logic for connection requests
 type ConnectionRequestUsecase interface {
-	SendRequest(ctx context.Context, senderID, receiverID uuid.UUID, message string) (*models.ConnectionRequest, error)
+	SendRequest(ctx context.Context, senderID, receiverID uuid.UUID, message string, expiresAt *time.Time) (*models.ConnectionRequest, error)
 	GetPendingRequests(ctx context.Context, userID uuid.UUID) ([]*models.ConnectionRequest, error)
 	AcceptRequest(ctx context.Context, userID, requestID uuid.UUID) (*models.Connection, error)
 	DeclineRequest(ctx context.Context, userID, requestID uuid.UUID) error
+	WithdrawRequest(ctx context.Context, userID, requestID uuid.UUID) error
+	ExpireRequests(ctx context.Context) (int, error)
+	Swipe(ctx context.Context, userID, targetUserID uuid.UUID, action string) (*models.SwipeResult, error)
 }
 
 // MessageUsecase handles business logic for messaging between connected users
 type MessageUsecase interface {
-	SendTextMessage(ctx context.Context, senderID uuid.UUID, connectionID uuid.UUID, content string) (*models.Message, error)
-	SendMediaMessage(ctx context.Context, senderID uuid.UUID, connectionID uuid.UUID, mediaFile interface{}, txtContent string) (*models.Message, error)
-	GetConnectionMessages(ctx context.Context, userID, connectionID uuid.UUID, limit, offset int) ([]*models.Message, error)
-	MarkMessagesAsRead(ctx context.Context, userID, connectionID uuid.UUID) error
+	SendTextMessage(ctx context.Context, senderID uuid.UUID, connectionID uuid.UUID, content string, replyToID *uuid.UUID, clientMessageID string) (*models.Message, error)
+	SendMediaMessage(ctx context.Context, senderID uuid.UUID, connectionID uuid.UUID, mediaFile io.Reader, txtContent string, replyToID *uuid.UUID) (*models.Message, error)
+	GetConnectionMessages(ctx context.Context, userID, connectionID uuid.UUID, query requests.MessageHistoryQuery) (*models.MessagePage, error)
+	MarkMessagesAsRead(ctx context.Context, userID, connectionID uuid.UUID, upToMessageID *uuid.UUID) ([]uuid.UUID, error)
+	GetReadMarkers(ctx context.Context, userID, connectionID uuid.UUID) ([]*models.ReadMarker, error)
+	DeliverPendingMessages(ctx context.Context, userID uuid.UUID) (int, error)
+	EditMessage(ctx context.Context, userID, messageID uuid.UUID, content string) (*models.Message, error)
+	DeleteMessage(ctx context.Context, userID, messageID uuid.UUID) error
+	GetMessageEdits(ctx context.Context, userID, messageID uuid.UUID) ([]*models.MessageEdit, error)
+	GetMessageReplies(ctx context.Context, userID, messageID uuid.UUID) ([]*models.Message, error)
+	AddReaction(ctx context.Context, userID, messageID uuid.UUID, emoji string) ([]*models.ReactionSummary, error)
+	RemoveReaction(ctx context.Context, userID, messageID uuid.UUID, emoji string) ([]*models.ReactionSummary, error)
+	SearchMessages(ctx context.Context, userID uuid.UUID, connectionID *uuid.UUID, query, cursor string, limit int) (*models.MessageSearchPage, error)
 	GetUnreadCount(ctx context.Context, userID uuid.UUID) (int, error)
 	GetChatList(ctx context.Context, userID uuid.UUID) (*models.ChatList, error)
+	CheckConnectionAccess(ctx context.Context, userID, connectionID uuid.UUID) error
 }
@@ -0,0 +1,182 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+	"match-me/ent"
+	"match-me/internal/models"
+	"match-me/internal/repositories/connections"
+	"match-me/internal/requests"
+
+	"github.com/google/uuid"
+)
+
+const defaultHistoryPageSize = 50
+
+// GetConnectionMessages loads a page of a conversation with keyset pagination.
+// Without a cursor it returns the latest messages; Before and After page from a
+// cursor of an earlier page, and Around centres the page on a message, such as a search hit.
+func (u *messageUsecase) GetConnectionMessages(ctx context.Context, userID, connectionID uuid.UUID, query requests.MessageHistoryQuery) (*models.MessagePage, error) {
+	// Verify the connection exists and user is part of it
+	_, err := u.validateConnectionAccess(ctx, userID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	set := 0
+	for _, param := range []string{query.Before, query.After, query.Around} {
+		if param != "" {
+			set++
+		}
+	}
+	if set > 1 {
+		return nil, fmt.Errorf("only one of before, after or around can be set")
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultHistoryPageSize
+	}
+
+	switch {
+	case query.Before != "":
+		cursor, err := decodeMessageCursor(query.Before)
+		if err != nil {
+			return nil, err
+		}
+		older, hasOlder, err := u.olderMessages(ctx, connectionID, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+		return newMessagePage(older, hasOlder, true), nil
+
+	case query.After != "":
+		cursor, err := decodeMessageCursor(query.After)
+		if err != nil {
+			return nil, err
+		}
+		newer, hasNewer, err := u.newerMessages(ctx, connectionID, *cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+		return newMessagePage(newer, true, hasNewer), nil
+
+	case query.Around != "":
+		return u.messagesAround(ctx, connectionID, query.Around, limit)
+
+	default:
+		latest, hasOlder, err := u.olderMessages(ctx, connectionID, nil, limit)
+		if err != nil {
+			return nil, err
+		}
+		return newMessagePage(latest, hasOlder, false), nil
+	}
+}
+
+// messagesAround returns the target message with the messages on either side of it
+func (u *messageUsecase) messagesAround(ctx context.Context, connectionID uuid.UUID, around string, limit int) (*models.MessagePage, error) {
+	messageID, err := uuid.Parse(around)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message ID")
+	}
+
+	target, err := u.messageRepo.GetMessageWithUsers(ctx, messageID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, err
+	}
+	if target.ConnectionID != connectionID || target.IsDeleted {
+		return nil, fmt.Errorf("message not found")
+	}
+
+	cursor := connections.CursorOf(target)
+	olderLimit := (limit - 1) / 2
+	newerLimit := limit - 1 - olderLimit
+
+	older, hasOlder, err := u.olderMessages(ctx, connectionID, &cursor, olderLimit)
+	if err != nil {
+		return nil, err
+	}
+	newer, hasNewer, err := u.newerMessages(ctx, connectionID, cursor, newerLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := make([]*ent.Message, 0, len(newer)+1+len(older))
+	page = append(page, newer...)
+	page = append(page, target)
+	page = append(page, older...)
+
+	return newMessagePage(page, hasOlder, hasNewer), nil
+}
+
+// olderMessages returns up to limit messages before the cursor, newest first
+func (u *messageUsecase) olderMessages(ctx context.Context, connectionID uuid.UUID, cursor *connections.MessageCursor, limit int) ([]*ent.Message, bool, error) {
+	if limit <= 0 {
+		return nil, cursor != nil, nil
+	}
+
+	// Fetch one extra message to know whether more follow
+	messages, err := u.messageRepo.GetMessagesBefore(ctx, connectionID, cursor, limit+1)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get connection messages: %w", err)
+	}
+
+	hasMore := len(messages) > limit
+	if hasMore {
+		messages = messages[:limit]
+	}
+	return messages, hasMore, nil
+}
+
+// newerMessages returns up to limit messages after the cursor, newest first
+func (u *messageUsecase) newerMessages(ctx context.Context, connectionID uuid.UUID, cursor connections.MessageCursor, limit int) ([]*ent.Message, bool, error) {
+	if limit <= 0 {
+		return nil, true, nil
+	}
+
+	messages, err := u.messageRepo.GetMessagesAfter(ctx, connectionID, cursor, limit+1)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get connection messages: %w", err)
+	}
+
+	hasMore := len(messages) > limit
+	if hasMore {
+		messages = messages[:limit]
+	}
+
+	// The repository returns them oldest first
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+	return messages, hasMore, nil
+}
+
+// newMessagePage builds a page from messages ordered newest first
+func newMessagePage(messages []*ent.Message, hasOlder, hasNewer bool) *models.MessagePage {
+	page := &models.MessagePage{
+		Messages: models.ToMessages(messages),
+		HasOlder: hasOlder,
+		HasNewer: hasNewer,
+	}
+	if page.Messages == nil {
+		page.Messages = []*models.Message{}
+	}
+
+	if len(messages) > 0 {
+		page.AfterCursor = encodeCursor(connections.CursorOf(messages[0]))
+		page.BeforeCursor = encodeCursor(connections.CursorOf(messages[len(messages)-1]))
+	}
+	return page
+}
+
+// decodeMessageCursor parses a history cursor returned in a previous page
+func decodeMessageCursor(cursor string) (*connections.MessageCursor, error) {
+	var c connections.MessageCursor
+	if err := decodeCursor(cursor, &c); err != nil || c.ID == uuid.Nil || c.CreatedAt.IsZero() {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &c, nil
+}
@@ -0,0 +1,110 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+	"match-me/ent"
+	"match-me/internal/models"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// maxEmojiLength is the longest emoji sequence accepted, in bytes
+const maxEmojiLength = 32
+
+func (u *messageUsecase) AddReaction(ctx context.Context, userID, messageID uuid.UUID, emoji string) ([]*models.ReactionSummary, error) {
+	emoji, err := normalizeEmoji(emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	entMessage, err := u.getReactableMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.messageRepo.AddReaction(ctx, messageID, userID, emoji); err != nil {
+		return nil, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	return u.reactionsChanged(ctx, entMessage, userID, emoji, "added")
+}
+
+func (u *messageUsecase) RemoveReaction(ctx context.Context, userID, messageID uuid.UUID, emoji string) ([]*models.ReactionSummary, error) {
+	emoji, err := normalizeEmoji(emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	entMessage, err := u.getReactableMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := u.messageRepo.RemoveReaction(ctx, messageID, userID, emoji)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove reaction: %w", err)
+	}
+	if !removed {
+		return nil, fmt.Errorf("reaction not found")
+	}
+
+	return u.reactionsChanged(ctx, entMessage, userID, emoji, "removed")
+}
+
+// getReactableMessage returns a message the user can react to
+func (u *messageUsecase) getReactableMessage(ctx context.Context, userID, messageID uuid.UUID) (*ent.Message, error) {
+	entMessage, err := u.messageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, err
+	}
+
+	// Both participants of the connection may react
+	if _, err := u.validateConnectionAccess(ctx, userID, entMessage.ConnectionID); err != nil {
+		return nil, err
+	}
+
+	if entMessage.IsDeleted {
+		return nil, fmt.Errorf("message has been deleted")
+	}
+
+	return entMessage, nil
+}
+
+// reactionsChanged returns the message's current reactions and broadcasts the change
+func (u *messageUsecase) reactionsChanged(ctx context.Context, entMessage *ent.Message, userID uuid.UUID, emoji, action string) ([]*models.ReactionSummary, error) {
+	reactions, err := u.messageRepo.GetMessageReactions(ctx, entMessage.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message reactions: %w", err)
+	}
+
+	summaries := models.ToReactionSummaries(reactions)
+
+	// Broadcast the reaction via WebSocket
+	if u.wsService != nil {
+		go u.wsService.BroadcastMessageReaction(entMessage.ConnectionID, entMessage.ID, userID, emoji, action, summaries)
+	}
+
+	return summaries, nil
+}
+
+// normalizeEmoji trims the emoji and rejects anything that is clearly not one
+func normalizeEmoji(emoji string) (string, error) {
+	emoji = strings.TrimSpace(emoji)
+	if emoji == "" || len(emoji) > maxEmojiLength {
+		return "", fmt.Errorf("invalid emoji")
+	}
+
+	for _, r := range emoji {
+		if unicode.IsLetter(r) || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return "", fmt.Errorf("invalid emoji")
+		}
+	}
+
+	return emoji, nil
+}
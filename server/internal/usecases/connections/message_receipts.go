@@ -0,0 +1,124 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"match-me/ent"
+	"match-me/internal/models"
+	"match-me/internal/repositories/connections"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (u *messageUsecase) MarkMessagesAsRead(ctx context.Context, userID, connectionID uuid.UUID, upToMessageID *uuid.UUID) ([]uuid.UUID, error) {
+	// Verify the connection exists and user is part of it
+	otherUserID, err := u.validateConnectionAccess(ctx, userID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without an explicit message everything up to the latest message is read
+	var target *ent.Message
+	if upToMessageID == nil {
+		target, err = u.messageRepo.GetLatestMessage(ctx, connectionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest message: %w", err)
+		}
+		if target == nil {
+			return []uuid.UUID{}, nil
+		}
+	} else {
+		target, err = u.messageRepo.GetMessage(ctx, *upToMessageID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("message not found")
+			}
+			return nil, fmt.Errorf("failed to get message: %w", err)
+		}
+		if target.ConnectionID != connectionID {
+			return nil, fmt.Errorf("message not found")
+		}
+	}
+
+	readMessages, err := u.messageRepo.MarkMessagesReadUpTo(ctx, connectionID, userID, connections.CursorOf(target))
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark messages as read: %w", err)
+	}
+
+	messageIDs := make([]uuid.UUID, len(readMessages))
+	for i, msg := range readMessages {
+		messageIDs[i] = msg.ID
+	}
+
+	// Broadcast read status via WebSocket
+	if u.wsService != nil && len(messageIDs) > 0 {
+		go u.wsService.BroadcastMessagesRead(connectionID, otherUserID, userID, messageIDs, target.ID, readMessages[0].ReadAt)
+	}
+
+	return messageIDs, nil
+}
+
+func (u *messageUsecase) GetReadMarkers(ctx context.Context, userID, connectionID uuid.UUID) ([]*models.ReadMarker, error) {
+	// Verify the connection exists and user is part of it
+	if _, err := u.validateConnectionAccess(ctx, userID, connectionID); err != nil {
+		return nil, err
+	}
+
+	markers, err := u.messageRepo.GetReadMarkers(ctx, connectionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get read markers: %w", err)
+	}
+
+	return models.ToReadMarkers(markers), nil
+}
+
+// DeliverPendingMessages marks every message still waiting for the user as delivered once
+// one of their clients connects, and tells the senders. The client loads the messages
+// themselves through the message history.
+func (u *messageUsecase) DeliverPendingMessages(ctx context.Context, userID uuid.UUID) (int, error) {
+	delivered, err := u.messageRepo.MarkPendingMessagesDelivered(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark pending messages as delivered: %w", err)
+	}
+
+	if u.wsService != nil {
+		u.broadcastDelivered(delivered)
+	}
+
+	return len(delivered), nil
+}
+
+// markDelivered records that a freshly sent message reached one of the receiver's clients
+func (u *messageUsecase) markDelivered(msg *models.Message) {
+	delivered, err := u.messageRepo.MarkMessagesDelivered(context.Background(), []uuid.UUID{msg.ID})
+	if err != nil {
+		log.Printf("Warning: Failed to mark message %s as delivered: %v", msg.ID, err)
+		return
+	}
+
+	u.broadcastDelivered(delivered)
+}
+
+// broadcastDelivered sends one delivery event per connection to the senders of the messages
+func (u *messageUsecase) broadcastDelivered(messages []*ent.Message) {
+	byConnection := make(map[uuid.UUID][]*ent.Message)
+	for _, msg := range messages {
+		byConnection[msg.ConnectionID] = append(byConnection[msg.ConnectionID], msg)
+	}
+
+	for connectionID, msgs := range byConnection {
+		messageIDs := make([]uuid.UUID, len(msgs))
+		for i, msg := range msgs {
+			messageIDs[i] = msg.ID
+		}
+
+		deliveredAt := msgs[0].DeliveredAt
+		if deliveredAt.IsZero() {
+			deliveredAt = time.Now()
+		}
+
+		u.wsService.BroadcastMessagesDelivered(connectionID, msgs[0].SenderID, msgs[0].ReceiverID, messageIDs, deliveredAt)
+	}
+}
@@ -0,0 +1,62 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+	"match-me/ent"
+	"match-me/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// getReplyTarget returns the message being replied to, or nil when the message is not a reply
+func (u *messageUsecase) getReplyTarget(ctx context.Context, connectionID uuid.UUID, replyToID *uuid.UUID) (*ent.Message, error) {
+	if replyToID == nil {
+		return nil, nil
+	}
+
+	target, err := u.messageRepo.GetMessage(ctx, *replyToID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("reply target not found")
+		}
+		return nil, fmt.Errorf("failed to get reply target: %w", err)
+	}
+
+	if target.ConnectionID != connectionID {
+		return nil, fmt.Errorf("reply target is not in this connection")
+	}
+
+	if target.IsDeleted {
+		return nil, fmt.Errorf("cannot reply to a deleted message")
+	}
+
+	return target, nil
+}
+
+func (u *messageUsecase) GetMessageReplies(ctx context.Context, userID, messageID uuid.UUID) ([]*models.Message, error) {
+	entMessage, err := u.messageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, err
+	}
+
+	// Both participants of the connection may see the thread
+	if _, err := u.validateConnectionAccess(ctx, userID, entMessage.ConnectionID); err != nil {
+		return nil, err
+	}
+
+	replies, err := u.messageRepo.GetMessageReplies(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message replies: %w", err)
+	}
+
+	// Every reply quotes the same message
+	for _, reply := range replies {
+		reply.Edges.ReplyTo = entMessage
+	}
+
+	return models.ToMessages(replies), nil
+}
@@ -0,0 +1,110 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"match-me/ent"
+	"match-me/internal/models"
+	"match-me/internal/repositories/connections"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 50
+)
+
+func (u *messageUsecase) SearchMessages(ctx context.Context, userID uuid.UUID, connectionID *uuid.UUID, query, cursor string, limit int) (*models.MessageSearchPage, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("search query cannot be empty")
+	}
+
+	// Searching one connection requires being part of it
+	if connectionID != nil {
+		connection, err := u.connectionRepo.GetConnection(ctx, *connectionID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("connection not found")
+			}
+			return nil, fmt.Errorf("failed to get connection: %w", err)
+		}
+		if connection.UserAID != userID && connection.UserBID != userID {
+			return nil, fmt.Errorf("unauthorized: user is not part of this connection")
+		}
+	}
+
+	var after *connections.MessageSearchCursor
+	if cursor != "" {
+		decoded, err := decodeSearchCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = decoded
+	}
+
+	if limit <= 0 {
+		limit = defaultSearchPageSize
+	}
+	if limit > maxSearchPageSize {
+		limit = maxSearchPageSize
+	}
+
+	// Fetch one extra hit to know whether another page follows
+	hits, err := u.messageRepo.SearchMessages(ctx, userID, connectionID, query, after, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+
+	hasMore := len(hits) > limit
+	if hasMore {
+		hits = hits[:limit]
+	}
+
+	results := make([]*models.MessageSearchResult, len(hits))
+	for i, hit := range hits {
+		results[i] = &models.MessageSearchResult{
+			Message: models.ToMessage(hit.Message),
+			Rank:    hit.Rank,
+			Snippet: highlightSnippet(hit.Snippet),
+		}
+	}
+
+	page := &models.MessageSearchPage{
+		Results: results,
+		HasMore: hasMore,
+	}
+	if hasMore && len(hits) > 0 {
+		page.NextCursor = encodeSearchCursor(hits[len(hits)-1])
+	}
+
+	return page, nil
+}
+
+// highlightSnippet escapes a search snippet and marks the matched terms with <mark>
+func highlightSnippet(snippet string) string {
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, connections.SnippetStartSel, "<mark>")
+	return strings.ReplaceAll(snippet, connections.SnippetStopSel, "</mark>")
+}
+
+// encodeSearchCursor returns an opaque cursor pointing after the given hit
+func encodeSearchCursor(hit *connections.MessageSearchHit) string {
+	return encodeCursor(connections.MessageSearchCursor{
+		Rank:      hit.Rank,
+		CreatedAt: hit.Message.CreatedAt,
+		ID:        hit.Message.ID,
+	})
+}
+
+// decodeSearchCursor parses a cursor returned by encodeSearchCursor
+func decodeSearchCursor(cursor string) (*connections.MessageSearchCursor, error) {
+	var c connections.MessageSearchCursor
+	if err := decodeCursor(cursor, &c); err != nil || c.ID == uuid.Nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &c, nil
+}
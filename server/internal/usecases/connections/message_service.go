@@ -3,59 +3,102 @@ package connections
 import (
 	"context"
 	"fmt"
+	"io"
+	"log"
+	"match-me/config"
 	"match-me/ent"
+	"match-me/ent/message"
 	"match-me/internal/models"
-	"match-me/internal/pkg/cloudinary"
+	"match-me/internal/pkg/storage"
 	"match-me/internal/repositories/connections"
 	"match-me/internal/websocket"
+	"time"
+	"unicode/utf8"
 
-	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/google/uuid"
 )
 
+// maxClientMessageIDLength is the longest idempotency key a client may send
+const maxClientMessageIDLength = 64
+
+// maxMessageLength is the longest text message accepted, in characters. It also keeps
+// message events small enough to fan out to the other server nodes.
+const maxMessageLength = 4000
+
 type messageUsecase struct {
 	messageRepo    connections.MessageRepository
 	connectionRepo connections.ConnectionRepository
-	cld            cloudinary.Cloudinary
+	media          storage.MediaStore
 	wsService      *websocket.WebSocketService
+	editWindow     time.Duration
 }
 
 func NewMessageUsecase(
 	messageRepo connections.MessageRepository,
 	connectionRepo connections.ConnectionRepository,
-	cld cloudinary.Cloudinary,
+	media storage.MediaStore,
 	wsService *websocket.WebSocketService,
+	cfg *config.Config,
 ) MessageUsecase {
 	return &messageUsecase{
 		messageRepo:    messageRepo,
 		connectionRepo: connectionRepo,
-		cld:            cld,
+		media:          media,
 		wsService:      wsService,
+		editWindow:     cfg.MessageEditWindow,
 	}
 }
 
-func (u *messageUsecase) SendTextMessage(ctx context.Context, senderID uuid.UUID, connectionID uuid.UUID, content string) (*models.Message, error) {
+func (u *messageUsecase) SendTextMessage(ctx context.Context, senderID uuid.UUID, connectionID uuid.UUID, content string, replyToID *uuid.UUID, clientMessageID string) (*models.Message, error) {
 	// Verify the connection exists and user is part of it
 	receiverID, err := u.validateConnectionAccess(ctx, senderID, connectionID)
 	if err != nil {
 		return nil, err
 	}
 
+	// A resend of a message that was already stored returns the stored message
+	existing, err := u.findResentMessage(ctx, senderID, connectionID, clientMessageID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return models.ToMessage(existing), nil
+	}
+
 	// Validate content
 	if content == "" {
 		return nil, fmt.Errorf("message content cannot be empty")
 	}
+	if utf8.RuneCountInString(content) > maxMessageLength {
+		return nil, fmt.Errorf("message content is too long")
+	}
+
+	replyTo, err := u.getReplyTarget(ctx, connectionID, replyToID)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create the message
-	entMessage, err := u.messageRepo.CreateTextMessage(ctx, connectionID, senderID, receiverID, content)
+	entMessage, err := u.messageRepo.CreateTextMessage(ctx, connectionID, senderID, receiverID, content, replyToID, clientMessageID)
 	if err != nil {
+		// A concurrent resend may have stored the message first
+		if clientMessageID != "" && ent.IsConstraintError(err) {
+			existing, findErr := u.findResentMessage(ctx, senderID, connectionID, clientMessageID)
+			if findErr == nil && existing != nil {
+				return models.ToMessage(existing), nil
+			}
+		}
 		return nil, fmt.Errorf("failed to create text message: %w", err)
 	}
+	entMessage.Edges.ReplyTo = replyTo
 
 	message := models.ToMessage(entMessage)
 
 	// Broadcast the new message via WebSocket
 	if u.wsService != nil {
+		// Sending a message shows the sender is active, whichever way it was sent
+		u.wsService.MarkActive(senderID)
+
 		go func(msg *models.Message) {
 			defer func() {
 				if r := recover(); r != nil {
@@ -64,7 +107,9 @@ func (u *messageUsecase) SendTextMessage(ctx context.Context, senderID uuid.UUID
 			}()
 
 			fmt.Printf("🔄 Starting BroadcastNewMessage goroutine for message ID: %s\n", msg.ID)
-			u.wsService.BroadcastNewMessage(msg)
+			if u.wsService.BroadcastNewMessage(msg) {
+				u.markDelivered(msg)
+			}
 			fmt.Printf("✅ Completed BroadcastNewMessage goroutine for message ID: %s\n", msg.ID)
 		}(message)
 	}
@@ -72,37 +117,47 @@ func (u *messageUsecase) SendTextMessage(ctx context.Context, senderID uuid.UUID
 	return message, nil
 }
 
-func (u *messageUsecase) SendMediaMessage(ctx context.Context, senderID uuid.UUID, connectionID uuid.UUID, mediaFile interface{}, txtContent string) (*models.Message, error) {
+func (u *messageUsecase) SendMediaMessage(ctx context.Context, senderID uuid.UUID, connectionID uuid.UUID, mediaFile io.Reader, txtContent string, replyToID *uuid.UUID) (*models.Message, error) {
 	// Verify the connection exists and user is part of it
 	receiverID, err := u.validateConnectionAccess(ctx, senderID, connectionID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Upload image to Cloudinary
-	uploadParams := uploader.UploadParams{
-		Folder:   fmt.Sprintf("%s_media_photo", connectionID.String()),
-		PublicID: fmt.Sprintf("message_%s_photo", senderID.String()),
+	// Validate the reply before uploading anything
+	replyTo, err := u.getReplyTarget(ctx, connectionID, replyToID)
+	if err != nil {
+		return nil, err
 	}
 
-	mediaURL, publicID, err := u.cld.UploadImage(mediaFile, uploadParams)
-	if err != nil {
-		return nil, fmt.Errorf("failed to upload media to Cloudinary: %w", err)
+	// Upload media to the media store
+	uploadOpts := storage.UploadOptions{
+		Folder: connectionMediaFolder(connectionID),
+		Name:   fmt.Sprintf("message_%s_%s", senderID.String(), uuid.NewString()),
 	}
 
-	// Set mediaType (assuming only images for now)
-	mediaType := "image/jpeg"
+	obj, err := u.media.Upload(ctx, mediaFile, uploadOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload media: %w", err)
+	}
 
 	// Create the message
-	entMessage, err := u.messageRepo.CreateMediaMessage(ctx, connectionID, senderID, receiverID, mediaURL, mediaType, publicID, txtContent)
+	entMessage, err := u.messageRepo.CreateMediaMessage(ctx, connectionID, senderID, receiverID, obj.Ref, obj.ContentType, obj.Key, txtContent, replyToID)
 	if err != nil {
+		_ = u.media.Delete(context.Background(), obj.Key)
 		return nil, fmt.Errorf("failed to create media message: %w", err)
 	}
+	entMessage.Edges.ReplyTo = replyTo
 
+	// Return a usable URL rather than the stored reference
+	entMessage.MediaURL = obj.URL
 	message := models.ToMessage(entMessage)
 
 	// Broadcast the new message via WebSocket
 	if u.wsService != nil {
+		// Sending a message shows the sender is active, whichever way it was sent
+		u.wsService.MarkActive(senderID)
+
 		go func(msg *models.Message) {
 			defer func() {
 				if r := recover(); r != nil {
@@ -111,7 +166,9 @@ func (u *messageUsecase) SendMediaMessage(ctx context.Context, senderID uuid.UUI
 			}()
 
 			fmt.Printf("🔄 Starting BroadcastNewMessage goroutine for message ID: %s\n", msg.ID)
-			u.wsService.BroadcastNewMessage(msg)
+			if u.wsService.BroadcastNewMessage(msg) {
+				u.markDelivered(msg)
+			}
 			fmt.Printf("✅ Completed BroadcastNewMessage goroutine for message ID: %s\n", msg.ID)
 		}(message)
 	}
@@ -119,50 +176,120 @@ func (u *messageUsecase) SendMediaMessage(ctx context.Context, senderID uuid.UUI
 	return message, nil
 }
 
-func (u *messageUsecase) GetConnectionMessages(ctx context.Context, userID, connectionID uuid.UUID, limit, offset int) ([]*models.Message, error) {
-	// Verify the connection exists and user is part of it
-	_, err := u.validateConnectionAccess(ctx, userID, connectionID)
+func (u *messageUsecase) EditMessage(ctx context.Context, userID, messageID uuid.UUID, content string) (*models.Message, error) {
+	entMessage, err := u.getOwnMessage(ctx, userID, messageID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Set default limit if not provided
-	if limit <= 0 {
-		limit = 50
+	// Validate content
+	if content == "" {
+		return nil, fmt.Errorf("message content cannot be empty")
+	}
+	if utf8.RuneCountInString(content) > maxMessageLength {
+		return nil, fmt.Errorf("message content is too long")
+	}
+
+	if entMessage.Type == message.TypeMedia {
+		return nil, fmt.Errorf("media messages cannot be edited")
 	}
 
-	// Get messages with user details
-	entMessages, err := u.messageRepo.GetConnectionMessagesWithUsers(ctx, connectionID, limit, offset)
+	if time.Since(entMessage.CreatedAt) > u.editWindow {
+		return nil, fmt.Errorf("edit window has expired")
+	}
+
+	// Nothing to record when the content did not change
+	if entMessage.Content == content {
+		return models.ToMessage(entMessage), nil
+	}
+
+	updated, err := u.messageRepo.EditMessage(ctx, messageID, content)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get connection messages: %w", err)
+		if err.Error() == "message has been deleted" {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to edit message: %w", err)
+	}
+
+	edited := models.ToMessage(updated)
+
+	// Broadcast the edit via WebSocket
+	if u.wsService != nil {
+		go u.wsService.BroadcastMessageEdited(edited)
 	}
 
-	return models.ToMessages(entMessages), nil
+	return edited, nil
 }
 
-func (u *messageUsecase) MarkMessagesAsRead(ctx context.Context, userID, connectionID uuid.UUID) error {
-	// Verify the connection exists and user is part of it
-	_, err := u.validateConnectionAccess(ctx, userID, connectionID)
+func (u *messageUsecase) DeleteMessage(ctx context.Context, userID, messageID uuid.UUID) error {
+	entMessage, err := u.getOwnMessage(ctx, userID, messageID)
 	if err != nil {
 		return err
 	}
 
-	// Mark all unread messages in this connection as read for this user
-	count, err := u.messageRepo.MarkConnectionMessagesAsRead(ctx, connectionID, userID)
+	deleted, err := u.messageRepo.SoftDeleteMessage(ctx, messageID)
 	if err != nil {
-		return fmt.Errorf("failed to mark messages as read: %w", err)
+		if err.Error() == "message has been deleted" {
+			return err
+		}
+		return fmt.Errorf("failed to delete message: %w", err)
 	}
 
-	// Broadcast read status via WebSocket
-	if u.wsService != nil && count > 0 {
-		go func(connID, usrID uuid.UUID, cnt int) {
-			u.wsService.BroadcastConnectionMessagesRead(connID, usrID, cnt)
-		}(connectionID, userID, count)
+	// Unsent media is removed from storage as well, unless another message still uses it.
+	// Media sent before every upload got its own key can be shared.
+	if entMessage.MediaPublicID != "" {
+		go u.deleteUnusedMedia(entMessage.MediaPublicID)
+	}
+
+	// Broadcast the deletion via WebSocket
+	if u.wsService != nil {
+		go u.wsService.BroadcastMessageDeleted(models.ToMessage(deleted))
 	}
 
 	return nil
 }
 
+// deleteUnusedMedia removes a stored media object once no message references it
+func (u *messageUsecase) deleteUnusedMedia(key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	count, err := u.messageRepo.CountMediaReferences(ctx, key)
+	if err != nil {
+		log.Printf("Warning: Failed to check references of media %s, keeping it: %v", key, err)
+		return
+	}
+	if count > 0 {
+		return
+	}
+
+	if err := u.media.Delete(ctx, key); err != nil {
+		log.Printf("Warning: Failed to delete media for unsent message: %v", err)
+	}
+}
+
+func (u *messageUsecase) GetMessageEdits(ctx context.Context, userID, messageID uuid.UUID) ([]*models.MessageEdit, error) {
+	entMessage, err := u.messageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, err
+	}
+
+	// Both participants of the connection may see the history
+	if _, err := u.validateConnectionAccess(ctx, userID, entMessage.ConnectionID); err != nil {
+		return nil, err
+	}
+
+	edits, err := u.messageRepo.GetMessageEdits(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message edits: %w", err)
+	}
+
+	return models.ToMessageEdits(edits), nil
+}
+
 func (u *messageUsecase) GetUnreadCount(ctx context.Context, userID uuid.UUID) (int, error) {
 	count, err := u.messageRepo.GetUnreadMessagesCount(ctx, userID)
 	if err != nil {
@@ -195,9 +322,9 @@ func (u *messageUsecase) GetChatList(ctx context.Context, userID uuid.UUID) (*mo
 		// Determine the other user in the connection
 		var otherUser *models.User
 		if entConnection.UserAID == userID {
-			otherUser = models.ToUser(entConnection.Edges.UserB, models.AccessLevelBasic)
+			otherUser = models.WithLastSeen(models.ToUser(entConnection.Edges.UserB, models.AccessLevelBasic), entConnection.Edges.UserB)
 		} else {
-			otherUser = models.ToUser(entConnection.Edges.UserA, models.AccessLevelBasic)
+			otherUser = models.WithLastSeen(models.ToUser(entConnection.Edges.UserA, models.AccessLevelBasic), entConnection.Edges.UserA)
 		}
 
 		if otherUser == nil {
@@ -205,13 +332,14 @@ func (u *messageUsecase) GetChatList(ctx context.Context, userID uuid.UUID) (*mo
 			continue
 		}
 
-		// Get the latest message for this connection
-		latestMessages, err := u.messageRepo.GetConnectionMessages(ctx, entConnection.ID, 1, 0)
+		// Get the latest message for this connection; unsent messages are
+		// kept as the preview with their content removed
+		latestMessage, err := u.messageRepo.GetLatestMessage(ctx, entConnection.ID)
 		var lastMessage *models.Message
 		var lastActivity string
 
-		if err == nil && len(latestMessages) > 0 {
-			lastMessage = models.ToMessage(latestMessages[0])
+		if err == nil && latestMessage != nil {
+			lastMessage = models.ToMessage(latestMessage)
 			lastActivity = lastMessage.CreatedAt
 		} else {
 			// If no messages, use connection created time
@@ -257,6 +385,64 @@ func (u *messageUsecase) GetChatList(ctx context.Context, userID uuid.UUID) (*mo
 	}, nil
 }
 
+// getOwnMessage loads a message the user sent in an active connection and that is not unsent
+func (u *messageUsecase) getOwnMessage(ctx context.Context, userID, messageID uuid.UUID) (*ent.Message, error) {
+	entMessage, err := u.messageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, err
+	}
+
+	if entMessage.SenderID != userID {
+		return nil, fmt.Errorf("unauthorized: user is not the sender of this message")
+	}
+
+	if entMessage.IsDeleted {
+		return nil, fmt.Errorf("message has been deleted")
+	}
+
+	if _, err := u.validateConnectionAccess(ctx, userID, entMessage.ConnectionID); err != nil {
+		return nil, err
+	}
+
+	return entMessage, nil
+}
+
+// findResentMessage returns the message the sender already stored under the idempotency key,
+// or nil when the key is unused
+func (u *messageUsecase) findResentMessage(ctx context.Context, senderID, connectionID uuid.UUID, clientMessageID string) (*ent.Message, error) {
+	if clientMessageID == "" {
+		return nil, nil
+	}
+	if len(clientMessageID) > maxClientMessageIDLength {
+		return nil, fmt.Errorf("client message ID is too long")
+	}
+
+	existing, err := u.messageRepo.GetMessageByClientID(ctx, senderID, clientMessageID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to check for resent message: %w", err)
+	}
+
+	// Keys are unique per sender, so a match elsewhere is a client bug rather than a resend
+	if existing.ConnectionID != connectionID {
+		return nil, fmt.Errorf("client message ID already used")
+	}
+
+	return existing, nil
+}
+
+// CheckConnectionAccess returns an error unless the user is part of the active connection.
+// Sockets use it so they apply the same rules as the HTTP endpoints.
+func (u *messageUsecase) CheckConnectionAccess(ctx context.Context, userID, connectionID uuid.UUID) error {
+	_, err := u.validateConnectionAccess(ctx, userID, connectionID)
+	return err
+}
+
 // validateConnectionAccess verifies that the connection exists, is active, and the user is part of it
 // Returns the ID of the other user in the connection
 func (u *messageUsecase) validateConnectionAccess(ctx context.Context, userID, connectionID uuid.UUID) (uuid.UUID, error) {
@@ -3,11 +3,15 @@ package connections
 import (
 	"context"
 	"fmt"
+	"match-me/config"
 	"match-me/ent"
+	"match-me/ent/connectionrequest"
 	"match-me/internal/models"
 	"match-me/internal/repositories/connections"
+	"match-me/internal/repositories/user"
 	"match-me/internal/usecases/interactions"
 	"match-me/internal/websocket"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -17,6 +21,9 @@ type connectionRequestUsecase struct {
 	connectionRepo   connections.ConnectionRepository
 	interactionUC    interactions.UserInteractionUsecase
 	wsService        *websocket.WebSocketService
+	userRepo         user.UserRepository
+	requestTTL       time.Duration
+	maxRequestTTL    time.Duration
 }
 
 func NewConnectionRequestUsecase(
@@ -24,21 +31,50 @@ func NewConnectionRequestUsecase(
 	connectionRepo connections.ConnectionRepository,
 	interactionUC interactions.UserInteractionUsecase,
 	wsService *websocket.WebSocketService,
+	userRepo user.UserRepository,
+	cfg *config.Config,
 ) ConnectionRequestUsecase {
 	return &connectionRequestUsecase{
 		requestRepo:      requestRepo,
 		connectionRepo:   connectionRepo,
 		interactionUC:    interactionUC,
 		wsService:        wsService,
+		userRepo:         userRepo,
+		requestTTL:       cfg.ConnectionRequestTTL,
+		maxRequestTTL:    cfg.ConnectionRequestMaxTTL,
 	}
 }
 
-func (u *connectionRequestUsecase) SendRequest(ctx context.Context, senderID, receiverID uuid.UUID, message string) (*models.ConnectionRequest, error) {
+func (u *connectionRequestUsecase) SendRequest(ctx context.Context, senderID, receiverID uuid.UUID, message string, expiresAt *time.Time) (*models.ConnectionRequest, error) {
 	// Validate that users are not the same
 	if senderID == receiverID {
 		return nil, fmt.Errorf("cannot send connection request to yourself")
 	}
 
+	// Senders may pick their own expiry, within the configured maximum
+	now := time.Now()
+	expiry := now.Add(u.requestTTL)
+	if expiresAt != nil {
+		if !expiresAt.After(now) || expiresAt.After(now.Add(u.maxRequestTTL)) {
+			return nil, fmt.Errorf("invalid expiry time")
+		}
+		expiry = *expiresAt
+	}
+
+	// Only verified users can send or receive connection requests
+	sender, err := u.userRepo.GetByID(ctx, senderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender: %w", err)
+	}
+	if sender.EmailVerifiedAt.IsZero() {
+		return nil, fmt.Errorf("email address not verified")
+	}
+
+	receiver, err := u.userRepo.GetByID(ctx, receiverID)
+	if err != nil || receiver.EmailVerifiedAt.IsZero() {
+		return nil, fmt.Errorf("receiver not found")
+	}
+
 	// Check if connection already exists
 	existingConnection, err := u.connectionRepo.GetConnectionBetweenUsers(ctx, senderID, receiverID)
 	if err != nil {
@@ -54,11 +90,17 @@ func (u *connectionRequestUsecase) SendRequest(ctx context.Context, senderID, re
 		return nil, fmt.Errorf("failed to check existing request: %w", err)
 	}
 	if existingRequest != nil {
-		return nil, fmt.Errorf("connection request already exists")
+		// An expired request does not block sending a new one
+		if !isExpired(existingRequest, now) {
+			return nil, fmt.Errorf("connection request already exists")
+		}
+		if err := u.requestRepo.DeleteConnectionRequest(ctx, existingRequest.ID); err != nil {
+			return nil, fmt.Errorf("failed to remove expired request: %w", err)
+		}
 	}
 
 	// Create the request
-	entRequest, err := u.requestRepo.CreateConnectionRequest(ctx, senderID, receiverID, message)
+	entRequest, err := u.requestRepo.CreateConnectionRequest(ctx, senderID, receiverID, message, expiry)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection request: %w", err)
 	}
@@ -101,6 +143,9 @@ func (u *connectionRequestUsecase) AcceptRequest(ctx context.Context, userID, re
 	if request.Status != "pending" {
 		return nil, fmt.Errorf("request is no longer pending")
 	}
+	if isExpired(request, time.Now()) {
+		return nil, fmt.Errorf("request has expired")
+	}
 
 	// Accept the request (this creates the connection)
 	updatedRequest, entConnection, err := u.requestRepo.AcceptRequest(ctx, requestID)
@@ -138,6 +183,9 @@ func (u *connectionRequestUsecase) DeclineRequest(ctx context.Context, userID, r
 	if request.Status != "pending" {
 		return fmt.Errorf("request is no longer pending")
 	}
+	if isExpired(request, time.Now()) {
+		return fmt.Errorf("request has expired")
+	}
 
 	// Decline the request
 	updatedRequest, err := u.requestRepo.DeclineRequest(ctx, requestID)
@@ -161,4 +209,126 @@ func (u *connectionRequestUsecase) DeclineRequest(ctx context.Context, userID, r
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// WithdrawRequest lets the sender take back a request the receiver has not answered yet
+func (u *connectionRequestUsecase) WithdrawRequest(ctx context.Context, userID, requestID uuid.UUID) error {
+	request, err := u.requestRepo.GetConnectionRequest(ctx, requestID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("connection request not found")
+		}
+		return fmt.Errorf("failed to get connection request: %w", err)
+	}
+
+	// Verify that the user is the sender of this request
+	if request.SenderID != userID {
+		return fmt.Errorf("unauthorized: user is not the sender of this request")
+	}
+
+	// The delete only matches pending requests, so a concurrent accept wins
+	if err := u.requestRepo.WithdrawRequest(ctx, requestID, userID); err != nil {
+		return err
+	}
+
+	if u.wsService != nil {
+		u.wsService.BroadcastConnectionWithdrawn(models.ToConnectionRequest(request))
+	}
+
+	return nil
+}
+
+// ExpireRequests expires every pending request past its expiry and notifies both parties
+func (u *connectionRequestUsecase) ExpireRequests(ctx context.Context) (int, error) {
+	expired, err := u.requestRepo.ExpireOldRequests(ctx, u.requestTTL)
+	if err != nil {
+		return 0, err
+	}
+
+	if u.wsService != nil {
+		for _, request := range expired {
+			u.wsService.BroadcastConnectionExpired(models.ToConnectionRequest(request))
+		}
+	}
+
+	return len(expired), nil
+}
+
+// isExpired reports whether a request has passed its expiry time
+func isExpired(request *ent.ConnectionRequest, now time.Time) bool {
+	if request.Status == connectionrequest.StatusExpired {
+		return true
+	}
+	return !request.ExpiresAt.IsZero() && !request.ExpiresAt.After(now)
+}
+
+// Swipe actions
+const (
+	SwipeLike      = "like"
+	SwipeSuperLike = "super_like"
+	SwipePass      = "pass"
+)
+
+func (u *connectionRequestUsecase) Swipe(ctx context.Context, userID, targetUserID uuid.UUID, action string) (*models.SwipeResult, error) {
+	// Validate that users are not the same
+	if userID == targetUserID {
+		return nil, fmt.Errorf("cannot swipe on yourself")
+	}
+
+	if u.interactionUC == nil {
+		return nil, fmt.Errorf("interactions are not available")
+	}
+
+	// Only verified users can like and be liked
+	swiper, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if swiper.EmailVerifiedAt.IsZero() {
+		return nil, fmt.Errorf("email address not verified")
+	}
+
+	target, err := u.userRepo.GetByID(ctx, targetUserID)
+	if err != nil || target.EmailVerifiedAt.IsZero() {
+		return nil, fmt.Errorf("target user not found")
+	}
+
+	result := &models.SwipeResult{Action: action}
+
+	switch action {
+	case SwipePass:
+		if err := u.interactionUC.RecordSkippedProfile(ctx, userID, targetUserID); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case SwipeLike, SwipeSuperLike:
+		// Liking someone you are already connected to has no effect
+		existingConnection, err := u.connectionRepo.GetConnectionBetweenUsers(ctx, userID, targetUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check existing connection: %w", err)
+		}
+		if existingConnection != nil {
+			return nil, fmt.Errorf("connection already exists between users")
+		}
+
+		connection, err := u.interactionUC.RecordLike(ctx, userID, targetUserID, action == SwipeSuperLike)
+		if err != nil {
+			return nil, err
+		}
+
+		// Both users liked each other, so the connection was created
+		if connection != nil {
+			result.Matched = true
+			result.Connection = connection
+
+			if u.wsService != nil {
+				u.wsService.BroadcastMatch(connection)
+			}
+		}
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("invalid swipe action")
+	}
+}
@@ -0,0 +1,20 @@
+package events
+
+import (
+	"context"
+	"match-me/internal/models"
+	"match-me/internal/requests"
+
+	"github.com/google/uuid"
+)
+
+// EventUsecase handles business logic for local events and RSVPs
+type EventUsecase interface {
+	CreateEvent(ctx context.Context, userID uuid.UUID, body requests.CreateEventBody) (*models.Event, error)
+	GetEvent(ctx context.Context, userID, eventID uuid.UUID) (*models.Event, error)
+	GetUserEvents(ctx context.Context, userID uuid.UUID) ([]*models.Event, error)
+	GetNearbyEvents(ctx context.Context, userID uuid.UUID, query requests.NearbyEventsQuery) ([]*models.Event, error)
+	UpdateEvent(ctx context.Context, userID, eventID uuid.UUID, body requests.UpdateEventBody) (*models.Event, error)
+	CancelEvent(ctx context.Context, userID, eventID uuid.UUID) error
+	RSVP(ctx context.Context, userID, eventID uuid.UUID, status string) (*models.Event, error)
+}
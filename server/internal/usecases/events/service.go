@@ -0,0 +1,218 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"match-me/ent"
+	"match-me/ent/schema"
+	"match-me/internal/models"
+	"match-me/internal/pkg/matching"
+	"match-me/internal/repositories/events"
+	"match-me/internal/repositories/user"
+	"match-me/internal/requests"
+	"match-me/internal/websocket"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultRadiusKm is the search radius for users without a preferred distance
+	defaultRadiusKm = 25
+
+	// defaultNearbyLimit is the number of nearby events returned when no limit is given
+	defaultNearbyLimit = 20
+)
+
+type eventUsecase struct {
+	eventRepo events.EventRepository
+	userRepo  user.UserRepository
+	wsService *websocket.WebSocketService
+}
+
+func NewEventUsecase(
+	eventRepo events.EventRepository,
+	userRepo user.UserRepository,
+	wsService *websocket.WebSocketService,
+) EventUsecase {
+	return &eventUsecase{
+		eventRepo: eventRepo,
+		userRepo:  userRepo,
+		wsService: wsService,
+	}
+}
+
+func (u *eventUsecase) CreateEvent(ctx context.Context, userID uuid.UUID, body requests.CreateEventBody) (*models.Event, error) {
+	if !body.StartTime.After(time.Now()) {
+		return nil, fmt.Errorf("start time must be in the future")
+	}
+	if body.EndTime != nil && !body.EndTime.After(body.StartTime) {
+		return nil, fmt.Errorf("end time must be after start time")
+	}
+
+	entEvent, err := u.eventRepo.CreateEvent(ctx, userID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.ToEvent(entEvent, userID)
+	confirmed := "confirmed"
+	event.MyRSVP = &confirmed
+	return event, nil
+}
+
+func (u *eventUsecase) GetEvent(ctx context.Context, userID, eventID uuid.UUID) (*models.Event, error) {
+	entEvent, err := u.eventRepo.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.ToEventDetail(entEvent, userID), nil
+}
+
+func (u *eventUsecase) GetUserEvents(ctx context.Context, userID uuid.UUID) ([]*models.Event, error) {
+	entEvents, err := u.eventRepo.GetUserEvents(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return models.ToEvents(entEvents, userID), nil
+}
+
+// GetNearbyEvents finds upcoming events around the given location, or around the user
+// within their preferred distance when no location is given
+func (u *eventUsecase) GetNearbyEvents(ctx context.Context, userID uuid.UUID, query requests.NearbyEventsQuery) ([]*models.Event, error) {
+	var center *schema.Point
+	radiusKm := query.RadiusKm
+
+	if query.Lat != nil && query.Lng != nil {
+		center = &schema.Point{Longitude: *query.Lng, Latitude: *query.Lat}
+	}
+
+	if center == nil || radiusKm == 0 {
+		currentUser, err := u.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		if center == nil {
+			center = currentUser.Coordinates
+		}
+		if radiusKm == 0 {
+			radiusKm = currentUser.PreferredDistance
+		}
+	}
+
+	if center == nil {
+		return nil, fmt.Errorf("location is required")
+	}
+	if radiusKm <= 0 {
+		radiusKm = defaultRadiusKm
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultNearbyLimit
+	}
+
+	entEvents, err := u.eventRepo.GetNearbyEvents(ctx, userID, center, float64(radiusKm)*1000, time.Now(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	events := models.ToEvents(entEvents, userID)
+	for _, event := range events {
+		if event.Location != nil {
+			distance := math.Round(matching.Distance(center, event.Location)*10) / 10
+			event.Distance = &distance
+		}
+	}
+	return events, nil
+}
+
+// UpdateEvent changes an event and tells its participants
+func (u *eventUsecase) UpdateEvent(ctx context.Context, userID, eventID uuid.UUID, body requests.UpdateEventBody) (*models.Event, error) {
+	if err := u.verifyCreator(ctx, userID, eventID); err != nil {
+		return nil, err
+	}
+
+	if body.StartTime != nil && !body.StartTime.After(time.Now()) {
+		return nil, fmt.Errorf("start time must be in the future")
+	}
+
+	entEvent, err := u.eventRepo.UpdateEvent(ctx, eventID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.ToEvent(entEvent, userID)
+	u.notifyParticipants(ctx, event, "updated", userID)
+	return event, nil
+}
+
+// CancelEvent cancels an event and tells its participants
+func (u *eventUsecase) CancelEvent(ctx context.Context, userID, eventID uuid.UUID) error {
+	if err := u.verifyCreator(ctx, userID, eventID); err != nil {
+		return err
+	}
+
+	entEvent, err := u.eventRepo.CancelEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	u.notifyParticipants(ctx, models.ToEvent(entEvent, userID), "cancelled", userID)
+	return nil
+}
+
+// RSVP records a user's response to an event and tells the creator
+func (u *eventUsecase) RSVP(ctx context.Context, userID, eventID uuid.UUID, status string) (*models.Event, error) {
+	entEvent, err := u.eventRepo.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if entEvent.CreatorID == userID {
+		return nil, fmt.Errorf("event creator cannot change their RSVP")
+	}
+
+	entEvent, entParticipant, err := u.eventRepo.SetRSVP(ctx, eventID, userID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	entEvent.Edges.Participants = []*ent.EventParticipant{entParticipant}
+	event := models.ToEvent(entEvent, userID)
+
+	if u.wsService != nil {
+		u.wsService.BroadcastEventRSVP(event, models.ToEventParticipant(entParticipant))
+	}
+	return event, nil
+}
+
+// verifyCreator checks that the user created the event
+func (u *eventUsecase) verifyCreator(ctx context.Context, userID, eventID uuid.UUID) error {
+	entEvent, err := u.eventRepo.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if entEvent.CreatorID != userID {
+		return fmt.Errorf("unauthorized: only the event creator can change the event")
+	}
+	return nil
+}
+
+// notifyParticipants sends an event change to everyone taking part except the user who made it
+func (u *eventUsecase) notifyParticipants(ctx context.Context, event *models.Event, action string, changedBy uuid.UUID) {
+	if u.wsService == nil {
+		return
+	}
+
+	participantIDs, err := u.eventRepo.GetParticipantIDs(ctx, event.ID)
+	if err != nil {
+		log.Printf("Failed to notify participants of event %s: %v", event.ID, err)
+		return
+	}
+
+	u.wsService.BroadcastEventChanged(event, action, participantIDs, changedBy)
+}
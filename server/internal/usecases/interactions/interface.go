@@ -18,6 +18,9 @@ type UserInteractionUsecase interface {
 	// Record when a user deletes a connection
 	RecordDeletedConnection(ctx context.Context, userID, targetUserID uuid.UUID) error
 
+	// Record a like or super like, returning the new connection when the like is mutual
+	RecordLike(ctx context.Context, userID, targetUserID uuid.UUID, superLike bool) (*models.Connection, error)
+
 	// Get filtered user IDs that should be excluded from recommendations
 	GetExcludedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 
@@ -15,6 +15,7 @@ const (
 	SkippedProfileExpiration    = 30 * 24 * time.Hour  // 30 days
 	DeclinedRequestExpiration   = 90 * 24 * time.Hour  // 90 days
 	DeletedConnectionExpiration = 365 * 24 * time.Hour // 1 year
+	LikeExpiration              = 90 * 24 * time.Hour  // 90 days
 )
 
 // Interaction type constants
@@ -22,6 +23,8 @@ const (
 	InteractionTypeDeclinedRequest   = "declined_request"
 	InteractionTypeSkippedProfile    = "skipped_profile"
 	InteractionTypeDeletedConnection = "deleted_connection"
+	InteractionTypeLiked             = "liked"
+	InteractionTypeSuperLiked        = "super_liked"
 )
 
 type userInteractionUsecase struct {
@@ -76,11 +79,35 @@ func (u *userInteractionUsecase) RecordDeletedConnection(ctx context.Context, us
 	return nil
 }
 
+func (u *userInteractionUsecase) RecordLike(ctx context.Context, userID, targetUserID uuid.UUID, superLike bool) (*models.Connection, error) {
+	interactionType := InteractionTypeLiked
+	if superLike {
+		interactionType = InteractionTypeSuperLiked
+	}
+	expiresAt := time.Now().Add(LikeExpiration)
+
+	_, entConnection, err := u.interactionRepo.RecordLike(ctx, userID, targetUserID, interactionType, &expiresAt)
+	if err != nil {
+		if err.Error() == "user already liked" {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to record like: %w", err)
+	}
+
+	if entConnection == nil {
+		return nil, nil
+	}
+
+	return models.ToConnection(entConnection), nil
+}
+
 func (u *userInteractionUsecase) GetExcludedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
 	interactionTypes := []string{
 		InteractionTypeDeclinedRequest,
 		InteractionTypeSkippedProfile,
 		InteractionTypeDeletedConnection,
+		InteractionTypeLiked,
+		InteractionTypeSuperLiked,
 	}
 
 	excludedIDs, err := u.interactionRepo.GetInteractedUserIDs(ctx, userID, interactionTypes)
@@ -97,6 +124,8 @@ func (u *userInteractionUsecase) ShouldExcludeFromRecommendations(ctx context.Co
 		InteractionTypeDeclinedRequest,
 		InteractionTypeSkippedProfile,
 		InteractionTypeDeletedConnection,
+		InteractionTypeLiked,
+		InteractionTypeSuperLiked,
 	}
 
 	for _, interactionType := range interactionTypes {
@@ -148,6 +177,10 @@ func (u *userInteractionUsecase) GetInteractionStats(ctx context.Context, userID
 			stats.SkippedProfiles++
 		case InteractionTypeDeletedConnection:
 			stats.DeletedConnections++
+		case InteractionTypeLiked:
+			stats.Likes++
+		case InteractionTypeSuperLiked:
+			stats.SuperLikes++
 		}
 		stats.TotalInteractions++
 	}
@@ -2,24 +2,48 @@ package user
 
 import (
 	"context"
+	"io"
 	"match-me/internal/models"
+	"match-me/internal/repositories/session"
 	"match-me/internal/requests"
 
 	"github.com/google/uuid"
 )
 
 type UserUsecase interface {
-	Register(ctx context.Context, req requests.RegisterUser) (*models.User, string, error)
-	Login(ctx context.Context, email, password string) (*models.User, string, error)
-	UpdatePassword(ctx context.Context, userID uuid.UUID, newPassword string) error
+	Register(ctx context.Context, req requests.RegisterUser, meta session.SessionMeta) (*models.User, *models.AuthTokens, error)
+	Login(ctx context.Context, email, password string, meta session.SessionMeta) (*models.User, *models.AuthTokens, error)
+	UpdatePassword(ctx context.Context, userID uuid.UUID, currentPassword, newPassword string) error
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, userID uuid.UUID) error
 	UpdateUser(ctx context.Context, id uuid.UUID, req *requests.UpdateUser) (*models.User, error)
 
 	GetUserByID(ctx context.Context, userID uuid.UUID, accessLevel models.AccessLevel) (*models.User, error)
 	DeleteUser(ctx context.Context, userID uuid.UUID) error
-	UploadUserPhotos(ctx context.Context, userID uuid.UUID, files []interface{}) ([]*models.UserPhoto, error)
+	UploadUserPhotos(ctx context.Context, userID uuid.UUID, files []io.Reader) ([]*models.UserPhoto, error)
+	ReplaceUserPhoto(ctx context.Context, userID, photoID uuid.UUID, file io.Reader) (*models.UserPhoto, error)
+	ReorderUserPhotos(ctx context.Context, userID uuid.UUID, photoIDs []uuid.UUID) ([]*models.UserPhoto, error)
+	SetPrimaryPhoto(ctx context.Context, userID, photoID uuid.UUID) ([]*models.UserPhoto, error)
 	DeleteUserPhoto(ctx context.Context, userID, photoID uuid.UUID) error
 
-	GetRecommendations(ctx context.Context, userID uuid.UUID) ([]string, error)
+	// Session management
+	RefreshSession(ctx context.Context, refreshToken string, meta session.SessionMeta) (*models.AuthTokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+	ValidateSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	GetUserSessions(ctx context.Context, userID, currentSessionID uuid.UUID) ([]*models.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+
+	GetRecommendations(ctx context.Context, userID uuid.UUID) ([]*models.Recommendation, error)
+	GetRecommendationFeed(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*models.RecommendationPage, error)
 	SkipRecommendation(ctx context.Context, userID, targetUserID uuid.UUID) error
 	GetDistanceBetweenUsers(ctx context.Context, userAID, userBID uuid.UUID) (float64, error)
+
+	// Presence
+	RecordActivity(ctx context.Context, userIDs []uuid.UUID) error
+	RecordDisconnect(ctx context.Context, userID uuid.UUID) error
+	GetStatuses(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]*models.UserStatus, error)
+	GetStatus(ctx context.Context, userID uuid.UUID) (*models.UserStatus, error)
+	UpdateStatus(ctx context.Context, userID uuid.UUID, req requests.UserStatus) (*models.UserStatus, error)
 }
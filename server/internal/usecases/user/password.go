@@ -0,0 +1,95 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+
+	"match-me/internal/pkg/jwt"
+	"match-me/internal/pkg/mailer"
+
+	"github.com/google/uuid"
+)
+
+func (u *userUsecase) UpdatePassword(ctx context.Context, userID uuid.UUID, currentPassword, newPassword string) error {
+	entUser, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	// Require the current password before changing it
+	if _, err := u.userRepo.Authenticate(ctx, entUser.Email, currentPassword); err != nil {
+		return errors.New("current password is incorrect")
+	}
+
+	// Update password in repository
+	return u.userRepo.UpdatePassword(ctx, userID, newPassword)
+}
+
+func (u *userUsecase) ForgotPassword(ctx context.Context, email string) error {
+	// Unknown emails are ignored so the endpoint does not reveal registered addresses
+	entUser, err := u.userRepo.GetUserByEmail(ctx, email)
+	if err != nil || entUser == nil {
+		log.Printf("password reset requested for unknown email")
+		return nil
+	}
+
+	// Bind the token to the current password hash: it stops working once the password changes
+	token, err := jwt.GenerateJWTTokenWithClaims(entUser.ID, u.jwtSecret, jwt.PurposePasswordReset, u.resetTokenTTL, map[string]any{
+		jwt.ClaimPasswordFingerprint: jwt.HashToken(entUser.PasswordHash),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", u.frontendURL, url.QueryEscape(token))
+	msg := mailer.Message{
+		To:      entUser.Email,
+		Subject: "Reset your Match-Me password",
+		Body: fmt.Sprintf("Hi %s,\n\n"+
+			"We received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\n"+
+			"The link expires in %s and can only be used once. If you did not request a reset, you can ignore this email.\n",
+			entUser.FirstName, link, u.resetTokenTTL),
+	}
+
+	if err := u.mailer.Send(ctx, msg); err != nil {
+		return fmt.Errorf("failed to send reset email: %w", err)
+	}
+
+	return nil
+}
+
+func (u *userUsecase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	userID, claims, err := jwt.ParseJwtToken(ctx, token, jwt.PurposePasswordReset, u.jwtSecret)
+	if err != nil {
+		return errors.New("invalid or expired reset token")
+	}
+
+	fingerprint, ok := claims[jwt.ClaimPasswordFingerprint].(string)
+	if !ok {
+		return errors.New("invalid or expired reset token")
+	}
+
+	entUser, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("invalid or expired reset token")
+	}
+
+	// The password changed since the token was issued (already used or updated otherwise)
+	if jwt.HashToken(entUser.PasswordHash) != fingerprint {
+		return errors.New("reset token has already been used")
+	}
+
+	if err := u.userRepo.ResetPassword(ctx, userID, entUser.PasswordHash, newPassword); err != nil {
+		return err
+	}
+
+	// Sign out everywhere after a reset
+	if _, err := u.sessionRepo.RevokeUserSessions(ctx, userID, RevokeReasonPassword); err != nil {
+		log.Printf("failed to revoke sessions after password reset for user %s: %v", userID, err)
+	}
+
+	return nil
+}
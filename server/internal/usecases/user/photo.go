@@ -0,0 +1,202 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"match-me/ent"
+	"match-me/internal/models"
+	"match-me/internal/pkg/imaging"
+	"match-me/internal/pkg/storage"
+	"match-me/internal/requests"
+
+	"github.com/google/uuid"
+)
+
+// processPhoto reads an uploaded photo (up to the size limit) and renders its variants
+func (u *userUsecase) processPhoto(file io.Reader) ([]imaging.Variant, error) {
+	reader := file
+	if u.photoOpts.MaxBytes > 0 {
+		reader = io.LimitReader(file, u.photoOpts.MaxBytes+1)
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+
+	return imaging.Process(data, u.photoOpts, imaging.DefaultVariants)
+}
+
+// storePhoto uploads every variant of a photo and returns the photo fields to persist
+// along with the storage keys written. Nothing is left behind when an upload fails.
+func (u *userUsecase) storePhoto(ctx context.Context, userID uuid.UUID, variants []imaging.Variant) (*requests.UserPhoto, []string, error) {
+	photo := &requests.UserPhoto{}
+	var keys []string
+
+	// Every stored photo gets its own name, so a new upload never shares an object with an existing photo
+	name := fmt.Sprintf("user_%s_photo_%s", userID.String(), uuid.New().String())
+
+	for _, variant := range variants {
+		obj, err := u.media.Upload(ctx, bytes.NewReader(variant.Data), storage.UploadOptions{
+			Folder:      fmt.Sprintf("user-photos/%s", userID.String()),
+			Name:        name + "_" + variant.Name,
+			ContentType: variant.ContentType,
+		})
+		if err != nil {
+			u.deleteMedia(keys)
+			return nil, nil, err
+		}
+		keys = append(keys, obj.Key)
+
+		switch variant.Name {
+		case imaging.VariantThumbnail:
+			photo.ThumbnailUrl, photo.ThumbnailPID = obj.Ref, obj.Key
+		case imaging.VariantMedium:
+			photo.MediumUrl, photo.MediumPID = obj.Ref, obj.Key
+		case imaging.VariantFull:
+			photo.PhotoUrl, photo.PID = obj.Ref, obj.Key
+		}
+	}
+
+	return photo, keys, nil
+}
+
+func (u *userUsecase) ReplaceUserPhoto(ctx context.Context, userID, photoID uuid.UUID, file io.Reader) (*models.UserPhoto, error) {
+	// Check if user exists
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	// Find the photo being replaced to clean up its media afterwards
+	var oldPhoto *ent.UserPhoto
+	for _, photo := range user.Edges.Photos {
+		if photo.ID == photoID {
+			oldPhoto = photo
+			break
+		}
+	}
+
+	if oldPhoto == nil {
+		return nil, fmt.Errorf("photo not found for user")
+	}
+
+	variants, err := u.processPhoto(file)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+
+	photoRequest, keys, err := u.storePhoto(ctx, userID, variants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload image: %w", err)
+	}
+
+	// Swap the stored media in place, keeping the photo's ID and position
+	entPhoto, err := u.userRepo.ReplacePhoto(ctx, photoID, userID, *photoRequest)
+	if err != nil {
+		u.deleteMedia(keys)
+		return nil, err
+	}
+
+	go u.deleteUnusedMedia(photoKeys(oldPhoto))
+
+	return u.toUserPhoto(entPhoto), nil
+}
+
+func (u *userUsecase) ReorderUserPhotos(ctx context.Context, userID uuid.UUID, photoIDs []uuid.UUID) ([]*models.UserPhoto, error) {
+	entPhotos, err := u.userRepo.ReorderPhotos(ctx, userID, photoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.toUserPhotos(entPhotos), nil
+}
+
+// SetPrimaryPhoto moves a photo to the first position, which is used as the profile photo.
+// The remaining photos keep their relative order.
+func (u *userUsecase) SetPrimaryPhoto(ctx context.Context, userID, photoID uuid.UUID) ([]*models.UserPhoto, error) {
+	// Check if user exists
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	photoIDs := []uuid.UUID{photoID}
+	found := false
+	for _, photo := range user.Edges.Photos {
+		if photo.ID == photoID {
+			found = true
+			continue
+		}
+		photoIDs = append(photoIDs, photo.ID)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("photo not found for user")
+	}
+
+	return u.ReorderUserPhotos(ctx, userID, photoIDs)
+}
+
+// toUserPhoto converts a stored photo to its model, resolving media references
+func (u *userUsecase) toUserPhoto(photo *ent.UserPhoto) *models.UserPhoto {
+	photo.PhotoURL = storage.ResolveURL(u.media, photo.PhotoURL)
+	photo.MediumURL = storage.ResolveURL(u.media, photo.MediumURL)
+	photo.ThumbnailURL = storage.ResolveURL(u.media, photo.ThumbnailURL)
+	return models.ToUserPhoto(photo)
+}
+
+func (u *userUsecase) toUserPhotos(photos []*ent.UserPhoto) []*models.UserPhoto {
+	result := make([]*models.UserPhoto, len(photos))
+	for i, photo := range photos {
+		result[i] = u.toUserPhoto(photo)
+	}
+	return result
+}
+
+// photoKeys lists the storage keys of every variant of a photo
+func photoKeys(photo *ent.UserPhoto) []string {
+	keys := []string{photo.PublicID}
+	if photo.MediumPublicID != "" {
+		keys = append(keys, photo.MediumPublicID)
+	}
+	if photo.ThumbnailPublicID != "" {
+		keys = append(keys, photo.ThumbnailPublicID)
+	}
+	return keys
+}
+
+// deleteUnusedMedia removes the objects of a removed photo that no other photo still uses.
+// Photos stored before every upload got its own key can share objects.
+func (u *userUsecase) deleteUnusedMedia(keys []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	referenced, err := u.userRepo.GetReferencedPhotoKeys(ctx, keys)
+	if err != nil {
+		log.Printf("Warning: Failed to check media references, keeping media: %v", err)
+		return
+	}
+
+	unused := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if !referenced[key] {
+			unused = append(unused, key)
+		}
+	}
+	u.deleteMedia(unused)
+}
+
+// deleteMedia removes stored media objects, logging failures since the database is the source of truth
+func (u *userUsecase) deleteMedia(keys []string) {
+	for _, key := range keys {
+		if err := u.media.Delete(context.Background(), key); err != nil {
+			log.Printf("Warning: Failed to delete media (key: %s): %v", key, err)
+		}
+	}
+}
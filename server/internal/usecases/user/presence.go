@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"match-me/internal/models"
+	"match-me/internal/requests"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// RecordActivity refreshes the last-seen time of online users, at most once per LAST_SEEN_INTERVAL
+func (u *userUsecase) RecordActivity(ctx context.Context, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	if _, err := u.userRepo.MarkLastSeen(ctx, userIDs, u.lastSeenGap); err != nil {
+		return fmt.Errorf("failed to record activity: %w", err)
+	}
+	return nil
+}
+
+// RecordDisconnect stores the moment a user went offline as their last-seen time
+func (u *userUsecase) RecordDisconnect(ctx context.Context, userID uuid.UUID) error {
+	if _, err := u.userRepo.MarkLastSeen(ctx, []uuid.UUID{userID}, 0); err != nil {
+		return fmt.Errorf("failed to record disconnect: %w", err)
+	}
+	return nil
+}
+
+// GetStatuses returns the stored status of the given users. Users who were not found are left out.
+func (u *userUsecase) GetStatuses(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]*models.UserStatus, error) {
+	statuses := make(map[uuid.UUID]*models.UserStatus, len(userIDs))
+	if len(userIDs) == 0 {
+		return statuses, nil
+	}
+
+	users, err := u.userRepo.GetStatuses(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statuses: %w", err)
+	}
+
+	for _, entUser := range users {
+		statuses[entUser.ID] = models.ToUserStatus(entUser)
+	}
+	return statuses, nil
+}
+
+// GetStatus returns the status mode and status message of a user
+func (u *userUsecase) GetStatus(ctx context.Context, userID uuid.UUID) (*models.UserStatus, error) {
+	entUser, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return models.ToUserStatus(entUser), nil
+}
+
+// UpdateStatus changes the status mode and status message of a user and shows
+// the change to their connections
+func (u *userUsecase) UpdateStatus(ctx context.Context, userID uuid.UUID, req requests.UserStatus) (*models.UserStatus, error) {
+	if req.StatusMessage != nil {
+		message := strings.TrimSpace(*req.StatusMessage)
+		req.StatusMessage = &message
+	}
+
+	entUser, err := u.userRepo.UpdateStatus(ctx, userID, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	status := models.ToUserStatus(entUser)
+	if u.wsService != nil {
+		u.wsService.UpdateUserStatus(status)
+	}
+	return status, nil
+}
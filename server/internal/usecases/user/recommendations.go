@@ -0,0 +1,136 @@
+package user
+
+import (
+	"context"
+	"log"
+
+	"match-me/internal/models"
+	"match-me/internal/pkg/matching"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// recommendationsLimit is the number of results returned by GetRecommendations
+	recommendationsLimit = 10
+
+	// maxFeedPageSize caps the page size a client may request
+	maxFeedPageSize = 50
+)
+
+func (u *userUsecase) GetRecommendations(ctx context.Context, userID uuid.UUID) ([]*models.Recommendation, error) {
+	page, err := u.GetRecommendationFeed(ctx, userID, "", recommendationsLimit)
+	if err != nil {
+		return []*models.Recommendation{}, err
+	}
+
+	return page.Recommendations, nil
+}
+
+// GetRecommendationFeed returns one page of the user's ranked recommendations.
+// An empty cursor starts from the best match; limit falls back to the configured page size.
+func (u *userUsecase) GetRecommendationFeed(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*models.RecommendationPage, error) {
+	var after *matching.Cursor
+	if cursor != "" {
+		var err error
+		after, err = matching.DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if limit <= 0 {
+		limit = u.feedPageSize
+	}
+	limit = min(limit, maxFeedPageSize)
+
+	rankings, err := u.rankedFeed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, hasMore := matching.Page(rankings, after, limit)
+
+	page := &models.RecommendationPage{
+		Recommendations: make([]*models.Recommendation, len(results)),
+		HasMore:         hasMore,
+	}
+	for i, result := range results {
+		page.Recommendations[i] = models.ToRecommendation(result)
+	}
+	if hasMore {
+		page.NextCursor = matching.EncodeCursor(results[len(results)-1])
+	}
+
+	return page, nil
+}
+
+// rankedFeed returns all recommendable users ranked best first, served from cache when possible
+func (u *userUsecase) rankedFeed(ctx context.Context, userID uuid.UUID) ([]matching.Result, error) {
+	if rankings, ok := u.feedCache.Get(userID); ok {
+		return rankings, nil
+	}
+
+	// Fetch users by user preference
+	preferredUsers, currentUser, err := u.userRepo.GetUsersByPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := u.excludedUserIDs(ctx, userID)
+
+	candidates := preferredUsers[:0]
+	for _, candidate := range preferredUsers {
+		if _, skip := excluded[candidate.ID]; !skip {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	// Score candidates, best first
+	rankings := matching.Rank(u.scorer, currentUser, candidates)
+	u.feedCache.Set(userID, rankings)
+
+	return rankings, nil
+}
+
+// excludedUserIDs collects the users that must not be recommended: existing connections,
+// pending requests in either direction and excluding interactions. Each set is loaded
+// with a single query; failures are only logged so the feed degrades gracefully.
+func (u *userUsecase) excludedUserIDs(ctx context.Context, userID uuid.UUID) map[uuid.UUID]struct{} {
+	excluded := make(map[uuid.UUID]struct{})
+
+	// Fetch existing connections
+	connections, err := u.connRepo.GetUserConnections(ctx, userID)
+	if err != nil {
+		log.Printf("failed to get user connections: %v", err) // only log err
+	}
+	for _, conn := range connections {
+		if conn.UserAID == userID {
+			excluded[conn.UserBID] = struct{}{}
+		} else if conn.UserBID == userID {
+			excluded[conn.UserAID] = struct{}{}
+		}
+	}
+
+	// Fetch pending requests in either direction
+	pendingIDs, err := u.connReqRepo.GetPendingRequestUserIDs(ctx, userID)
+	if err != nil {
+		log.Printf("failed to get pending request user IDs: %v", err) // only log err
+	}
+	for _, id := range pendingIDs {
+		excluded[id] = struct{}{}
+	}
+
+	// Fetch excluded user IDs based on interactions
+	if u.interactionUC != nil {
+		excludedIDs, err := u.interactionUC.GetExcludedUserIDs(ctx, userID)
+		if err != nil {
+			log.Printf("failed to get excluded user IDs: %v", err) // only log err
+		}
+		for _, id := range excludedIDs {
+			excluded[id] = struct{}{}
+		}
+	}
+
+	return excluded
+}
@@ -4,90 +4,131 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
-	"sort"
 	"time"
 
+	"match-me/config"
 	"match-me/ent"
 	"match-me/internal/models"
-	"match-me/internal/pkg/cloudinary"
-	"match-me/internal/pkg/jwt"
+	"match-me/internal/pkg/imaging"
+	"match-me/internal/pkg/mailer"
+	"match-me/internal/pkg/matching"
+	"match-me/internal/pkg/storage"
 	"match-me/internal/repositories/connections"
+	"match-me/internal/repositories/session"
 	"match-me/internal/repositories/user"
 	"match-me/internal/requests"
 	"match-me/internal/usecases/interactions"
+	"match-me/internal/websocket"
 
-	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/google/uuid"
 )
 
 type userUsecase struct {
-	userRepo      user.UserRepository
-	jwtSecret     string
-	cld           cloudinary.Cloudinary
-	connRepo      connections.ConnectionRepository
-	connReqRepo   connections.ConnectionRequestRepository
-	interactionUC interactions.UserInteractionUsecase
+	userRepo        user.UserRepository
+	sessionRepo     session.SessionRepository
+	jwtSecret       string
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	resetTokenTTL   time.Duration
+	verifyTokenTTL  time.Duration
+	verifyResendGap time.Duration
+	frontendURL     string
+	mailer          mailer.Mailer
+	photoOpts       imaging.Options
+	maxPhotos       int
+	scorer          matching.Scorer
+	feedCache       *matching.FeedCache
+	feedPageSize    int
+	lastSeenGap     time.Duration
+	media           storage.MediaStore
+	connRepo        connections.ConnectionRepository
+	connReqRepo     connections.ConnectionRequestRepository
+	interactionUC   interactions.UserInteractionUsecase
+	wsService       *websocket.WebSocketService
 }
 
 func NewUserUsecase(userRepo user.UserRepository,
+	sessionRepo session.SessionRepository,
 	connRepo connections.ConnectionRepository,
 	connReqRepo connections.ConnectionRequestRepository,
 	interactionUC interactions.UserInteractionUsecase,
-	jwtSecret string, cld cloudinary.Cloudinary) UserUsecase {
+	cfg *config.Config, media storage.MediaStore, mail mailer.Mailer, feedCache *matching.FeedCache,
+	wsService *websocket.WebSocketService) UserUsecase {
 	return &userUsecase{
-		userRepo:      userRepo,
-		connRepo:      connRepo,
-		connReqRepo:   connReqRepo,
-		interactionUC: interactionUC,
-		jwtSecret:     jwtSecret,
-		cld:           cld,
+		userRepo:        userRepo,
+		sessionRepo:     sessionRepo,
+		connRepo:        connRepo,
+		connReqRepo:     connReqRepo,
+		interactionUC:   interactionUC,
+		wsService:       wsService,
+		jwtSecret:       cfg.JWTSecret,
+		accessTokenTTL:  cfg.AccessTokenTTL,
+		refreshTokenTTL: cfg.RefreshTokenTTL,
+		resetTokenTTL:   cfg.ResetTokenTTL,
+		verifyTokenTTL:  cfg.VerifyTokenTTL,
+		verifyResendGap: cfg.VerifyResendGap,
+		frontendURL:     cfg.FrontendURL,
+		mailer:          mail,
+		media:           media,
+		maxPhotos:       cfg.MaxPhotosPerUser,
+		scorer:          matching.NewScorer(matching.DefaultWeights),
+		feedCache:       feedCache,
+		feedPageSize:    cfg.RecommendationPageSize,
+		lastSeenGap:     cfg.LastSeenInterval,
+		photoOpts: imaging.Options{
+			MaxBytes:     cfg.PhotoMaxBytes,
+			MaxDimension: cfg.PhotoMaxDimension,
+			MaxPixels:    cfg.PhotoMaxPixels,
+			MinDimension: cfg.PhotoMinDimension,
+		},
 	}
 }
 
-func (u *userUsecase) Register(ctx context.Context, req requests.RegisterUser) (*models.User, string, error) {
+func (u *userUsecase) Register(ctx context.Context, req requests.RegisterUser, meta session.SessionMeta) (*models.User, *models.AuthTokens, error) {
 	// Check if user already exists
 	existingUser, err := u.userRepo.GetUserByEmail(ctx, req.Email)
 	if err == nil && existingUser != nil {
-		return nil, "", errors.New("user with this email already exists")
+		return nil, nil, errors.New("user with this email already exists")
 	}
 
 	// Create user
 	entUser, err := u.userRepo.CreateUser(ctx, req)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to create user: %w", err)
+		return nil, nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	// Generate JWT token
-	token, err := jwt.GenerateJWTToken(entUser.ID, u.jwtSecret, jwt.PurposeLogin, 24*time.Hour)
+	// Send verification email, registration succeeds even if delivery fails
+	if err := u.sendVerificationEmail(ctx, entUser); err != nil {
+		log.Printf("failed to send verification email to user %s: %v", entUser.ID, err)
+	}
+
+	// Start a session and generate tokens
+	tokens, err := u.createSession(ctx, entUser.ID, meta)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to generate token: %w", err)
+		return nil, nil, err
 	}
 
 	user := models.ToUser(entUser, models.AccessLevelFull)
-	return user, token, nil
+	return user, tokens, nil
 }
 
-func (u *userUsecase) Login(ctx context.Context, email, password string) (*models.User, string, error) {
+func (u *userUsecase) Login(ctx context.Context, email, password string, meta session.SessionMeta) (*models.User, *models.AuthTokens, error) {
 	// Authenticate user
 	entUser, err := u.userRepo.Authenticate(ctx, email, password)
 	if err != nil {
-		return nil, "", fmt.Errorf("authentication failed: incorrect email or password")
+		return nil, nil, fmt.Errorf("authentication failed: incorrect email or password")
 	}
 
-	// Generate JWT token
-	token, err := jwt.GenerateJWTToken(entUser.ID, u.jwtSecret, jwt.PurposeLogin, 24*time.Hour)
+	// Start a session and generate tokens
+	tokens, err := u.createSession(ctx, entUser.ID, meta)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to generate token: %w", err)
+		return nil, nil, err
 	}
 
 	user := models.ToUser(entUser, models.AccessLevelFull)
-	return user, token, nil
-}
-
-func (u *userUsecase) UpdatePassword(ctx context.Context, userID uuid.UUID, newPassword string) error {
-	// Update password in repository
-	return u.userRepo.UpdatePassword(ctx, userID, newPassword)
+	return user, tokens, nil
 }
 
 func (u *userUsecase) UpdateUser(ctx context.Context, id uuid.UUID, req *requests.UpdateUser) (*models.User, error) {
@@ -133,9 +174,9 @@ func (u *userUsecase) DeleteUser(ctx context.Context, userID uuid.UUID) error {
 	return nil
 }
 
-func (u *userUsecase) UploadUserPhotos(ctx context.Context, userID uuid.UUID, files []interface{}) ([]*models.UserPhoto, error) {
+func (u *userUsecase) UploadUserPhotos(ctx context.Context, userID uuid.UUID, files []io.Reader) ([]*models.UserPhoto, error) {
 	// Check if user exists
-	_, err := u.userRepo.GetByID(ctx, userID)
+	user, err := u.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
@@ -144,61 +185,46 @@ func (u *userUsecase) UploadUserPhotos(ctx context.Context, userID uuid.UUID, fi
 		return []*models.UserPhoto{}, nil
 	}
 
-	var uploadedPhotos []*models.UserPhoto
-	var uploadedPublicIDs []string
+	// Reject uploads that cannot fit before doing any processing
+	if u.maxPhotos > 0 && len(user.Edges.Photos)+len(files) > u.maxPhotos {
+		return nil, fmt.Errorf("photo limit reached")
+	}
 
-	// Upload all files
+	// Validate and process every image before storing any of them
+	processed := make([][]imaging.Variant, len(files))
 	for i, file := range files {
-		order := i + 1 // Start ordering from 1
-
-		// Upload image to Cloudinary
-		uploadParams := uploader.UploadParams{
-			Folder:   "user-photos",
-			PublicID: fmt.Sprintf("user_%s_photo_%d", userID.String(), order),
-		}
-
-		photoURL, publicID, err := u.cld.UploadImage(file, uploadParams)
+		variants, err := u.processPhoto(file)
 		if err != nil {
-			// Rollback: delete all previously uploaded images
-			for _, pid := range uploadedPublicIDs {
-				_ = u.cld.DeleteImage(pid)
-			}
-			return nil, fmt.Errorf("failed to upload image %d: %w", order, err)
-		}
-
-		uploadedPublicIDs = append(uploadedPublicIDs, publicID)
-
-		// Create photo request
-		photoRequest := requests.UserPhoto{
-			PhotoUrl: photoURL,
-			PID:      publicID,
-			Order:    order,
+			return nil, fmt.Errorf("invalid image %d: %w", i+1, err)
 		}
+		processed[i] = variants
+	}
 
-		// Generate photo ID
-		photoID := uuid.New()
+	photoRequests := make([]requests.UserPhoto, len(processed))
+	var uploadedKeys []string
 
-		// Add photo to repository
-		entPhoto, err := u.userRepo.AddPhoto(ctx, photoID, userID, photoRequest)
+	// Upload image variants to media store
+	for i, variants := range processed {
+		photoRequest, keys, err := u.storePhoto(ctx, userID, variants)
 		if err != nil {
-			// Rollback: delete all uploaded images
-			for _, pid := range uploadedPublicIDs {
-				_ = u.cld.DeleteImage(pid)
-			}
-			return nil, fmt.Errorf("failed to save photo %d: %w", order, err)
+			// Rollback: delete all previously uploaded images
+			u.deleteMedia(uploadedKeys)
+			return nil, fmt.Errorf("failed to upload image %d: %w", i+1, err)
 		}
 
-		// Convert to model
-		userPhoto := &models.UserPhoto{
-			ID:       entPhoto.ID,
-			PhotoURL: entPhoto.PhotoURL,
-			Order:    entPhoto.Order,
-		}
+		uploadedKeys = append(uploadedKeys, keys...)
+		photoRequests[i] = *photoRequest
+	}
 
-		uploadedPhotos = append(uploadedPhotos, userPhoto)
+	// Add photos to repository, appended after the existing ones
+	entPhotos, err := u.userRepo.AddPhotos(ctx, userID, photoRequests, u.maxPhotos)
+	if err != nil {
+		// Rollback: delete all uploaded images
+		u.deleteMedia(uploadedKeys)
+		return nil, err
 	}
 
-	return uploadedPhotos, nil
+	return u.toUserPhotos(entPhotos), nil
 }
 
 func (u *userUsecase) DeleteUserPhoto(ctx context.Context, userID, photoID uuid.UUID) error {
@@ -208,7 +234,7 @@ func (u *userUsecase) DeleteUserPhoto(ctx context.Context, userID, photoID uuid.
 		return fmt.Errorf("user not found: %w", err)
 	}
 
-	// Find the photo to get its public ID for Cloudinary deletion
+	// Find the photo to get its storage ID for media deletion
 	var photoToDelete *ent.UserPhoto
 	for _, photo := range user.Edges.Photos {
 		if photo.ID == photoID {
@@ -227,128 +253,11 @@ func (u *userUsecase) DeleteUserPhoto(ctx context.Context, userID, photoID uuid.
 		return fmt.Errorf("failed to delete photo from database: %w", err)
 	}
 
-	go func() {
-		// Delete image from Cloudinary if it has a public ID
-		// Generate expected public ID based on upload pattern
-		err = u.cld.DeleteImage(photoToDelete.PublicID)
-		if err != nil {
-			// Log the error but don't fail the operation since DB deletion succeeded
-			fmt.Printf("Warning: Failed to delete image from Cloudinary (public_id: %s): %v\n", photoToDelete.PublicID, err)
-		}
-	}()
+	go u.deleteUnusedMedia(photoKeys(photoToDelete))
 
 	return nil
 }
 
-func (u *userUsecase) GetRecommendations(ctx context.Context, userID uuid.UUID) ([]string, error) {
-	// Fetch users by user preference
-	preferredUsers, currentUser, err := u.userRepo.GetUsersByPreference(ctx, userID)
-	if err != nil {
-		return []string{}, err
-	}
-
-	if len(preferredUsers) < 1 {
-		return []string{}, nil
-	}
-
-	type userRanking struct {
-		userID    string
-		userScore int
-	}
-
-	recommendedIDS := make([]userRanking, len(preferredUsers))
-
-	for i, v := range preferredUsers {
-		score := 0
-		// Check matches in bio max if 5 per field
-		score += countSimilar(currentUser.LookingFor, v.LookingFor)
-		score += countSimilar(currentUser.Interests, v.Interests)
-		score += countSimilar(currentUser.MusicPreferences, v.MusicPreferences)
-		score += countSimilar(currentUser.FoodPreferences, v.FoodPreferences)
-		if currentUser.CommunicationStyle == v.CommunicationStyle {
-			score += 5
-		}
-		recommendedIDS[i] = userRanking{
-			userID:    v.ID.String(),
-			userScore: score,
-		}
-	}
-
-	// Sort by userScore descending
-	sort.Slice(recommendedIDS, func(i, j int) bool {
-		return recommendedIDS[i].userScore > recommendedIDS[j].userScore
-	})
-
-	// Fetch existing connections
-	connections, err := u.connRepo.GetUserConnections(ctx, userID)
-	if err != nil {
-		log.Printf("failed to get user connections: %v", err) // only log err
-	}
-	// Create a set of connected user IDs
-	connectedUserIDs := make(map[string]struct{})
-	for _, conn := range connections {
-		if conn.UserAID == userID {
-			connectedUserIDs[conn.UserBID.String()] = struct{}{}
-		} else if conn.UserBID == userID {
-			connectedUserIDs[conn.UserAID.String()] = struct{}{}
-		}
-	}
-
-	// Fetch excluded user IDs based on interactions
-	var excludedUserIDs map[string]struct{}
-	if u.interactionUC != nil {
-		excludedIDs, err := u.interactionUC.GetExcludedUserIDs(ctx, userID)
-		if err != nil {
-			log.Printf("failed to get excluded user IDs: %v", err) // only log err
-			excludedUserIDs = make(map[string]struct{})
-		} else {
-			excludedUserIDs = make(map[string]struct{})
-			for _, id := range excludedIDs {
-				excludedUserIDs[id.String()] = struct{}{}
-			}
-		}
-	} else {
-		excludedUserIDs = make(map[string]struct{})
-	}
-
-	// Filter out connected, pending, and excluded users, collect top 10
-	result := make([]string, 0, 10)
-	for _, ranking := range recommendedIDS {
-		if len(result) >= 10 {
-			break
-		}
-		
-		// Check if user is connected
-		if _, connected := connectedUserIDs[ranking.userID]; connected {
-			continue
-		}
-		
-		// Check if there's a pending request in either direction
-		targetUserID, err := uuid.Parse(ranking.userID)
-		if err != nil {
-			continue // Skip if userID is invalid
-		}
-		
-		// Check userID -> targetUserID
-		req1, _ := u.connReqRepo.GetConnectionRequestBetweenUsers(ctx, userID, targetUserID)
-		// Check targetUserID -> userID  
-		req2, _ := u.connReqRepo.GetConnectionRequestBetweenUsers(ctx, targetUserID, userID)
-		
-		if req1 != nil || req2 != nil {
-			continue // Skip if there's a pending request in either direction
-		}
-		
-		// Check if excluded by interactions
-		if _, excluded := excludedUserIDs[ranking.userID]; excluded {
-			continue
-		}
-		
-		result = append(result, ranking.userID)
-	}
-
-	return result, nil
-}
-
 func (u *userUsecase) SkipRecommendation(ctx context.Context, userID, targetUserID uuid.UUID) error {
 	// Validate that users are not the same
 	if userID == targetUserID {
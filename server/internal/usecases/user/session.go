@@ -0,0 +1,185 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"match-me/internal/models"
+	"match-me/internal/pkg/jwt"
+	"match-me/internal/repositories/session"
+
+	"github.com/google/uuid"
+)
+
+// Reasons stored on revoked sessions
+const (
+	RevokeReasonLogout     = "logout"
+	RevokeReasonUser       = "revoked_by_user"
+	RevokeReasonTokenReuse = "refresh_token_reuse"
+	RevokeReasonPassword   = "password_reset"
+)
+
+func (u *userUsecase) RefreshSession(ctx context.Context, refreshToken string, meta session.SessionMeta) (*models.AuthTokens, error) {
+	userID, sessionID, err := u.parseRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the session is still usable
+	sess, err := u.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil || sess.UserID != userID {
+		return nil, fmt.Errorf("invalid refresh token")
+	}
+	if !sess.RevokedAt.IsZero() {
+		return nil, fmt.Errorf("session has been revoked")
+	}
+
+	// Issue the next refresh token of the family
+	newRefreshToken, refreshExpiresAt, err := u.generateRefreshToken(userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := u.sessionRepo.RotateRefreshToken(ctx, sessionID,
+		jwt.HashToken(refreshToken), jwt.HashToken(newRefreshToken), meta, refreshExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rotated {
+		// The presented token was already rotated: it is being replayed, so kill the whole family
+		log.Printf("⚠️ Refresh token reuse detected for session %s (user %s), revoking session", sessionID, userID)
+		if err := u.sessionRepo.RevokeSession(ctx, sessionID, RevokeReasonTokenReuse); err != nil {
+			log.Printf("failed to revoke session %s: %v", sessionID, err)
+		}
+		return nil, fmt.Errorf("refresh token reuse detected")
+	}
+
+	accessToken, accessExpiresAt, err := u.generateAccessToken(userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAuthTokens(sessionID, accessToken, accessExpiresAt, newRefreshToken, refreshExpiresAt), nil
+}
+
+func (u *userUsecase) Logout(ctx context.Context, refreshToken string) error {
+	_, sessionID, err := u.parseRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	sess, err := u.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("invalid refresh token")
+	}
+
+	// A stale token still ends the session, but is recorded as reuse
+	reason := RevokeReasonLogout
+	if sess.RefreshTokenHash != jwt.HashToken(refreshToken) {
+		reason = RevokeReasonTokenReuse
+	}
+
+	return u.sessionRepo.RevokeSession(ctx, sessionID, reason)
+}
+
+func (u *userUsecase) ValidateSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	sess, err := u.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil || sess.UserID != userID {
+		return fmt.Errorf("session not found")
+	}
+
+	if !sess.RevokedAt.IsZero() || sess.ExpiresAt.Before(time.Now()) {
+		return fmt.Errorf("session is no longer active")
+	}
+
+	return nil
+}
+
+func (u *userUsecase) GetUserSessions(ctx context.Context, userID, currentSessionID uuid.UUID) ([]*models.Session, error) {
+	sessions, err := u.sessionRepo.GetActiveSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.ToSessions(sessions, currentSessionID), nil
+}
+
+func (u *userUsecase) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	sess, err := u.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil || sess.UserID != userID {
+		return fmt.Errorf("session not found")
+	}
+
+	return u.sessionRepo.RevokeSession(ctx, sessionID, RevokeReasonUser)
+}
+
+// createSession starts a new token family for the user and returns its first token pair
+func (u *userUsecase) createSession(ctx context.Context, userID uuid.UUID, meta session.SessionMeta) (*models.AuthTokens, error) {
+	sessionID := uuid.New()
+
+	refreshToken, refreshExpiresAt, err := u.generateRefreshToken(userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.sessionRepo.CreateSession(ctx, sessionID, userID, jwt.HashToken(refreshToken), meta, refreshExpiresAt); err != nil {
+		return nil, err
+	}
+
+	accessToken, accessExpiresAt, err := u.generateAccessToken(userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAuthTokens(sessionID, accessToken, accessExpiresAt, refreshToken, refreshExpiresAt), nil
+}
+
+func (u *userUsecase) generateAccessToken(userID, sessionID uuid.UUID) (string, time.Time, error) {
+	expiresAt := time.Now().Add(u.accessTokenTTL)
+	token, err := jwt.GenerateJWTTokenWithClaims(userID, u.jwtSecret, jwt.PurposeLogin, u.accessTokenTTL, map[string]any{
+		jwt.ClaimSessionID: sessionID.String(),
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+func (u *userUsecase) generateRefreshToken(userID, sessionID uuid.UUID) (string, time.Time, error) {
+	expiresAt := time.Now().Add(u.refreshTokenTTL)
+	token, err := jwt.GenerateJWTTokenWithClaims(userID, u.jwtSecret, jwt.PurposeRefresh, u.refreshTokenTTL, map[string]any{
+		jwt.ClaimSessionID: sessionID.String(),
+		jwt.ClaimTokenID:   uuid.NewString(),
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+func (u *userUsecase) parseRefreshToken(ctx context.Context, refreshToken string) (uuid.UUID, uuid.UUID, error) {
+	userID, claims, err := jwt.ParseJwtToken(ctx, refreshToken, jwt.PurposeRefresh, u.jwtSecret)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid refresh token")
+	}
+
+	sessionID, err := jwt.ClaimUUID(claims, jwt.ClaimSessionID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid refresh token")
+	}
+
+	return userID, sessionID, nil
+}
+
+func toAuthTokens(sessionID uuid.UUID, accessToken string, accessExpiresAt time.Time, refreshToken string, refreshExpiresAt time.Time) *models.AuthTokens {
+	return &models.AuthTokens{
+		SessionID:        sessionID,
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"match-me/ent"
+	"match-me/internal/pkg/jwt"
+	"match-me/internal/pkg/mailer"
+
+	"github.com/google/uuid"
+)
+
+func (u *userUsecase) VerifyEmail(ctx context.Context, token string) error {
+	userID, claims, err := jwt.ParseJwtToken(ctx, token, jwt.PurposeVerifyEmail, u.jwtSecret)
+	if err != nil {
+		return errors.New("invalid or expired verification token")
+	}
+
+	email, ok := claims[jwt.ClaimEmail].(string)
+	if !ok {
+		return errors.New("invalid or expired verification token")
+	}
+
+	entUser, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil || entUser.Email != email {
+		return errors.New("invalid or expired verification token")
+	}
+
+	// Verifying twice is harmless
+	if !entUser.EmailVerifiedAt.IsZero() {
+		return nil
+	}
+
+	return u.userRepo.MarkEmailVerified(ctx, userID)
+}
+
+func (u *userUsecase) ResendVerification(ctx context.Context, userID uuid.UUID) error {
+	entUser, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	if !entUser.EmailVerifiedAt.IsZero() {
+		return errors.New("email already verified")
+	}
+
+	return u.sendVerificationEmail(ctx, entUser)
+}
+
+// sendVerificationEmail mails a verification link, at most once per resend interval
+func (u *userUsecase) sendVerificationEmail(ctx context.Context, entUser *ent.User) error {
+	allowed, err := u.userRepo.MarkVerificationSent(ctx, entUser.ID, u.verifyResendGap)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errors.New("verification email sent recently, please try again later")
+	}
+
+	token, err := jwt.GenerateJWTTokenWithClaims(entUser.ID, u.jwtSecret, jwt.PurposeVerifyEmail, u.verifyTokenTTL, map[string]any{
+		jwt.ClaimEmail: entUser.Email,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", u.frontendURL, url.QueryEscape(token))
+	msg := mailer.Message{
+		To:      entUser.Email,
+		Subject: "Verify your Match-Me email address",
+		Body: fmt.Sprintf("Hi %s,\n\n"+
+			"Please confirm your email address to start getting matched:\n\n%s\n\n"+
+			"The link expires in %s.\n",
+			entUser.FirstName, link, u.verifyTokenTTL),
+	}
+
+	if err := u.mailer.Send(ctx, msg); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	return nil
+}
@@ -18,7 +18,8 @@ const (
 	writeWait      = 10 * time.Second
 	pongWait       = 60 * time.Second
 	pingPeriod     = (pongWait * 9) / 10
-	maxMessageSize = 512
+	maxMessageSize = 8192 // Large enough for chat messages sent over the socket
+	sendTimeout    = 10 * time.Second
 )
 
 var upgrader = websocket.Upgrader{
@@ -42,6 +43,11 @@ type Client struct {
 	connectionID *uuid.UUID // For chat/typing connections
 	isActive     bool
 	lastActivity time.Time
+	lastInput    time.Time // Last frame sent by the client itself, unlike pongs
+
+	// parent is set for a channel subscription of a multiplexed connection.
+	// Such a client has no socket of its own and writes through its parent.
+	parent *Client
 }
 
 // NewClient creates a new WebSocket client without any hub reference.
@@ -55,6 +61,22 @@ func NewClient(conn *websocket.Conn, userID uuid.UUID) *Client {
 		cancel:       cancel,
 		isActive:     true,
 		lastActivity: time.Now(),
+		lastInput:    time.Now(),
+	}
+}
+
+// newSubscriptionClient creates a client for one channel of a multiplexed connection.
+func newSubscriptionClient(parent *Client, connectionID *uuid.UUID) *Client {
+	ctx, cancel := context.WithCancel(parent.ctx)
+	return &Client{
+		conn:         parent.conn,
+		userID:       parent.userID,
+		ctx:          ctx,
+		cancel:       cancel,
+		connectionID: connectionID,
+		isActive:     true,
+		lastActivity: time.Now(),
+		parent:       parent,
 	}
 }
 
@@ -79,17 +101,39 @@ func (c *Client) UpdateActivity() {
 	c.lastActivity = time.Now()
 }
 
+// MarkInput records that the client sent a frame, which keeps its user from going away.
+func (c *Client) MarkInput() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastInput = time.Now()
+}
+
+// LastInput returns when the client last sent a frame.
+func (c *Client) LastInput() time.Time {
+	if c.parent != nil {
+		return c.parent.LastInput()
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastInput
+}
+
 // IsStale checks if the client connection is stale (inactive for too long).
 func (c *Client) IsStale() bool {
+	if c.parent != nil {
+		return c.parent.IsStale()
+	}
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	return time.Since(c.lastActivity) > pongWait*2
 }
 
 // readPump pumps messages from the websocket connection.
-// It accepts the specific hub's unregister channel and a reference to the typing hub
-// (which will be nil for non-typing connections).
-func (c *Client) readPump(unregister chan<- *Client, typingHub *TypingHub) {
+// It accepts the specific hub's unregister channel and references to the typing and chat hubs
+// (which will be nil for connections of another kind).
+func (c *Client) readPump(unregister chan<- *Client, typingHub *TypingHub, chatHub *ChatHub) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Printf("🚨 PANIC recovered in readPump for user %s: %v", c.userID, r)
@@ -117,7 +161,15 @@ func (c *Client) readPump(unregister chan<- *Client, typingHub *TypingHub) {
 			return
 		}
 		c.UpdateActivity()
-		if err := c.handleMessage(message, typingHub); err != nil {
+		c.MarkInput()
+		// Chatting keeps the user from going away, like frames on their status clients
+		if chatHub != nil {
+			chatHub.markActive(c.userID)
+		}
+		if typingHub != nil {
+			typingHub.markActive(c.userID)
+		}
+		if err := c.handleMessage(message, typingHub, chatHub); err != nil {
 			log.Printf("error handling message: %v", err)
 		}
 	}
@@ -164,7 +216,7 @@ func (c *Client) writePump() {
 }
 
 // handleMessage processes incoming WebSocket messages.
-func (c *Client) handleMessage(message []byte, typingHub *TypingHub) error {
+func (c *Client) handleMessage(message []byte, typingHub *TypingHub, chatHub *ChatHub) error {
 	var wsMessage WebSocketMessage
 	if err := json.Unmarshal(message, &wsMessage); err != nil {
 		return err
@@ -176,7 +228,14 @@ func (c *Client) handleMessage(message []byte, typingHub *TypingHub) error {
 		if typingHub != nil {
 			c.handleTypingEvent(wsMessage.Data, typingHub)
 		}
-	// Other cases like EventPing can be added here if needed.
+	case EventMessageSend:
+		// Only chat connections can send messages.
+		if chatHub != nil {
+			c.handleSendEvent(wsMessage.Data, chatHub)
+		}
+	case EventPing:
+		// Clients ping while their user is active, so replying is all that's needed.
+		c.SendMessage(EventPong, nil)
 	default:
 		log.Printf("Unhandled message type: %s", wsMessage.Type)
 	}
@@ -197,28 +256,79 @@ func (c *Client) handleTypingEvent(data interface{}, typingHub *TypingHub) {
 	}
 }
 
+// handleSendEvent stores a message sent over a chat connection and answers with an ack or nack.
+func (c *Client) handleSendEvent(data interface{}, chatHub *ChatHub) {
+	var sendEvent SendMessageEvent
+	jsonData, _ := json.Marshal(data)
+	if err := json.Unmarshal(jsonData, &sendEvent); err != nil {
+		log.Printf("Error parsing send event: %v", err)
+		c.SendMessage(EventMessageNack, MessageNackEvent{Error: "invalid message"})
+		return
+	}
+
+	if sendEvent.ClientMessageID == "" {
+		c.SendMessage(EventMessageNack, MessageNackEvent{Error: "client message ID is required"})
+		return
+	}
+
+	connID := c.GetConnectionID()
+	handler := chatHub.sendHandler()
+	if connID == nil || handler == nil {
+		c.SendMessage(EventMessageNack, MessageNackEvent{
+			ClientMessageID: sendEvent.ClientMessageID,
+			Error:           "sending messages is not available on this connection",
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, sendTimeout)
+	defer cancel()
+
+	message, err := handler(ctx, c.userID, *connID, sendEvent)
+	if err != nil {
+		c.SendMessage(EventMessageNack, MessageNackEvent{
+			ClientMessageID: sendEvent.ClientMessageID,
+			Error:           err.Error(),
+		})
+		return
+	}
+
+	c.SendMessage(EventMessageAck, MessageAckEvent{
+		ClientMessageID: sendEvent.ClientMessageID,
+		MessageID:       message.ID,
+		Message:         message,
+	})
+}
+
 // SendMessage sends a WebSocket message to the client.
-func (c *Client) SendMessage(eventType EventType, data interface{}) {
+// It reports whether the message was queued for the client.
+func (c *Client) SendMessage(eventType EventType, data interface{}) bool {
 	c.mu.RLock()
 	if !c.isActive {
 		c.mu.RUnlock()
-		return
+		return false
 	}
 	c.mu.RUnlock()
 
+	if c.parent != nil {
+		return c.parent.SendMessage(eventType, data)
+	}
+
 	message := NewWebSocketMessage(eventType, data)
 	messageBytes, err := message.ToJSON()
 	if err != nil {
 		log.Printf("Error marshaling WebSocket message: %v", err)
-		return
+		return false
 	}
 
 	select {
 	case c.send <- messageBytes:
 		log.Printf("📨 Successfully queued message %s for user %s", eventType, c.userID)
+		return true
 	default:
 		log.Printf("⚠️ Client send buffer full for user %s, closing connection", c.userID)
 		c.Close()
+		return false
 	}
 }
 
@@ -240,7 +350,11 @@ func (c *Client) Close() {
 	
 	c.isActive = false
 	c.cancel()
-	close(c.send)
+
+	// A subscription shares its parent's socket, which stays open
+	if c.parent == nil {
+		close(c.send)
+	}
 }
 
 // --- HUB-SPECIFIC SERVE FUNCTIONS ---
@@ -268,7 +382,7 @@ func ServeStatusWS(hub *StatusHub, c *gin.Context, userID uuid.UUID) {
 
 	// Start goroutines - if either fails, the client will be automatically unregistered via defer in readPump
 	go client.writePump()
-	go client.readPump(hub.unregister, nil)
+	go client.readPump(hub.unregister, nil, nil)
 }
 
 // ServeChatWS handles a chat-specific WebSocket connection.
@@ -283,8 +397,8 @@ func ServeChatWS(hub *ChatHub, c *gin.Context, userID uuid.UUID, connectionID uu
 	hub.register <- client
 
 	go client.writePump()
-	// The readPump for a chat client doesn't need to handle typing events.
-	go client.readPump(hub.unregister, nil)
+	// The readPump for a chat client handles messages sent over the socket, but not typing events.
+	go client.readPump(hub.unregister, nil, hub)
 }
 
 // ServeTypingWS handles a typing-specific WebSocket connection.
@@ -300,5 +414,5 @@ func ServeTypingWS(hub *TypingHub, c *gin.Context, userID uuid.UUID, connectionI
 
 	go client.writePump()
 	// The readPump for a typing client MUST be able to handle incoming typing events.
-	go client.readPump(hub.unregister, hub)
+	go client.readPump(hub.unregister, hub, nil)
 }
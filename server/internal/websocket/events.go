@@ -13,9 +13,16 @@ type EventType string
 
 const (
 	// Message events
-	EventMessageNew    EventType = "message_new"
-	EventMessageRead   EventType = "message_read"
-	EventMessageTyping EventType = "message_typing"
+	EventMessageNew       EventType = "message_new"
+	EventMessageRead      EventType = "message_read"
+	EventMessageDelivered EventType = "message_delivered"
+	EventMessageTyping    EventType = "message_typing"
+	EventMessageEdited    EventType = "message_edited"
+	EventMessageDeleted   EventType = "message_deleted"
+	EventMessageReaction  EventType = "message_reaction"
+	EventMessageSend      EventType = "message_send"
+	EventMessageAck       EventType = "message_ack"
+	EventMessageNack      EventType = "message_nack"
 
 	// User status events
 	EventUserOnline        EventType = "user_online"
@@ -29,6 +36,17 @@ const (
 	EventConnectionAccepted EventType = "connection_accepted"
 	EventConnectionDropped  EventType = "connection_dropped"
 
+	// Local event changes
+	EventEventUpdated EventType = "event_updated"
+	EventEventRSVP    EventType = "event_rsvp"
+
+	// Subscription events (multiplexed connection)
+	EventSubscribe      EventType = "subscribe"
+	EventUnsubscribe    EventType = "unsubscribe"
+	EventSubscribed     EventType = "subscribed"
+	EventUnsubscribed   EventType = "unsubscribed"
+	EventSubscribeError EventType = "subscribe_error"
+
 	// System events
 	EventError EventType = "error"
 	EventPing  EventType = "ping"
@@ -53,10 +71,65 @@ type MessageEvent struct {
 
 // MessageReadEvent represents a message read event
 type MessageReadEvent struct {
+	MessageIDs        []uuid.UUID `json:"message_ids"`
+	LastReadMessageID uuid.UUID   `json:"last_read_message_id"`
+	ConnectionID      uuid.UUID   `json:"connection_id"`
+	ReadBy            uuid.UUID   `json:"read_by"`
+	ReadAt            time.Time   `json:"read_at"`
+}
+
+// MessageDeliveredEvent represents a message delivery event
+type MessageDeliveredEvent struct {
+	MessageIDs   []uuid.UUID `json:"message_ids"`
+	ConnectionID uuid.UUID   `json:"connection_id"`
+	DeliveredTo  uuid.UUID   `json:"delivered_to"`
+	DeliveredAt  time.Time   `json:"delivered_at"`
+}
+
+// MessageEditedEvent represents a message edit event
+type MessageEditedEvent struct {
+	Message      *models.Message `json:"message"`
+	ConnectionID uuid.UUID       `json:"connection_id"`
+	EditedBy     uuid.UUID       `json:"edited_by"`
+}
+
+// MessageDeletedEvent represents a message unsend event
+type MessageDeletedEvent struct {
 	MessageID    uuid.UUID `json:"message_id"`
 	ConnectionID uuid.UUID `json:"connection_id"`
-	ReadBy       uuid.UUID `json:"read_by"`
-	ReadAt       time.Time `json:"read_at"`
+	DeletedBy    uuid.UUID `json:"deleted_by"`
+	DeletedAt    time.Time `json:"deleted_at"`
+}
+
+// MessageReactionEvent represents a reaction being added to or removed from a message
+type MessageReactionEvent struct {
+	MessageID    uuid.UUID                 `json:"message_id"`
+	ConnectionID uuid.UUID                 `json:"connection_id"`
+	UserID       uuid.UUID                 `json:"user_id"`
+	Emoji        string                    `json:"emoji"`
+	Action       string                    `json:"action"` // "added", "removed"
+	Reactions    []*models.ReactionSummary `json:"reactions"`
+}
+
+// SendMessageEvent represents a message sent by a client over a chat connection
+type SendMessageEvent struct {
+	ClientMessageID string     `json:"client_message_id"`       // Idempotency key chosen by the client
+	ConnectionID    *uuid.UUID `json:"connection_id,omitempty"` // Required on the multiplexed connection
+	Content         string     `json:"content"`
+	ReplyToID       *uuid.UUID `json:"reply_to_id,omitempty"`
+}
+
+// MessageAckEvent confirms that a sent message was stored
+type MessageAckEvent struct {
+	ClientMessageID string          `json:"client_message_id"`
+	MessageID       uuid.UUID       `json:"message_id"`
+	Message         *models.Message `json:"message"`
+}
+
+// MessageNackEvent reports that a sent message was rejected
+type MessageNackEvent struct {
+	ClientMessageID string `json:"client_message_id"`
+	Error           string `json:"error"`
 }
 
 // TypingEvent represents typing indicator event
@@ -69,21 +142,36 @@ type TypingEvent struct {
 
 // UserStatusEvent represents user online/offline status
 type UserStatusEvent struct {
-	UserID       uuid.UUID `json:"user_id"`
-	Status       string    `json:"status"` // "online", "offline", "away"
-	LastActivity time.Time `json:"last_activity"`
+	UserID        uuid.UUID  `json:"user_id"`
+	Status        string     `json:"status"` // "online", "busy", "away", "offline"
+	StatusMessage *string    `json:"status_message,omitempty"`
+	LastActivity  *time.Time `json:"last_activity,omitempty"`
 }
 
 // ConnectionRequestEvent represents connection request events
 type ConnectionRequestEvent struct {
 	Request *models.ConnectionRequest `json:"request"`
-	Action  string                    `json:"action"` // "new", "accepted", "declined"
+	Action  string                    `json:"action"` // "new", "accepted", "declined", "expired", "withdrawn"
 }
 
 // ConnectionEvent represents connection events
 type ConnectionEvent struct {
 	Connection *models.Connection `json:"connection"`
-	Action     string             `json:"action"` // "established", "dropped"
+	Action     string             `json:"action"` // "established", "matched", "dropped"
+}
+
+// LocalEventEvent represents changes to a local event sent to its participants
+type LocalEventEvent struct {
+	Event       *models.Event            `json:"event"`
+	Action      string                   `json:"action"` // "updated", "cancelled", "rsvp"
+	Participant *models.EventParticipant `json:"participant,omitempty"`
+}
+
+// SubscriptionEvent represents a channel subscription on the multiplexed connection.
+// Channels are "status", "connection:<id>" and "typing:<id>".
+type SubscriptionEvent struct {
+	Channel string `json:"channel"`
+	Error   string `json:"error,omitempty"`
 }
 
 // ErrorEvent represents error events
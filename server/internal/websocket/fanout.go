@@ -0,0 +1,267 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"match-me/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Fan-out scopes decide which hub delivers a relayed event on the receiving node
+const (
+	scopeConnection = "connection"
+	scopeTyping     = "typing"
+	scopeUser       = "user"
+	scopeStatus     = "status"
+	scopeLink       = "link"
+	scopeUnlink     = "unlink"
+	scopePresence   = "presence"
+)
+
+// publishTimeout bounds how long a broadcast waits on the broker
+const publishTimeout = 5 * time.Second
+
+// fanoutMessage is the envelope sent through the broker to the other server nodes
+type fanoutMessage struct {
+	Origin       string          `json:"origin"`
+	Scope        string          `json:"scope"`
+	ConnectionID *uuid.UUID      `json:"connection_id,omitempty"`
+	UserID       uuid.UUID       `json:"user_id"`
+	PeerID       *uuid.UUID      `json:"peer_id,omitempty"`
+	Status       string          `json:"status,omitempty"`
+	Type         EventType       `json:"type,omitempty"`
+	Data         json.RawMessage `json:"data,omitempty"`
+}
+
+// publish sends an event to the other server nodes
+func (s *WebSocketService) publish(msg fanoutMessage, data interface{}) {
+	msg.Origin = s.nodeID
+	if data != nil {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			log.Printf("Error marshaling %s event for fan-out: %v", msg.Type, err)
+			return
+		}
+		msg.Data = raw
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("Error marshaling fan-out message: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+	if err := s.broker.Publish(ctx, payload); err != nil {
+		log.Printf("Failed to publish %s %s event: %v", msg.Scope, msg.Type, err)
+	}
+}
+
+// toConnection sends an event to everyone in a connection except the sender, on every node
+func (s *WebSocketService) toConnection(connectionID uuid.UUID, eventType EventType, data interface{}, senderUserID uuid.UUID) {
+	s.chatHub.BroadcastEvent(connectionID, eventType, data, senderUserID)
+	s.publish(fanoutMessage{
+		Scope:        scopeConnection,
+		ConnectionID: &connectionID,
+		UserID:       senderUserID,
+		Type:         eventType,
+	}, data)
+}
+
+// toUser sends an event to a user's status connections on every node.
+// It reports whether the user has a status connection to receive it.
+func (s *WebSocketService) toUser(userID uuid.UUID, eventType EventType, data interface{}) bool {
+	delivered := s.statusHub.BroadcastToUser(userID, eventType, data)
+	s.publish(fanoutMessage{
+		Scope:  scopeUser,
+		UserID: userID,
+		Type:   eventType,
+	}, data)
+	return delivered || s.IsOnlineElsewhere(userID)
+}
+
+// relayTypingIndicator forwards a typing event delivered by the local TypingHub
+func (s *WebSocketService) relayTypingIndicator(connectionID uuid.UUID, typingEvent TypingEvent, senderUserID uuid.UUID) {
+	s.publish(fanoutMessage{
+		Scope:        scopeTyping,
+		ConnectionID: &connectionID,
+		UserID:       senderUserID,
+		Type:         EventMessageTyping,
+	}, typingEvent)
+}
+
+// RelayUserStatus forwards a status event broadcast by the local StatusHub
+func (s *WebSocketService) RelayUserStatus(eventType EventType, statusEvent UserStatusEvent) {
+	s.publish(fanoutMessage{
+		Scope:  scopeStatus,
+		UserID: statusEvent.UserID,
+		Status: statusEvent.Status,
+		Type:   eventType,
+	}, statusEvent)
+}
+
+// handleFanout delivers an event published by another node to this node's clients
+func (s *WebSocketService) handleFanout(payload []byte) {
+	var msg fanoutMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		log.Printf("Error decoding fan-out message: %v", err)
+		return
+	}
+
+	// Events from this node were already delivered locally
+	if msg.Origin == s.nodeID {
+		return
+	}
+
+	switch msg.Scope {
+	case scopeConnection:
+		if msg.ConnectionID != nil {
+			s.chatHub.BroadcastEvent(*msg.ConnectionID, msg.Type, msg.Data, msg.UserID)
+		}
+
+	case scopeTyping:
+		var typingEvent TypingEvent
+		if msg.ConnectionID == nil || json.Unmarshal(msg.Data, &typingEvent) != nil {
+			return
+		}
+		s.typingHub.deliverTypingIndicator(*msg.ConnectionID, typingEvent, msg.UserID)
+
+	case scopeUser:
+		s.statusHub.BroadcastToUser(msg.UserID, msg.Type, msg.Data)
+
+	case scopeStatus:
+		var statusEvent UserStatusEvent
+		if json.Unmarshal(msg.Data, &statusEvent) != nil {
+			return
+		}
+
+		switch msg.Type {
+		case EventUserOffline:
+			s.setRemoteUser(msg.UserID, false)
+
+			// Local clients already see the user as online while they are connected here
+			if s.statusHub.IsUserOnline(msg.UserID) {
+				return
+			}
+		case EventUserStatusChange:
+			// Changes such as going invisible don't connect or disconnect the user
+		default:
+			s.setRemoteUser(msg.UserID, true)
+		}
+		s.statusHub.deliverStatusEvent(msg.Type, statusEvent)
+
+	case scopePresence:
+		var status models.UserStatus
+		if json.Unmarshal(msg.Data, &status) != nil {
+			return
+		}
+		s.statusHub.SetUserStatus(&status)
+
+	case scopeLink:
+		if msg.PeerID != nil {
+			s.statusHub.LinkUsers(msg.UserID, *msg.PeerID)
+		}
+
+	case scopeUnlink:
+		if msg.PeerID != nil {
+			s.statusHub.UnlinkUsers(msg.UserID, *msg.PeerID)
+		}
+
+	default:
+		log.Printf("Unknown fan-out scope: %s", msg.Scope)
+	}
+}
+
+// Run renews this node's presence leases until the service shuts down
+func (s *WebSocketService) Run() {
+	ticker := time.NewTicker(s.heartbeatInterval)
+	defer ticker.Stop()
+
+	s.beat()
+	for {
+		select {
+		case <-ticker.C:
+			s.beat()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// beat renews the presence of the users connected to this node and reports the activity
+// of those who don't appear offline
+func (s *WebSocketService) beat() {
+	userIDs := s.statusHub.GetOnlineUsers()
+	s.heartbeat(userIDs)
+
+	s.presenceMu.RLock()
+	onActivity := s.onActivity
+	s.presenceMu.RUnlock()
+
+	if onActivity == nil || len(userIDs) == 0 {
+		return
+	}
+	if visible := s.statusHub.visibleUsers(userIDs); len(visible) > 0 {
+		onActivity(visible)
+	}
+}
+
+// heartbeat renews the leases of the given local users and refreshes the users online elsewhere
+func (s *WebSocketService) heartbeat(userIDs []uuid.UUID) {
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	if err := s.broker.Heartbeat(ctx, s.nodeID, userIDs, s.leaseTTL); err != nil {
+		log.Printf("Failed to renew presence leases: %v", err)
+	}
+
+	remoteUsers, err := s.broker.OnlineUsers(ctx, s.nodeID)
+	if err != nil {
+		log.Printf("Failed to refresh remote presence: %v", err)
+		return
+	}
+
+	online := make(map[uuid.UUID]bool, len(remoteUsers))
+	for _, userID := range remoteUsers {
+		online[userID] = true
+	}
+
+	s.presenceMu.Lock()
+	s.remoteUsers = online
+	s.presenceMu.Unlock()
+}
+
+// setRemoteUser records a status change from another node until the next heartbeat
+func (s *WebSocketService) setRemoteUser(userID uuid.UUID, online bool) {
+	s.presenceMu.Lock()
+	defer s.presenceMu.Unlock()
+
+	if online {
+		s.remoteUsers[userID] = true
+	} else {
+		delete(s.remoteUsers, userID)
+	}
+}
+
+// IsOnlineElsewhere checks if a user is connected to another server node
+func (s *WebSocketService) IsOnlineElsewhere(userID uuid.UUID) bool {
+	s.presenceMu.RLock()
+	defer s.presenceMu.RUnlock()
+	return s.remoteUsers[userID]
+}
+
+// RemoteOnlineUsers returns the users connected to other server nodes
+func (s *WebSocketService) RemoteOnlineUsers() []uuid.UUID {
+	s.presenceMu.RLock()
+	defer s.presenceMu.RUnlock()
+
+	userIDs := make([]uuid.UUID, 0, len(s.remoteUsers))
+	for userID := range s.remoteUsers {
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs
+}
@@ -3,6 +3,7 @@ package websocket
 import (
 	"context"
 	"log"
+	"match-me/internal/models"
 	"sync"
 	"time"
 
@@ -43,6 +44,9 @@ func (cg *ChatConnectionGroup) GetClientCount() int {
 	return len(cg.clients)
 }
 
+// ChatSendHandler stores a message a client sent over a chat connection.
+type ChatSendHandler func(ctx context.Context, senderID, connectionID uuid.UUID, send SendMessageEvent) (*models.Message, error)
+
 // ChatHub maintains the set of active chat clients and broadcasts chat messages.
 type ChatHub struct {
 	clients     map[*Client]bool
@@ -52,6 +56,9 @@ type ChatHub struct {
 	mu          sync.RWMutex
 	ctx         context.Context
 	cancel      context.CancelFunc
+	onConnect   func(userID uuid.UUID)
+	onSend      ChatSendHandler
+	onActivity  func(userID uuid.UUID)
 }
 
 func NewChatHub() *ChatHub {
@@ -81,8 +88,13 @@ func (h *ChatHub) Run() {
 				}
 				group.AddClient(client)
 			}
+			onConnect := h.onConnect
 			h.mu.Unlock()
 
+			if onConnect != nil {
+				go onConnect(client.userID)
+			}
+
 		case client := <-h.unregister:
 			h.mu.Lock()
 			if _, ok := h.clients[client]; ok {
@@ -111,21 +123,65 @@ func (h *ChatHub) Run() {
 	}
 }
 
+// OnConnect sets a callback that is run with the user ID whenever a chat client registers.
+func (h *ChatHub) OnConnect(fn func(userID uuid.UUID)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onConnect = fn
+}
+
+// OnSend sets the handler that stores messages clients send over their chat connection.
+func (h *ChatHub) OnSend(fn ChatSendHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onSend = fn
+}
+
+// OnActivity sets a callback that is run with the user ID whenever a chat client sends a frame.
+func (h *ChatHub) OnActivity(fn func(userID uuid.UUID)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onActivity = fn
+}
+
+// markActive reports that a user's chat client sent a frame.
+func (h *ChatHub) markActive(userID uuid.UUID) {
+	h.mu.RLock()
+	onActivity := h.onActivity
+	h.mu.RUnlock()
+
+	if onActivity != nil {
+		onActivity(userID)
+	}
+}
+
+// sendHandler returns the handler for messages sent by clients, if one is set.
+func (h *ChatHub) sendHandler() ChatSendHandler {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.onSend
+}
+
 // BroadcastMessage sends a chat message to all clients in a connection except the sender.
-func (h *ChatHub) BroadcastMessage(connectionID uuid.UUID, messageEvent MessageEvent, senderUserID uuid.UUID) {
+// It reports whether the message was queued for at least one client.
+func (h *ChatHub) BroadcastMessage(connectionID uuid.UUID, messageEvent MessageEvent, senderUserID uuid.UUID) bool {
 	h.mu.RLock()
 	group, ok := h.connections[connectionID]
 	h.mu.RUnlock()
 
+	delivered := false
 	if ok {
 		group.mu.RLock()
 		defer group.mu.RUnlock()
 		for client := range group.clients {
 			if client.userID != senderUserID {
-				client.SendMessage(EventMessageNew, messageEvent)
+				if client.SendMessage(EventMessageNew, messageEvent) {
+					delivered = true
+				}
 			}
 		}
 	}
+	return delivered
 }
 
 // GetConnectionUsers returns a slice of user IDs for a given connection.
@@ -212,6 +268,12 @@ type TypingHub struct {
 	mu          sync.RWMutex
 	ctx         context.Context
 	cancel      context.CancelFunc
+
+	// Called after a typing event was delivered to this hub's clients.
+	onBroadcast func(connectionID uuid.UUID, typingEvent TypingEvent, senderUserID uuid.UUID)
+
+	// Called with the user ID whenever a typing client sends a frame.
+	onActivity func(userID uuid.UUID)
 }
 
 func NewTypingHub() *TypingHub {
@@ -271,8 +333,46 @@ func (h *TypingHub) Run() {
 	}
 }
 
+// OnBroadcast sets a callback that is run after every typing event sent through the hub.
+func (h *TypingHub) OnBroadcast(fn func(connectionID uuid.UUID, typingEvent TypingEvent, senderUserID uuid.UUID)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onBroadcast = fn
+}
+
+// OnActivity sets a callback that is run with the user ID whenever a typing client sends a frame.
+func (h *TypingHub) OnActivity(fn func(userID uuid.UUID)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onActivity = fn
+}
+
+// markActive reports that a user's typing client sent a frame.
+func (h *TypingHub) markActive(userID uuid.UUID) {
+	h.mu.RLock()
+	onActivity := h.onActivity
+	h.mu.RUnlock()
+
+	if onActivity != nil {
+		onActivity(userID)
+	}
+}
+
 // BroadcastTypingIndicator sends a typing event to all clients in a connection except the sender.
 func (h *TypingHub) BroadcastTypingIndicator(connectionID uuid.UUID, typingEvent TypingEvent, senderUserID uuid.UUID) {
+	h.deliverTypingIndicator(connectionID, typingEvent, senderUserID)
+
+	h.mu.RLock()
+	onBroadcast := h.onBroadcast
+	h.mu.RUnlock()
+
+	if onBroadcast != nil {
+		onBroadcast(connectionID, typingEvent, senderUserID)
+	}
+}
+
+// deliverTypingIndicator sends a typing event to this hub's clients only.
+func (h *TypingHub) deliverTypingIndicator(connectionID uuid.UUID, typingEvent TypingEvent, senderUserID uuid.UUID) {
 	h.mu.RLock()
 	group, ok := h.connections[connectionID]
 	h.mu.RUnlock()
@@ -312,8 +412,50 @@ type StatusHub struct {
 	mu         sync.RWMutex
 	ctx        context.Context
 	cancel     context.CancelFunc
+
+	// Called with the user ID whenever a status client registers.
+	onConnect func(userID uuid.UUID)
+
+	// Called with the user ID when a user's last status client on this node unregisters.
+	onDisconnect func(userID uuid.UUID)
+
+	// Looks up the stored status of users, including when they were last seen.
+	lookup StatusLookup
+
+	// Stored status of the users online on this node, and which of them went idle.
+	statuses    map[uuid.UUID]*models.UserStatus
+	away        map[uuid.UUID]bool
+	idleTimeout time.Duration
+
+	// Last activity of online users outside their status clients, such as chatting
+	// or sending messages over HTTP.
+	activeAt map[uuid.UUID]time.Time
+
+	// Presence of users connected to other server nodes, if any.
+	presence RemotePresence
+
+	// Connections of the users online on this node, which decide who sees whose status.
+	index        *presenceIndex
+	loadContacts ContactsLoader
+}
+
+// ContactsLoader returns the users a user is connected with.
+type ContactsLoader func(userID uuid.UUID) ([]uuid.UUID, error)
+
+// StatusLookup returns the stored status of the given users. Users who are not
+// found may be left out.
+type StatusLookup func(userIDs []uuid.UUID) map[uuid.UUID]*models.UserStatus
+
+// RemotePresence relays status changes to other server nodes and reports the users
+// connected to them.
+type RemotePresence interface {
+	RelayUserStatus(eventType EventType, statusEvent UserStatusEvent)
+	IsOnlineElsewhere(userID uuid.UUID) bool
 }
 
+// idleCheckInterval is how often the hub looks for users who went idle or came back
+const idleCheckInterval = 10 * time.Second
+
 // NewStatusHub creates a new StatusHub.
 func NewStatusHub() *StatusHub {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -322,6 +464,10 @@ func NewStatusHub() *StatusHub {
 		unregister:    make(chan *Client),
 		clients:       make(map[*Client]bool),
 		clientsByUser: make(map[uuid.UUID]map[*Client]bool),
+		index:         newPresenceIndex(),
+		statuses:      make(map[uuid.UUID]*models.UserStatus),
+		away:          make(map[uuid.UUID]bool),
+		activeAt:      make(map[uuid.UUID]time.Time),
 		ctx:           ctx,
 		cancel:        cancel,
 	}
@@ -331,6 +477,7 @@ func NewStatusHub() *StatusHub {
 func (h *StatusHub) Run() {
 	// Start stale connection cleanup goroutine
 	go h.cleanupStaleConnections()
+	go h.detectIdleUsers()
 
 	for {
 		select {
@@ -351,44 +498,14 @@ func (h *StatusHub) Run() {
 			// Add client to user's client map
 			h.clientsByUser[client.userID][client] = true
 			log.Printf("✅ Status client registered for user %s (total connections: %d)", client.userID, len(h.clientsByUser[client.userID]))
+			onConnect := h.onConnect
 			h.mu.Unlock()
 
-			// 1. Send the list of already-online users directly to the new client.
-			h.SendInitialUserStatuses(client)
-
-			// 2. Broadcast the new user's "online" status to everyone else.
-			if wasOffline {
-				h.BroadcastUserStatus(client.userID, "online")
-			}
+			// Loading the user's connections hits the database, so keep it off the event loop
+			go h.welcome(client, wasOffline, onConnect)
 
 		case client := <-h.unregister:
-			h.mu.Lock()
-			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				userID := client.userID
-				// Remove the client from the user-specific map.
-				if userClients, ok := h.clientsByUser[userID]; ok {
-					delete(userClients, client)
-					// Check if user will be offline after removing this client
-					willBeOffline := len(userClients) == 0
-					// If the user has no more active status connections, remove their entry.
-					if willBeOffline {
-						delete(h.clientsByUser, userID)
-					}
-					client.Close()
-					log.Printf("🔌 Status client unregistered for user %s", userID)
-					h.mu.Unlock()
-
-					// Broadcast user offline status if they have no more connections
-					if willBeOffline {
-						h.BroadcastUserStatus(userID, "offline")
-					}
-				} else {
-					h.mu.Unlock()
-				}
-			} else {
-				h.mu.Unlock()
-			}
+			h.removeClient(client)
 
 		case <-h.ctx.Done():
 			h.mu.Lock()
@@ -402,8 +519,359 @@ func (h *StatusHub) Run() {
 	}
 }
 
+// removeClient unregisters a status client. When it was the user's last one, the user
+// goes offline: their last-seen time is recorded and their connections are told, unless
+// they were invisible and so already appeared offline.
+func (h *StatusHub) removeClient(client *Client) {
+	h.mu.Lock()
+	if _, ok := h.clients[client]; !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(h.clients, client)
+	userID := client.userID
+
+	// Remove the client from the user-specific map.
+	userClients, ok := h.clientsByUser[userID]
+	if !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(userClients, client)
+
+	// If the user has no more active status connections, remove their entry.
+	willBeOffline := len(userClients) == 0
+	stored := h.statuses[userID]
+	if willBeOffline {
+		delete(h.clientsByUser, userID)
+		delete(h.statuses, userID)
+		delete(h.away, userID)
+		delete(h.activeAt, userID)
+		h.index.untrack(userID)
+	}
+	client.Close()
+	log.Printf("🔌 Status client unregistered for user %s", userID)
+	onDisconnect := h.onDisconnect
+	h.mu.Unlock()
+
+	if !willBeOffline {
+		return
+	}
+
+	// The status may not be cached yet if the user left right after connecting
+	if stored == nil {
+		stored = h.storedStatus(userID)
+	}
+	if stored.Mode == models.StatusModeInvisible {
+		return
+	}
+
+	if onDisconnect != nil {
+		go onDisconnect(userID)
+	}
+
+	// Broadcast user offline status unless they are still connected to another node
+	if !h.isOnlineElsewhere(userID) {
+		h.broadcastStatusEvent(EventUserOffline, newStatusEvent(userID, "offline", stored, time.Now()))
+	}
+}
+
+// welcome brings a newly registered status client up to date and announces the user.
+func (h *StatusHub) welcome(client *Client, wasOffline bool, onConnect func(userID uuid.UUID)) {
+	// 1. Index the user's connections and load their status, unless another of
+	// their clients already did.
+	if !h.index.isTracked(client.userID) {
+		h.trackContacts(client.userID)
+	}
+	h.trackStatus(client.userID)
+
+	// 2. Send the list of already-online connections directly to the new client.
+	h.SendInitialUserStatuses(client)
+
+	// 3. Broadcast the new user's status to their connections, unless they
+	// disconnected in the meantime or appear offline.
+	if wasOffline && h.IsUserOnline(client.userID) {
+		if status := h.liveStatus(client.userID, h.storedStatus(client.userID)); status != "offline" {
+			h.BroadcastUserStatus(client.userID, status)
+		}
+	}
+
+	// 4. Let the application catch the new client up.
+	if onConnect != nil {
+		onConnect(client.userID)
+	}
+}
+
+// trackContacts loads the connections of a user with a status client on this hub.
+func (h *StatusHub) trackContacts(userID uuid.UUID) {
+	h.mu.RLock()
+	loadContacts := h.loadContacts
+	h.mu.RUnlock()
+
+	if loadContacts == nil {
+		return
+	}
+
+	contactIDs, err := loadContacts(userID)
+	if err != nil {
+		log.Printf("❌ Failed to load connections of user %s: %v", userID, err)
+		return
+	}
+
+	// The user may have disconnected while their connections were loading
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.clientsByUser[userID]; ok {
+		h.index.track(userID, contactIDs)
+	}
+}
+
+// trackStatus caches the stored status of a user with a status client on this hub.
+func (h *StatusHub) trackStatus(userID uuid.UUID) {
+	h.mu.RLock()
+	_, cached := h.statuses[userID]
+	h.mu.RUnlock()
+
+	if cached {
+		return
+	}
+
+	status := h.lookupStatuses([]uuid.UUID{userID})[userID]
+
+	// The user may have disconnected while their status was loading
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.clientsByUser[userID]; ok {
+		if _, cached := h.statuses[userID]; !cached {
+			h.statuses[userID] = status
+		}
+	}
+}
+
+// UseContacts sets the loader for the connections of users who come online.
+func (h *StatusHub) UseContacts(loader ContactsLoader) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.loadContacts = loader
+}
+
+// LinkUsers lets two newly connected users see each other's status.
+func (h *StatusHub) LinkUsers(userAID, userBID uuid.UUID) {
+	h.index.link(userAID, userBID)
+
+	statuses := h.storedStatuses([]uuid.UUID{userAID, userBID})
+	if statusEvent, ok := h.currentStatusEvent(userAID, statuses[userAID]); ok {
+		h.sendStatusEvent(h.userClients(userBID), statusEventType(statusEvent.Status), statusEvent)
+	}
+	if statusEvent, ok := h.currentStatusEvent(userBID, statuses[userBID]); ok {
+		h.sendStatusEvent(h.userClients(userAID), statusEventType(statusEvent.Status), statusEvent)
+	}
+}
+
+// UnlinkUsers stops sharing status between two users whose connection was dropped.
+// Each is shown as offline to the other.
+func (h *StatusHub) UnlinkUsers(userAID, userBID uuid.UUID) {
+	h.index.unlink(userAID, userBID)
+
+	statuses := h.storedStatuses([]uuid.UUID{userAID, userBID})
+	now := time.Now().UTC()
+	h.sendStatusEvent(h.userClients(userBID), EventUserOffline, newStatusEvent(userAID, "offline", statuses[userAID], now))
+	h.sendStatusEvent(h.userClients(userAID), EventUserOffline, newStatusEvent(userBID, "offline", statuses[userBID], now))
+}
+
+// userClients returns a copy of the status clients of a user on this hub.
+func (h *StatusHub) userClients(userID uuid.UUID) []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	clients := make([]*Client, 0, len(h.clientsByUser[userID]))
+	for client := range h.clientsByUser[userID] {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
+// OnConnect sets a callback that is run with the user ID whenever a status client registers.
+func (h *StatusHub) OnConnect(fn func(userID uuid.UUID)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onConnect = fn
+}
+
+// OnDisconnect sets a callback that is run with the user ID when their last status client disconnects,
+// unless they are invisible.
+func (h *StatusHub) OnDisconnect(fn func(userID uuid.UUID)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onDisconnect = fn
+}
+
+// UseStatusLookup sets the lookup for the stored status of users.
+func (h *StatusHub) UseStatusLookup(lookup StatusLookup) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.lookup = lookup
+}
+
+// SetIdleTimeout sets how long a user may stay inactive before they are shown as away.
+// Zero never marks users as away.
+func (h *StatusHub) SetIdleTimeout(timeout time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.idleTimeout = timeout
+}
+
+// lookupStatuses returns the stored status of the given users. Without a lookup,
+// or for users it leaves out, users are available and share when they were last seen.
+func (h *StatusHub) lookupStatuses(userIDs []uuid.UUID) map[uuid.UUID]*models.UserStatus {
+	h.mu.RLock()
+	lookup := h.lookup
+	h.mu.RUnlock()
+
+	var found map[uuid.UUID]*models.UserStatus
+	if lookup != nil {
+		found = lookup(userIDs)
+	}
+
+	statuses := make(map[uuid.UUID]*models.UserStatus, len(userIDs))
+	for _, userID := range userIDs {
+		if status, ok := found[userID]; ok && status != nil {
+			statuses[userID] = status
+			continue
+		}
+		statuses[userID] = &models.UserStatus{
+			UserID:       userID,
+			Mode:         models.StatusModeAvailable,
+			ShowLastSeen: true,
+		}
+	}
+	return statuses
+}
+
+// storedStatuses returns the stored status of the given users, preferring the cached
+// status of users online on this node.
+func (h *StatusHub) storedStatuses(userIDs []uuid.UUID) map[uuid.UUID]*models.UserStatus {
+	statuses := make(map[uuid.UUID]*models.UserStatus, len(userIDs))
+	var missing []uuid.UUID
+
+	h.mu.RLock()
+	for _, userID := range userIDs {
+		if status, ok := h.statuses[userID]; ok {
+			statuses[userID] = status
+		} else {
+			missing = append(missing, userID)
+		}
+	}
+	h.mu.RUnlock()
+
+	if len(missing) > 0 {
+		for userID, status := range h.lookupStatuses(missing) {
+			statuses[userID] = status
+		}
+	}
+	return statuses
+}
+
+// storedStatus returns the stored status of a single user.
+func (h *StatusHub) storedStatus(userID uuid.UUID) *models.UserStatus {
+	return h.storedStatuses([]uuid.UUID{userID})[userID]
+}
+
+// liveStatus returns the status others see for an online user: invisible users appear
+// offline, busy stays busy, and idle users are away.
+func (h *StatusHub) liveStatus(userID uuid.UUID, stored *models.UserStatus) string {
+	switch stored.Mode {
+	case models.StatusModeInvisible:
+		return "offline"
+	case models.StatusModeBusy:
+		return "busy"
+	}
+
+	h.mu.RLock()
+	away := h.away[userID]
+	h.mu.RUnlock()
+
+	if away {
+		return "away"
+	}
+	return "online"
+}
+
+// currentStatusEvent returns the status event of a user who is online here or on another
+// node and does not appear offline.
+func (h *StatusHub) currentStatusEvent(userID uuid.UUID, stored *models.UserStatus) (UserStatusEvent, bool) {
+	if !h.IsUserOnline(userID) && !h.isOnlineElsewhere(userID) {
+		return UserStatusEvent{}, false
+	}
+
+	status := h.liveStatus(userID, stored)
+	if status == "offline" {
+		return UserStatusEvent{}, false
+	}
+
+	// Report when the user was last seen, as far as they share it
+	var lastSeen time.Time
+	if stored.LastSeenAt != nil {
+		lastSeen, _ = time.Parse(time.RFC3339, *stored.LastSeenAt)
+	}
+	return newStatusEvent(userID, status, stored, lastSeen), true
+}
+
+// newStatusEvent creates the status event of a user. The status message is left out
+// while they appear offline, and the last activity unless they share when they were last seen.
+func newStatusEvent(userID uuid.UUID, status string, stored *models.UserStatus, lastActivity time.Time) UserStatusEvent {
+	statusEvent := UserStatusEvent{
+		UserID: userID,
+		Status: status,
+	}
+	if status != "offline" {
+		statusEvent.StatusMessage = stored.StatusMessage
+	}
+	if stored.ShowLastSeen && !lastActivity.IsZero() {
+		lastActivity = lastActivity.UTC()
+		statusEvent.LastActivity = &lastActivity
+	}
+	return statusEvent
+}
+
+// statusEventType returns the event type announcing that a user came online with a status
+// or went offline.
+func statusEventType(status string) EventType {
+	switch status {
+	case "online":
+		return EventUserOnline
+	case "offline":
+		return EventUserOffline
+	case "away":
+		return EventUserAway
+	default:
+		return EventUserOnline
+	}
+}
+
+// UsePresence sets the source of presence for users connected to other server nodes.
+func (h *StatusHub) UsePresence(presence RemotePresence) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.presence = presence
+}
+
+// remotePresence returns the presence source, or nil when running as a single node.
+func (h *StatusHub) remotePresence() RemotePresence {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.presence
+}
+
+// isOnlineElsewhere checks if a user has a status connection on another server node.
+func (h *StatusHub) isOnlineElsewhere(userID uuid.UUID) bool {
+	presence := h.remotePresence()
+	return presence != nil && presence.IsOnlineElsewhere(userID)
+}
+
 // BroadcastToUser sends a direct message to all connections for a specific user.
-func (h *StatusHub) BroadcastToUser(userID uuid.UUID, eventType EventType, data interface{}) {
+// It reports whether the message was queued for at least one connection.
+func (h *StatusHub) BroadcastToUser(userID uuid.UUID, eventType EventType, data interface{}) bool {
 	h.mu.RLock()
 	// Find the clients for the target user and create a copy to avoid holding the lock during send.
 	var clientsToSend []*Client
@@ -416,82 +884,193 @@ func (h *StatusHub) BroadcastToUser(userID uuid.UUID, eventType EventType, data
 	h.mu.RUnlock()
 
 	// Send the message to the copied list of clients.
+	delivered := false
 	for _, client := range clientsToSend {
-		client.SendMessage(eventType, data)
+		if client.SendMessage(eventType, data) {
+			delivered = true
+		}
 	}
+	return delivered
 }
 
 // BroadcastUserStatus broadcasts a user's status change to all connected status clients except the user themselves.
 func (h *StatusHub) BroadcastUserStatus(userID uuid.UUID, status string) {
+	// The change itself is the user's latest activity
+	statusEvent := newStatusEvent(userID, status, h.storedStatus(userID), time.Now())
+	h.broadcastStatusEvent(statusEventType(status), statusEvent)
+}
+
+// BroadcastStatusChange broadcasts the current status of an online user as a user_status_change
+// after their mode, status message or activity changed.
+func (h *StatusHub) BroadcastStatusChange(userID uuid.UUID) {
+	if !h.IsUserOnline(userID) {
+		return
+	}
+
+	stored := h.storedStatus(userID)
+	statusEvent := newStatusEvent(userID, h.liveStatus(userID, stored), stored, time.Now())
+	h.broadcastStatusEvent(EventUserStatusChange, statusEvent)
+}
+
+// broadcastStatusEvent sends a status event to the user's connections on this and every other node.
+func (h *StatusHub) broadcastStatusEvent(eventType EventType, statusEvent UserStatusEvent) {
+	h.deliverStatusEvent(eventType, statusEvent)
+
+	if presence := h.remotePresence(); presence != nil {
+		presence.RelayUserStatus(eventType, statusEvent)
+	}
+}
+
+// deliverStatusEvent sends a user's status event to the clients of their connections on this hub only.
+func (h *StatusHub) deliverStatusEvent(eventType EventType, statusEvent UserStatusEvent) {
 	h.mu.RLock()
-	// Create a copy of all clients except the user whose status is changing
-	clientsToSend := make([]*Client, 0, len(h.clients))
-	for client := range h.clients {
-		// Exclude the user whose status is changing to avoid feedback loops
-		if client.userID != userID {
+	// Create a copy of the clients of every local user connected with the user whose status is changing
+	var clientsToSend []*Client
+	for _, watcherID := range h.index.watchersOf(statusEvent.UserID) {
+		for client := range h.clientsByUser[watcherID] {
 			clientsToSend = append(clientsToSend, client)
 		}
 	}
 	h.mu.RUnlock()
 
-	// Don't broadcast if no other clients are connected
+	// Don't broadcast if none of their connections are online here
 	if len(clientsToSend) == 0 {
-		log.Printf("📡 No other clients to broadcast %s status for user %s", status, userID)
+		log.Printf("📡 No connections to broadcast %s status for user %s", statusEvent.Status, statusEvent.UserID)
 		return
 	}
 
-	// Create the status event
-	statusEvent := UserStatusEvent{
-		UserID:       userID,
-		Status:       status,
-		LastActivity: time.Now().UTC(),
+	h.sendStatusEvent(clientsToSend, eventType, statusEvent)
+	log.Printf("📡 Broadcasted %s status for user %s to %d connection clients", statusEvent.Status, statusEvent.UserID, len(clientsToSend))
+}
+
+// sendStatusEvent sends a user's status event to the given clients.
+func (h *StatusHub) sendStatusEvent(clients []*Client, eventType EventType, statusEvent UserStatusEvent) {
+	for _, client := range clients {
+		client.SendMessage(eventType, statusEvent)
 	}
+}
 
-	// Determine the correct event type
-	var eventType EventType
-	switch status {
-	case "online":
-		eventType = EventUserOnline
-	case "offline":
-		eventType = EventUserOffline
-	case "away":
-		eventType = EventUserAway
-	default:
-		eventType = EventUserOnline
+// SetUserStatus replaces the stored status of a user online on this hub and broadcasts
+// how their connections see them now.
+func (h *StatusHub) SetUserStatus(status *models.UserStatus) {
+	if status == nil {
+		return
 	}
 
-	// Broadcast to all status clients except the user themselves
-	for _, client := range clientsToSend {
-		client.SendMessage(eventType, statusEvent)
+	h.mu.Lock()
+	_, userExists := h.clientsByUser[status.UserID]
+	if userExists {
+		h.statuses[status.UserID] = status
 	}
+	h.mu.Unlock()
 
-	log.Printf("📡 Broadcasted %s status for user %s to %d other clients", status, userID, len(clientsToSend))
+	if userExists {
+		h.BroadcastStatusChange(status.UserID)
+	}
 }
 
 // SetUserAway marks a user as away and broadcasts the status change
 func (h *StatusHub) SetUserAway(userID uuid.UUID) {
-	h.mu.RLock()
+	h.setAway(userID, true)
+}
+
+// SetUserOnline marks a user as no longer away and broadcasts the status change
+func (h *StatusHub) SetUserOnline(userID uuid.UUID) {
+	h.setAway(userID, false)
+}
+
+// setAway records whether an online user is away and broadcasts the change, if any.
+func (h *StatusHub) setAway(userID uuid.UUID, away bool) {
+	h.mu.Lock()
 	_, userExists := h.clientsByUser[userID]
-	h.mu.RUnlock()
+	changed := userExists && h.away[userID] != away
+	if changed {
+		if away {
+			h.away[userID] = true
+		} else {
+			delete(h.away, userID)
+		}
+	}
+	h.mu.Unlock()
+
+	// Only broadcast if user is currently online and shows up as away or back online,
+	// which busy and invisible users don't
+	if changed && h.storedStatus(userID).Mode == models.StatusModeAvailable {
+		h.BroadcastStatusChange(userID)
+	}
+}
 
-	// Only broadcast if user is currently online
+// MarkActive records activity of an online user outside their status clients, which
+// keeps them from going away and brings them back if they were.
+func (h *StatusHub) MarkActive(userID uuid.UUID) {
+	h.mu.Lock()
+	_, userExists := h.clientsByUser[userID]
+	wasAway := h.away[userID]
 	if userExists {
-		h.BroadcastUserStatus(userID, "away")
+		h.activeAt[userID] = time.Now()
+	}
+	h.mu.Unlock()
+
+	if wasAway {
+		h.setAway(userID, false)
 	}
 }
 
-// SetUserOnline marks a user as online and broadcasts the status change
-func (h *StatusHub) SetUserOnline(userID uuid.UUID) {
+// detectIdleUsers periodically marks idle users as away, and users who are active
+// again as back online.
+func (h *StatusHub) detectIdleUsers() {
+	ticker := time.NewTicker(idleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.checkIdleUsers()
+
+		case <-h.ctx.Done():
+			return
+		}
+	}
+}
+
+// checkIdleUsers marks users as away when neither their status clients sent a frame
+// nor did they show other activity within the idle timeout.
+func (h *StatusHub) checkIdleUsers() {
 	h.mu.RLock()
-	_, userExists := h.clientsByUser[userID]
+	timeout := h.idleTimeout
+	idle := make(map[uuid.UUID]bool, len(h.clientsByUser))
+	if timeout > 0 {
+		for userID, userClients := range h.clientsByUser {
+			lastInput := h.activeAt[userID]
+			for client := range userClients {
+				if input := client.LastInput(); input.After(lastInput) {
+					lastInput = input
+				}
+			}
+			idle[userID] = time.Since(lastInput) >= timeout
+		}
+	}
 	h.mu.RUnlock()
 
-	// Only broadcast if user is currently online
-	if userExists {
-		h.BroadcastUserStatus(userID, "online")
+	for userID, isIdle := range idle {
+		h.setAway(userID, isIdle)
 	}
 }
 
+// visibleUsers returns the given online users except those who appear offline, whose
+// activity must not show in when they were last seen.
+func (h *StatusHub) visibleUsers(userIDs []uuid.UUID) []uuid.UUID {
+	statuses := h.storedStatuses(userIDs)
+
+	visible := make([]uuid.UUID, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if statuses[userID].Mode != models.StatusModeInvisible {
+			visible = append(visible, userID)
+		}
+	}
+	return visible
+}
+
 // GetOnlineUsers returns a slice of unique user IDs of all clients connected to this hub.
 func (h *StatusHub) GetOnlineUsers() []uuid.UUID {
 	h.mu.RLock()
@@ -513,28 +1092,29 @@ func (h *StatusHub) IsUserOnline(userID uuid.UUID) bool {
 	return ok
 }
 
-// SendInitialUserStatuses sends the current status of all online users to a single new client.
+// SendInitialUserStatuses sends the current status of the new client's online connections to it.
 func (h *StatusHub) SendInitialUserStatuses(newClient *Client) {
-	h.mu.RLock()
+	contactIDs := h.index.contactsOf(newClient.userID)
+	if len(contactIDs) == 0 {
+		return
+	}
 
-	// Create a list of status events for all users who are already online.
-	// We exclude the new client themselves, as they know they are online.
-	onlineUsers := make([]UserStatusEvent, 0, len(h.clientsByUser))
-	for userID := range h.clientsByUser {
-		if userID != newClient.userID {
-			onlineUsers = append(onlineUsers, UserStatusEvent{
-				UserID:       userID,
-				Status:       "online",
-				LastActivity: time.Now().UTC(), // Or fetch last known activity
-			})
+	// Collect the connections who are already online, here or on another node,
+	// and don't appear offline.
+	statuses := h.storedStatuses(contactIDs)
+	onlineUsers := make([]UserStatusEvent, 0, len(contactIDs))
+	for _, contactID := range contactIDs {
+		if statusEvent, ok := h.currentStatusEvent(contactID, statuses[contactID]); ok {
+			onlineUsers = append(onlineUsers, statusEvent)
 		}
 	}
-	h.mu.RUnlock()
 
-	// Send this snapshot of online users to the new client.
-	if len(onlineUsers) > 0 {
-		newClient.SendMessage(EventUserStatusInitial, onlineUsers)
+	if len(onlineUsers) == 0 {
+		return
 	}
+
+	// Send this snapshot of online users to the new client.
+	newClient.SendMessage(EventUserStatusInitial, onlineUsers)
 }
 
 // cleanupStaleConnections periodically removes inactive connections.
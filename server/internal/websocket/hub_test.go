@@ -0,0 +1,232 @@
+package websocket
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"match-me/internal/models"
+	"match-me/internal/pkg/broker"
+
+	"github.com/google/uuid"
+)
+
+// connectStatusClient registers a status client for a user the way the hub's event loop
+// does, with the user's status and connections already loaded.
+func connectStatusClient(h *StatusHub, userID uuid.UUID, mode string, contactIDs ...uuid.UUID) *Client {
+	client := NewClient(nil, userID)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.clients[client] = true
+	if h.clientsByUser[userID] == nil {
+		h.clientsByUser[userID] = make(map[*Client]bool)
+	}
+	h.clientsByUser[userID][client] = true
+	h.statuses[userID] = &models.UserStatus{UserID: userID, Mode: mode, ShowLastSeen: true}
+	h.index.track(userID, contactIDs)
+	return client
+}
+
+// sentEvents returns the types of the events queued for a client so far.
+func sentEvents(t *testing.T, client *Client) []EventType {
+	t.Helper()
+
+	var events []EventType
+	for {
+		select {
+		case raw, ok := <-client.send:
+			if !ok {
+				return events
+			}
+			var message WebSocketMessage
+			if err := json.Unmarshal(raw, &message); err != nil {
+				t.Fatalf("undecodable event: %v", err)
+			}
+			events = append(events, message.Type)
+		default:
+			return events
+		}
+	}
+}
+
+func TestStatusHubLastClientDisconnect(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		wantOffline  bool
+		wantLastSeen bool
+	}{
+		{name: "available user", mode: models.StatusModeAvailable, wantOffline: true, wantLastSeen: true},
+		{name: "busy user", mode: models.StatusModeBusy, wantOffline: true, wantLastSeen: true},
+		{name: "invisible user", mode: models.StatusModeInvisible},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewStatusHub()
+			defer h.Shutdown()
+
+			userID, watcherID := uuid.New(), uuid.New()
+			client := connectStatusClient(h, userID, tt.mode, watcherID)
+			watcher := connectStatusClient(h, watcherID, models.StatusModeAvailable, userID)
+
+			disconnected := make(chan uuid.UUID, 1)
+			h.OnDisconnect(func(userID uuid.UUID) { disconnected <- userID })
+
+			h.removeClient(client)
+
+			if h.IsUserOnline(userID) {
+				t.Fatal("user is still online after their last client left")
+			}
+
+			var gotOffline bool
+			for _, event := range sentEvents(t, watcher) {
+				if event == EventUserOffline {
+					gotOffline = true
+				}
+			}
+			if gotOffline != tt.wantOffline {
+				t.Errorf("watcher got user_offline = %v, want %v", gotOffline, tt.wantOffline)
+			}
+
+			var gotLastSeen bool
+			select {
+			case <-disconnected:
+				gotLastSeen = true
+			case <-time.After(100 * time.Millisecond):
+			}
+			if gotLastSeen != tt.wantLastSeen {
+				t.Errorf("disconnect recorded = %v, want %v", gotLastSeen, tt.wantLastSeen)
+			}
+		})
+	}
+}
+
+func TestStatusHubRemoveClientKeepsOtherClients(t *testing.T) {
+	h := NewStatusHub()
+	defer h.Shutdown()
+
+	userID, watcherID := uuid.New(), uuid.New()
+	first := connectStatusClient(h, userID, models.StatusModeAvailable, watcherID)
+	connectStatusClient(h, userID, models.StatusModeAvailable, watcherID)
+	watcher := connectStatusClient(h, watcherID, models.StatusModeAvailable, userID)
+
+	h.removeClient(first)
+
+	if !h.IsUserOnline(userID) {
+		t.Error("user went offline while another of their clients is connected")
+	}
+	if events := sentEvents(t, watcher); len(events) != 0 {
+		t.Errorf("watcher got %v, want no events", events)
+	}
+}
+
+func TestBeatLeavesOutInvisibleUsers(t *testing.T) {
+	h := NewStatusHub()
+	defer h.Shutdown()
+
+	b := broker.NewMemoryBroker()
+	defer b.Close()
+	s := NewWebSocketService(NewChatHub(), NewTypingHub(), h, b, time.Minute, time.Minute)
+
+	visibleID, busyID, invisibleID := uuid.New(), uuid.New(), uuid.New()
+	connectStatusClient(h, visibleID, models.StatusModeAvailable)
+	connectStatusClient(h, busyID, models.StatusModeBusy)
+	connectStatusClient(h, invisibleID, models.StatusModeInvisible)
+
+	var active []uuid.UUID
+	s.OnUserActivity(func(userIDs []uuid.UUID) { active = userIDs })
+
+	s.beat()
+
+	got := make(map[uuid.UUID]bool, len(active))
+	for _, userID := range active {
+		got[userID] = true
+	}
+	if len(active) != 2 || !got[visibleID] || !got[busyID] {
+		t.Errorf("activity reported for %v, want only the available and busy users", active)
+	}
+	if got[invisibleID] {
+		t.Error("activity reported for an invisible user")
+	}
+}
+
+func TestStatusHubIdleDetection(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity func(s *WebSocketService, userID uuid.UUID)
+		wantAway bool
+	}{
+		{
+			name:     "silent status client",
+			activity: func(*WebSocketService, uuid.UUID) {},
+			wantAway: true,
+		},
+		{
+			name:     "active on a chat socket",
+			activity: func(s *WebSocketService, userID uuid.UUID) { s.chatHub.markActive(userID) },
+		},
+		{
+			name:     "active on a typing socket",
+			activity: func(s *WebSocketService, userID uuid.UUID) { s.typingHub.markActive(userID) },
+		},
+		{
+			name:     "sent a message over HTTP",
+			activity: func(s *WebSocketService, userID uuid.UUID) { s.MarkActive(userID) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewStatusHub()
+			defer h.Shutdown()
+			h.SetIdleTimeout(time.Minute)
+
+			b := broker.NewMemoryBroker()
+			defer b.Close()
+			s := NewWebSocketService(NewChatHub(), NewTypingHub(), h, b, time.Minute, time.Minute)
+
+			// Legacy status clients never send frames of their own
+			userID := uuid.New()
+			client := connectStatusClient(h, userID, models.StatusModeAvailable)
+			client.lastInput = time.Now().Add(-2 * time.Minute)
+
+			tt.activity(s, userID)
+			h.checkIdleUsers()
+
+			if status := h.liveStatus(userID, h.storedStatus(userID)); (status == "away") != tt.wantAway {
+				t.Errorf("status = %s, want away = %v", status, tt.wantAway)
+			}
+		})
+	}
+}
+
+func TestStatusHubMarkActiveEndsAway(t *testing.T) {
+	h := NewStatusHub()
+	defer h.Shutdown()
+	h.SetIdleTimeout(time.Minute)
+
+	userID, watcherID := uuid.New(), uuid.New()
+	client := connectStatusClient(h, userID, models.StatusModeAvailable, watcherID)
+	client.lastInput = time.Now().Add(-2 * time.Minute)
+	watcher := connectStatusClient(h, watcherID, models.StatusModeAvailable, userID)
+
+	h.checkIdleUsers()
+	sentEvents(t, watcher)
+
+	h.MarkActive(userID)
+
+	if status := h.liveStatus(userID, h.storedStatus(userID)); status != "online" {
+		t.Errorf("status = %s, want online right after activity", status)
+	}
+	if events := sentEvents(t, watcher); len(events) != 1 || events[0] != EventUserStatusChange {
+		t.Errorf("watcher got %v, want a single user_status_change", events)
+	}
+
+	// The activity keeps counting on the next idle check
+	h.checkIdleUsers()
+	if status := h.liveStatus(userID, h.storedStatus(userID)); status != "online" {
+		t.Errorf("status = %s after the next idle check, want online", status)
+	}
+}
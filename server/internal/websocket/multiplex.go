@@ -0,0 +1,294 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+// =================================================================================
+// MULTIPLEXED CONNECTION
+// A single WebSocket carrying any number of chat, typing and status channels.
+// Every subscribed channel is registered with its hub as a subscription client
+// that writes through the shared socket.
+// =================================================================================
+
+const (
+	ChannelStatus           = "status"
+	ChannelConnectionPrefix = "connection:"
+	ChannelTypingPrefix     = "typing:"
+)
+
+// AccessChecker verifies that a user may subscribe to the channels of a connection.
+type AccessChecker func(ctx context.Context, userID, connectionID uuid.UUID) error
+
+// subscription is one channel of a multiplexed connection.
+type subscription struct {
+	client     *Client
+	unregister chan<- *Client
+}
+
+// MultiplexClient routes the channels of a single WebSocket to the hubs.
+type MultiplexClient struct {
+	*Client
+	chatHub       *ChatHub
+	typingHub     *TypingHub
+	statusHub     *StatusHub
+	authorize     AccessChecker
+	subscriptions map[string]*subscription
+	subMu         sync.Mutex
+}
+
+// ServeMultiplexWS handles a WebSocket connection that subscribes to channels on demand.
+func ServeMultiplexWS(chatHub *ChatHub, typingHub *TypingHub, statusHub *StatusHub, c *gin.Context, userID uuid.UUID, authorize AccessChecker) {
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Printf("WebSocket upgrade error: %v", err)
+		return
+	}
+
+	client := &MultiplexClient{
+		Client:        NewClient(conn, userID),
+		chatHub:       chatHub,
+		typingHub:     typingHub,
+		statusHub:     statusHub,
+		authorize:     authorize,
+		subscriptions: make(map[string]*subscription),
+	}
+
+	go client.writePump()
+	go client.readPump()
+}
+
+// readPump pumps messages from the websocket connection and unsubscribes
+// from every channel once the connection closes.
+func (m *MultiplexClient) readPump() {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("🚨 PANIC recovered in multiplexed readPump for user %s: %v", m.userID, r)
+			debug.PrintStack()
+		}
+		m.unsubscribeAll()
+		m.Close()
+		m.conn.Close()
+	}()
+
+	m.conn.SetReadLimit(maxMessageSize)
+	m.conn.SetReadDeadline(time.Now().Add(pongWait))
+	m.conn.SetPongHandler(func(string) error {
+		m.conn.SetReadDeadline(time.Now().Add(pongWait))
+		m.UpdateActivity()
+		return nil
+	})
+
+	for {
+		_, message, err := m.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				log.Printf("websocket error: %v", err)
+			}
+			return
+		}
+		m.UpdateActivity()
+		m.MarkInput()
+		m.statusHub.MarkActive(m.userID)
+		if err := m.handleMessage(message); err != nil {
+			log.Printf("error handling message: %v", err)
+		}
+	}
+}
+
+// handleMessage processes incoming frames on the multiplexed connection.
+func (m *MultiplexClient) handleMessage(message []byte) error {
+	var wsMessage WebSocketMessage
+	if err := json.Unmarshal(message, &wsMessage); err != nil {
+		return err
+	}
+
+	switch wsMessage.Type {
+	case EventSubscribe:
+		m.handleSubscribe(wsMessage.Data)
+	case EventUnsubscribe:
+		m.handleUnsubscribe(wsMessage.Data)
+	case EventMessageTyping:
+		var typingEvent TypingEvent
+		if err := decodeEventData(wsMessage.Data, &typingEvent); err != nil {
+			return err
+		}
+		// Typing is only relayed on a subscribed typing channel
+		if sub := m.subscription(ChannelTypingPrefix + typingEvent.ConnectionID.String()); sub != nil {
+			sub.client.handleTypingEvent(wsMessage.Data, m.typingHub)
+		}
+	case EventMessageSend:
+		var sendEvent SendMessageEvent
+		if err := decodeEventData(wsMessage.Data, &sendEvent); err != nil {
+			return err
+		}
+		// Sending requires a subscription to the connection's chat channel
+		var sub *subscription
+		if sendEvent.ConnectionID != nil {
+			sub = m.subscription(ChannelConnectionPrefix + sendEvent.ConnectionID.String())
+		}
+		if sub == nil {
+			m.SendMessage(EventMessageNack, MessageNackEvent{
+				ClientMessageID: sendEvent.ClientMessageID,
+				Error:           "not subscribed to this connection",
+			})
+			return nil
+		}
+		sub.client.handleSendEvent(wsMessage.Data, m.chatHub)
+	case EventPing:
+		m.SendMessage(EventPong, nil)
+	default:
+		log.Printf("Unhandled message type: %s", wsMessage.Type)
+	}
+	return nil
+}
+
+// handleSubscribe registers a new channel with its hub after checking access.
+func (m *MultiplexClient) handleSubscribe(data interface{}) {
+	var event SubscriptionEvent
+	if err := decodeEventData(data, &event); err != nil {
+		m.SendMessage(EventSubscribeError, SubscriptionEvent{Error: "invalid subscription"})
+		return
+	}
+	event.Channel = canonicalChannel(event.Channel)
+
+	// Subscribing twice is harmless
+	if sub := m.subscription(event.Channel); sub != nil && sub.client.IsActive() {
+		m.SendMessage(EventSubscribed, SubscriptionEvent{Channel: event.Channel})
+		return
+	}
+
+	var connectionID *uuid.UUID
+	var register, unregister chan *Client
+	switch {
+	case event.Channel == ChannelStatus:
+		register, unregister = m.statusHub.register, m.statusHub.unregister
+	case strings.HasPrefix(event.Channel, ChannelConnectionPrefix):
+		register, unregister = m.chatHub.register, m.chatHub.unregister
+		connectionID = parseChannelConnectionID(event.Channel, ChannelConnectionPrefix)
+	case strings.HasPrefix(event.Channel, ChannelTypingPrefix):
+		register, unregister = m.typingHub.register, m.typingHub.unregister
+		connectionID = parseChannelConnectionID(event.Channel, ChannelTypingPrefix)
+	default:
+		m.SendMessage(EventSubscribeError, SubscriptionEvent{Channel: event.Channel, Error: "unknown channel"})
+		return
+	}
+
+	if event.Channel != ChannelStatus {
+		if connectionID == nil {
+			m.SendMessage(EventSubscribeError, SubscriptionEvent{Channel: event.Channel, Error: "invalid connection ID"})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(m.ctx, sendTimeout)
+		err := m.authorize(ctx, m.userID, *connectionID)
+		cancel()
+		if err != nil {
+			m.SendMessage(EventSubscribeError, SubscriptionEvent{Channel: event.Channel, Error: err.Error()})
+			return
+		}
+	}
+
+	sub := &subscription{
+		client:     newSubscriptionClient(m.Client, connectionID),
+		unregister: unregister,
+	}
+
+	// A subscription whose client stopped is replaced, so its hub drops the old client first
+	m.subMu.Lock()
+	previous := m.subscriptions[event.Channel]
+	m.subscriptions[event.Channel] = sub
+	m.subMu.Unlock()
+
+	if previous != nil {
+		previous.unregister <- previous.client
+	}
+	register <- sub.client
+	log.Printf("🔔 User %s subscribed to %s", m.userID, event.Channel)
+	m.SendMessage(EventSubscribed, SubscriptionEvent{Channel: event.Channel})
+}
+
+// handleUnsubscribe removes a channel from its hub.
+func (m *MultiplexClient) handleUnsubscribe(data interface{}) {
+	var event SubscriptionEvent
+	if err := decodeEventData(data, &event); err != nil {
+		m.SendMessage(EventSubscribeError, SubscriptionEvent{Error: "invalid subscription"})
+		return
+	}
+	event.Channel = canonicalChannel(event.Channel)
+
+	m.subMu.Lock()
+	sub, ok := m.subscriptions[event.Channel]
+	delete(m.subscriptions, event.Channel)
+	m.subMu.Unlock()
+
+	if ok {
+		sub.unregister <- sub.client
+	}
+	m.SendMessage(EventUnsubscribed, SubscriptionEvent{Channel: event.Channel})
+}
+
+// unsubscribeAll removes every channel from its hub.
+func (m *MultiplexClient) unsubscribeAll() {
+	m.subMu.Lock()
+	subs := m.subscriptions
+	m.subscriptions = make(map[string]*subscription)
+	m.subMu.Unlock()
+
+	for _, sub := range subs {
+		sub.unregister <- sub.client
+	}
+}
+
+// subscription returns the subscription to a channel, if any.
+func (m *MultiplexClient) subscription(channel string) *subscription {
+	m.subMu.Lock()
+	defer m.subMu.Unlock()
+	return m.subscriptions[channel]
+}
+
+// canonicalChannel writes the connection ID of a channel in its canonical form, so a channel
+// subscribed with an upper-case or braced UUID matches the lookups made when sending or typing.
+func canonicalChannel(channel string) string {
+	for _, prefix := range []string{ChannelConnectionPrefix, ChannelTypingPrefix} {
+		if !strings.HasPrefix(channel, prefix) {
+			continue
+		}
+		if connectionID := parseChannelConnectionID(channel, prefix); connectionID != nil {
+			return prefix + connectionID.String()
+		}
+	}
+	return channel
+}
+
+// parseChannelConnectionID returns the connection ID of a "connection:<id>" or "typing:<id>" channel.
+func parseChannelConnectionID(channel, prefix string) *uuid.UUID {
+	connectionID, err := uuid.Parse(strings.TrimPrefix(channel, prefix))
+	if err != nil {
+		return nil
+	}
+	return &connectionID
+}
+
+// decodeEventData converts the generic data of a frame into an event struct.
+func decodeEventData(data interface{}, v interface{}) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to read event data: %w", err)
+	}
+	if err := json.Unmarshal(jsonData, v); err != nil {
+		return fmt.Errorf("failed to read event data: %w", err)
+	}
+	return nil
+}
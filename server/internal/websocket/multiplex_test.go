@@ -0,0 +1,24 @@
+package websocket
+
+import "testing"
+
+func TestCanonicalChannel(t *testing.T) {
+	const id = "6f1c2a8e-3b4d-4e5f-9a0b-1c2d3e4f5a6b"
+
+	tests := []struct {
+		channel string
+		want    string
+	}{
+		{ChannelStatus, ChannelStatus},
+		{ChannelConnectionPrefix + id, ChannelConnectionPrefix + id},
+		{ChannelConnectionPrefix + "6F1C2A8E-3B4D-4E5F-9A0B-1C2D3E4F5A6B", ChannelConnectionPrefix + id},
+		{ChannelTypingPrefix + "{" + id + "}", ChannelTypingPrefix + id},
+		{ChannelTypingPrefix + "not-a-uuid", ChannelTypingPrefix + "not-a-uuid"},
+	}
+
+	for _, tt := range tests {
+		if got := canonicalChannel(tt.channel); got != tt.want {
+			t.Errorf("canonicalChannel(%q) = %q, want %q", tt.channel, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package websocket
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// presenceIndex tracks the connections of the users with a status client on this node,
+// so status changes only reach the people a user is connected with.
+type presenceIndex struct {
+	mu sync.RWMutex
+
+	// Maps a local user to the users they are connected with.
+	contacts map[uuid.UUID]map[uuid.UUID]bool
+
+	// Maps a user to the local users connected with them.
+	watchers map[uuid.UUID]map[uuid.UUID]bool
+}
+
+func newPresenceIndex() *presenceIndex {
+	return &presenceIndex{
+		contacts: make(map[uuid.UUID]map[uuid.UUID]bool),
+		watchers: make(map[uuid.UUID]map[uuid.UUID]bool),
+	}
+}
+
+// track starts indexing a local user with their current connections
+func (p *presenceIndex) track(userID uuid.UUID, contactIDs []uuid.UUID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.untrackLocked(userID)
+	p.contacts[userID] = make(map[uuid.UUID]bool, len(contactIDs))
+	for _, contactID := range contactIDs {
+		p.addLocked(userID, contactID)
+	}
+}
+
+// isTracked checks if a user's connections are indexed
+func (p *presenceIndex) isTracked(userID uuid.UUID) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.contacts[userID]
+	return ok
+}
+
+// untrack stops indexing a user who no longer has a status client on this node
+func (p *presenceIndex) untrack(userID uuid.UUID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.untrackLocked(userID)
+}
+
+func (p *presenceIndex) untrackLocked(userID uuid.UUID) {
+	for contactID := range p.contacts[userID] {
+		p.removeWatcherLocked(contactID, userID)
+	}
+	delete(p.contacts, userID)
+}
+
+// link records a new connection between two users, for whichever of them is tracked
+func (p *presenceIndex) link(userAID, userBID uuid.UUID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.contacts[userAID]; ok {
+		p.addLocked(userAID, userBID)
+	}
+	if _, ok := p.contacts[userBID]; ok {
+		p.addLocked(userBID, userAID)
+	}
+}
+
+// unlink forgets a dropped connection between two users
+func (p *presenceIndex) unlink(userAID, userBID uuid.UUID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if contacts, ok := p.contacts[userAID]; ok {
+		delete(contacts, userBID)
+		p.removeWatcherLocked(userBID, userAID)
+	}
+	if contacts, ok := p.contacts[userBID]; ok {
+		delete(contacts, userAID)
+		p.removeWatcherLocked(userAID, userBID)
+	}
+}
+
+func (p *presenceIndex) addLocked(userID, contactID uuid.UUID) {
+	p.contacts[userID][contactID] = true
+	if p.watchers[contactID] == nil {
+		p.watchers[contactID] = make(map[uuid.UUID]bool)
+	}
+	p.watchers[contactID][userID] = true
+}
+
+func (p *presenceIndex) removeWatcherLocked(userID, watcherID uuid.UUID) {
+	if watchers, ok := p.watchers[userID]; ok {
+		delete(watchers, watcherID)
+		if len(watchers) == 0 {
+			delete(p.watchers, userID)
+		}
+	}
+}
+
+// contactsOf returns the users a tracked local user is connected with
+func (p *presenceIndex) contactsOf(userID uuid.UUID) []uuid.UUID {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	contactIDs := make([]uuid.UUID, 0, len(p.contacts[userID]))
+	for contactID := range p.contacts[userID] {
+		contactIDs = append(contactIDs, contactID)
+	}
+	return contactIDs
+}
+
+// watchersOf returns the local users connected with a user
+func (p *presenceIndex) watchersOf(userID uuid.UUID) []uuid.UUID {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	watcherIDs := make([]uuid.UUID, 0, len(p.watchers[userID]))
+	for watcherID := range p.watchers[userID] {
+		watcherIDs = append(watcherIDs, watcherID)
+	}
+	return watcherIDs
+}
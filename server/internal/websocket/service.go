@@ -1,8 +1,11 @@
 package websocket
 
 import (
+	"context"
 	"log"
 	"match-me/internal/models"
+	"match-me/internal/pkg/broker"
+	"sync"
 	"time" // Make sure time is imported
 
 	"github.com/google/uuid"
@@ -13,22 +16,56 @@ type WebSocketService struct {
 	chatHub   *ChatHub
 	typingHub *TypingHub
 	statusHub *StatusHub
+
+	// Fan-out to the other server nodes
+	broker            broker.Broker
+	nodeID            string
+	heartbeatInterval time.Duration
+	leaseTTL          time.Duration
+
+	// Users connected to other nodes, refreshed on every heartbeat
+	presenceMu  sync.RWMutex
+	remoteUsers map[uuid.UUID]bool
+
+	// Called on every heartbeat with the users connected to this node
+	onActivity func(userIDs []uuid.UUID)
+
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // NewWebSocketService creates a new WebSocket service
-func NewWebSocketService(chatHub *ChatHub, typingHub *TypingHub, statusHub *StatusHub) *WebSocketService {
-	return &WebSocketService{
-		chatHub:   chatHub,
-		typingHub: typingHub,
-		statusHub: statusHub,
+func NewWebSocketService(chatHub *ChatHub, typingHub *TypingHub, statusHub *StatusHub, b broker.Broker, heartbeatInterval, leaseTTL time.Duration) *WebSocketService {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &WebSocketService{
+		chatHub:           chatHub,
+		typingHub:         typingHub,
+		statusHub:         statusHub,
+		broker:            b,
+		nodeID:            uuid.New().String(),
+		heartbeatInterval: heartbeatInterval,
+		leaseTTL:          leaseTTL,
+		remoteUsers:       make(map[uuid.UUID]bool),
+		ctx:               ctx,
+		cancel:            cancel,
 	}
+
+	b.Subscribe(s.handleFanout)
+	typingHub.OnBroadcast(s.relayTypingIndicator)
+	chatHub.OnActivity(statusHub.MarkActive)
+	typingHub.OnActivity(statusHub.MarkActive)
+	statusHub.UsePresence(s)
+
+	log.Printf("WebSocket node %s started", s.nodeID)
+	return s
 }
 
-// BroadcastNewMessage broadcasts a new message to connection participants
-func (s *WebSocketService) BroadcastNewMessage(message *models.Message) {
+// BroadcastNewMessage broadcasts a new message to connection participants.
+// It reports whether the message reached at least one of the receiver's clients.
+func (s *WebSocketService) BroadcastNewMessage(message *models.Message) bool {
 	if message == nil {
 		log.Printf("❌ BroadcastNewMessage called with nil message")
-		return
+		return false
 	}
 
 	// NOTE: Assumes MessageEvent is defined in your package.
@@ -39,25 +76,96 @@ func (s *WebSocketService) BroadcastNewMessage(message *models.Message) {
 	}
 
 	// Use the ChatHub to broadcast the message to active chat connections
-	s.chatHub.BroadcastMessage(message.ConnectionID, messageEvent, message.SenderID)
+	sentToChat := s.chatHub.BroadcastMessage(message.ConnectionID, messageEvent, message.SenderID)
+	s.publish(fanoutMessage{
+		Scope:        scopeConnection,
+		ConnectionID: &message.ConnectionID,
+		UserID:       message.SenderID,
+		Type:         EventMessageNew,
+	}, messageEvent)
 
 	// Also send notification via StatusHub for global real-time updates
 	// This ensures users receive message notifications even when the chat isn't open
-	s.statusHub.BroadcastToUser(message.ReceiverID, EventMessageNew, messageEvent)
+	sentToStatus := s.toUser(message.ReceiverID, EventMessageNew, messageEvent)
+
+	return sentToChat || sentToStatus
 }
 
-// BroadcastMessageRead broadcasts message read status to connection participants
-func (s *WebSocketService) BroadcastMessageRead(messageID, connectionID, readByUserID uuid.UUID) {
-	// NOTE: Assumes MessageReadEvent and EventMessageRead are defined
+// BroadcastMessagesDelivered tells the sender that messages reached the receiver
+func (s *WebSocketService) BroadcastMessagesDelivered(connectionID, senderID, receiverID uuid.UUID, messageIDs []uuid.UUID, deliveredAt time.Time) {
+	deliveredEvent := MessageDeliveredEvent{
+		MessageIDs:   messageIDs,
+		ConnectionID: connectionID,
+		DeliveredTo:  receiverID,
+		DeliveredAt:  deliveredAt,
+	}
+
+	s.toConnection(connectionID, EventMessageDelivered, deliveredEvent, receiverID)
+	s.toUser(senderID, EventMessageDelivered, deliveredEvent)
+}
+
+// BroadcastMessagesRead tells the sender which of their messages the reader has read
+func (s *WebSocketService) BroadcastMessagesRead(connectionID, senderID, readByUserID uuid.UUID, messageIDs []uuid.UUID, lastReadMessageID uuid.UUID, readAt time.Time) {
 	readEvent := MessageReadEvent{
+		MessageIDs:        messageIDs,
+		LastReadMessageID: lastReadMessageID,
+		ConnectionID:      connectionID,
+		ReadBy:            readByUserID,
+		ReadAt:            readAt,
+	}
+
+	// Use ChatHub to broadcast read events within the connection
+	s.toConnection(connectionID, EventMessageRead, readEvent, readByUserID)
+	s.toUser(senderID, EventMessageRead, readEvent)
+}
+
+// BroadcastMessageEdited broadcasts an edited message to connection participants
+func (s *WebSocketService) BroadcastMessageEdited(message *models.Message) {
+	if message == nil {
+		return
+	}
+
+	editedEvent := MessageEditedEvent{
+		Message:      message,
+		ConnectionID: message.ConnectionID,
+		EditedBy:     message.SenderID,
+	}
+
+	s.toConnection(message.ConnectionID, EventMessageEdited, editedEvent, message.SenderID)
+
+	// The receiver may show the message in a chat list preview without the chat open
+	s.toUser(message.ReceiverID, EventMessageEdited, editedEvent)
+}
+
+// BroadcastMessageDeleted broadcasts that a message was unsent to connection participants
+func (s *WebSocketService) BroadcastMessageDeleted(message *models.Message) {
+	if message == nil {
+		return
+	}
+
+	deletedEvent := MessageDeletedEvent{
+		MessageID:    message.ID,
+		ConnectionID: message.ConnectionID,
+		DeletedBy:    message.SenderID,
+		DeletedAt:    time.Now(),
+	}
+
+	s.toConnection(message.ConnectionID, EventMessageDeleted, deletedEvent, message.SenderID)
+	s.toUser(message.ReceiverID, EventMessageDeleted, deletedEvent)
+}
+
+// BroadcastMessageReaction broadcasts a reaction change to connection participants
+func (s *WebSocketService) BroadcastMessageReaction(connectionID, messageID, userID uuid.UUID, emoji, action string, reactions []*models.ReactionSummary) {
+	reactionEvent := MessageReactionEvent{
 		MessageID:    messageID,
 		ConnectionID: connectionID,
-		ReadBy:       readByUserID,
-		ReadAt:       time.Now(),
+		UserID:       userID,
+		Emoji:        emoji,
+		Action:       action,
+		Reactions:    reactions,
 	}
 
-	// Use ChatHub to broadcast read events within the connection
-	s.chatHub.BroadcastEvent(connectionID, EventMessageRead, readEvent, readByUserID)
+	s.toConnection(connectionID, EventMessageReaction, reactionEvent, userID)
 }
 
 // BroadcastTypingIndicator broadcasts typing status to connection participants
@@ -85,7 +193,7 @@ func (s *WebSocketService) BroadcastConnectionRequest(request *models.Connection
 	}
 
 	// Use StatusHub to send direct messages to users
-	s.statusHub.BroadcastToUser(request.ReceiverID, EventConnectionRequest, requestEvent)
+	s.toUser(request.ReceiverID, EventConnectionRequest, requestEvent)
 }
 
 // BroadcastConnectionAccepted broadcasts that a connection request was accepted
@@ -98,29 +206,33 @@ func (s *WebSocketService) BroadcastConnectionAccepted(request *models.Connectio
 		Request: request,
 		Action:  "accepted",
 	}
-	s.statusHub.BroadcastToUser(request.SenderID, EventConnectionRequest, requestEvent)
+	s.toUser(request.SenderID, EventConnectionRequest, requestEvent)
 
 	// NOTE: Assumes ConnectionEvent is defined.
 	connectionEvent := ConnectionEvent{
 		Connection: connection,
 		Action:     "established",
 	}
-	s.statusHub.BroadcastToUser(request.SenderID, EventConnectionAccepted, connectionEvent)
-	s.statusHub.BroadcastToUser(request.ReceiverID, EventConnectionAccepted, connectionEvent)
+	s.toUser(request.SenderID, EventConnectionAccepted, connectionEvent)
+	s.toUser(request.ReceiverID, EventConnectionAccepted, connectionEvent)
+
+	s.LinkUsers(connection.UserAID, connection.UserBID)
 }
 
-// BroadcastConnectionMessagesRead broadcasts that all messages in a connection were read.
-func (s *WebSocketService) BroadcastConnectionMessagesRead(connectionID, readByUserID uuid.UUID, messageCount int) {
+// BroadcastMatch notifies both users that their mutual likes created a connection
+func (s *WebSocketService) BroadcastMatch(connection *models.Connection) {
+	if connection == nil {
+		return
+	}
 
-	readEvent := MessageReadEvent{
-		MessageID:    uuid.Nil,
-		ConnectionID: connectionID,
-		ReadBy:       readByUserID,
-		ReadAt:       time.Now(),
+	connectionEvent := ConnectionEvent{
+		Connection: connection,
+		Action:     "matched",
 	}
+	s.toUser(connection.UserAID, EventConnectionAccepted, connectionEvent)
+	s.toUser(connection.UserBID, EventConnectionAccepted, connectionEvent)
 
-	// Use the ChatHub to broadcast the read event to other participants in the connection.
-	s.chatHub.BroadcastEvent(connectionID, EventMessageRead, readEvent, readByUserID)
+	s.LinkUsers(connection.UserAID, connection.UserBID)
 }
 
 // BroadcastConnectionDeclined broadcasts that a connection request was declined.
@@ -136,7 +248,144 @@ func (s *WebSocketService) BroadcastConnectionDeclined(request *models.Connectio
 	}
 
 	// Use the StatusHub to send the notification directly to the original sender.
-	s.statusHub.BroadcastToUser(request.SenderID, EventConnectionRequest, requestEvent)
+	s.toUser(request.SenderID, EventConnectionRequest, requestEvent)
+}
+
+// BroadcastConnectionExpired tells both parties that a pending request expired
+func (s *WebSocketService) BroadcastConnectionExpired(request *models.ConnectionRequest) {
+	if request == nil {
+		return
+	}
+
+	requestEvent := ConnectionRequestEvent{
+		Request: request,
+		Action:  "expired",
+	}
+	s.toUser(request.SenderID, EventConnectionRequest, requestEvent)
+	s.toUser(request.ReceiverID, EventConnectionRequest, requestEvent)
+}
+
+// BroadcastConnectionWithdrawn tells the receiver that the sender withdrew a pending request
+func (s *WebSocketService) BroadcastConnectionWithdrawn(request *models.ConnectionRequest) {
+	if request == nil {
+		return
+	}
+
+	requestEvent := ConnectionRequestEvent{
+		Request: request,
+		Action:  "withdrawn",
+	}
+	s.toUser(request.ReceiverID, EventConnectionRequest, requestEvent)
+}
+
+// BroadcastEventChanged tells the participants of an event that it was updated or cancelled
+func (s *WebSocketService) BroadcastEventChanged(event *models.Event, action string, participantIDs []uuid.UUID, changedBy uuid.UUID) {
+	if event == nil {
+		return
+	}
+
+	eventEvent := LocalEventEvent{
+		Event:  event,
+		Action: action,
+	}
+	for _, userID := range participantIDs {
+		if userID != changedBy {
+			s.toUser(userID, EventEventUpdated, eventEvent)
+		}
+	}
+}
+
+// BroadcastEventRSVP tells the creator of an event that someone responded to it
+func (s *WebSocketService) BroadcastEventRSVP(event *models.Event, participant *models.EventParticipant) {
+	if event == nil || participant == nil || participant.UserID == event.CreatorID {
+		return
+	}
+
+	eventEvent := LocalEventEvent{
+		Event:       event,
+		Action:      "rsvp",
+		Participant: participant,
+	}
+	s.toUser(event.CreatorID, EventEventRSVP, eventEvent)
+}
+
+// LinkUsers starts sharing presence between two users who just got connected, on every node
+func (s *WebSocketService) LinkUsers(userAID, userBID uuid.UUID) {
+	s.statusHub.LinkUsers(userAID, userBID)
+	s.publish(fanoutMessage{
+		Scope:  scopeLink,
+		UserID: userAID,
+		PeerID: &userBID,
+	}, nil)
+}
+
+// UnlinkUsers stops sharing presence between two users whose connection was dropped, on every node
+func (s *WebSocketService) UnlinkUsers(userAID, userBID uuid.UUID) {
+	s.statusHub.UnlinkUsers(userAID, userBID)
+	s.publish(fanoutMessage{
+		Scope:  scopeUnlink,
+		UserID: userAID,
+		PeerID: &userBID,
+	}, nil)
+}
+
+// UseContacts sets the loader for the connections whose presence a user may see
+func (s *WebSocketService) UseContacts(loader ContactsLoader) {
+	s.statusHub.UseContacts(loader)
+}
+
+// OnClientConnected registers a callback that runs whenever a user opens a chat or status connection
+func (s *WebSocketService) OnClientConnected(fn func(userID uuid.UUID)) {
+	s.chatHub.OnConnect(fn)
+	s.statusHub.OnConnect(fn)
+}
+
+// OnClientDisconnected registers a callback that runs when a user closes their last status connection.
+// It does not run for invisible users, who already appeared offline.
+func (s *WebSocketService) OnClientDisconnected(fn func(userID uuid.UUID)) {
+	s.statusHub.OnDisconnect(fn)
+}
+
+// OnUserActivity registers a callback that runs on every presence heartbeat with the users
+// connected to this node, leaving out invisible users
+func (s *WebSocketService) OnUserActivity(fn func(userIDs []uuid.UUID)) {
+	s.presenceMu.Lock()
+	defer s.presenceMu.Unlock()
+	s.onActivity = fn
+}
+
+// UseStatusLookup sets the lookup for the stored mode, status message and last-seen time of users
+func (s *WebSocketService) UseStatusLookup(lookup StatusLookup) {
+	s.statusHub.UseStatusLookup(lookup)
+}
+
+// SetAwayAfter sets how long a user may stay inactive before they are shown as away
+func (s *WebSocketService) SetAwayAfter(timeout time.Duration) {
+	s.statusHub.SetIdleTimeout(timeout)
+}
+
+// MarkActive records that a user is active, e.g. because they sent a message, so they
+// are not shown as away
+func (s *WebSocketService) MarkActive(userID uuid.UUID) {
+	s.statusHub.MarkActive(userID)
+}
+
+// UpdateUserStatus shows a user's new mode and status message to their connections, on every node
+func (s *WebSocketService) UpdateUserStatus(status *models.UserStatus) {
+	if status == nil {
+		return
+	}
+
+	s.statusHub.SetUserStatus(status)
+	s.publish(fanoutMessage{
+		Scope:  scopePresence,
+		UserID: status.UserID,
+	}, status)
+}
+
+// OnChatMessage registers the handler that stores messages sent over chat connections
+func (s *WebSocketService) OnChatMessage(fn ChatSendHandler) {
+	s.chatHub.OnSend(fn)
 }
 
 // BroadcastUserStatusChange broadcasts user status changes to their connections
@@ -144,17 +393,37 @@ func (s *WebSocketService) BroadcastUserStatusChange(userID uuid.UUID, status st
 	s.statusHub.BroadcastUserStatus(userID, status)
 }
 
-// GetOnlineUsers returns list of currently online users
+// GetOnlineUsers returns list of currently online users across all server nodes
 func (s *WebSocketService) GetOnlineUsers() []uuid.UUID {
-	return s.statusHub.GetOnlineUsers()
+	onlineUsers := s.statusHub.GetOnlineUsers()
+
+	seen := make(map[uuid.UUID]bool, len(onlineUsers))
+	for _, userID := range onlineUsers {
+		seen[userID] = true
+	}
+	for _, userID := range s.RemoteOnlineUsers() {
+		if !seen[userID] {
+			onlineUsers = append(onlineUsers, userID)
+		}
+	}
+	return onlineUsers
 }
 
-// IsUserOnline checks if a specific user is currently online
+// IsUserOnline checks if a specific user is currently online on any server node
 func (s *WebSocketService) IsUserOnline(userID uuid.UUID) bool {
-	return s.statusHub.IsUserOnline(userID)
+	return s.statusHub.IsUserOnline(userID) || s.IsOnlineElsewhere(userID)
+}
+
+// GetUserStatus returns the status a user's connections see: "online", "busy", "away" or "offline"
+func (s *WebSocketService) GetUserStatus(userID uuid.UUID) string {
+	statusEvent, ok := s.statusHub.currentStatusEvent(userID, s.statusHub.storedStatus(userID))
+	if !ok {
+		return "offline"
+	}
+	return statusEvent.Status
 }
 
-// GetConnectionUsers returns users currently connected to a specific connection
+// GetConnectionUsers returns users currently connected to a specific connection on this node
 func (s *WebSocketService) GetConnectionUsers(connectionID uuid.UUID) ([]uuid.UUID, bool) {
 	// FIX: Call the new method on the ChatHub.
 	return s.chatHub.GetConnectionUsers(connectionID)
@@ -162,7 +431,7 @@ func (s *WebSocketService) GetConnectionUsers(connectionID uuid.UUID) ([]uuid.UU
 
 // SendDirectMessage sends a direct message to a specific user (for system notifications)
 func (s *WebSocketService) SendDirectMessage(userID uuid.UUID, eventType EventType, data interface{}) {
-	s.statusHub.BroadcastToUser(userID, eventType, data)
+	s.toUser(userID, eventType, data)
 }
 
 // SendErrorToUser sends an error message to a specific user
@@ -172,11 +441,15 @@ func (s *WebSocketService) SendErrorToUser(userID uuid.UUID, code int, message s
 		Code:    code,
 		Message: message,
 	}
-	s.statusHub.BroadcastToUser(userID, EventError, errorEvent)
+	s.toUser(userID, EventError, errorEvent)
 }
 
 // Shutdown gracefully shuts down the WebSocket service
 func (s *WebSocketService) Shutdown() {
+	// Give up this node's presence leases so other nodes see its users go offline
+	s.cancel()
+	s.heartbeat(nil)
+
 	s.chatHub.Shutdown()
 	s.typingHub.Shutdown()
 	s.statusHub.Shutdown()